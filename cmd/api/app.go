@@ -55,6 +55,11 @@ func (a *App) shutdown() {
 		pkg.Log().Errorw("server forced to shutdown", "error", err)
 	}
 
+	// Hijacked WebSocket connections are not closed by http.Server.Shutdown.
+	if err := a.ws.Shutdown(ctx); err != nil {
+		pkg.Log().Errorw("websocket hub forced to shutdown", "error", err)
+	}
+
 	if err := a.worker.Stop(ctx); err != nil {
 		pkg.Log().Errorw("worker forced to shutdown", "error", err)
 	}
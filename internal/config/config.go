@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -20,6 +21,15 @@ type Config struct {
 	RabbitMQ RabbitMQConfig
 	MinIO    MinioStorageConfig
 	Limiter  RateLimiterCfg
+	Lockout  LockoutConfig
+	TwoFA    TwoFactorConfig
+	OAuth    OAuthConfig
+	Verify   VerificationConfig
+	Deletion DeletionConfig
+	Feed     FeedConfig
+	Reaction ReactionConfig
+	WS       WSConfig
+	Presence PresenceConfig
 }
 
 func Load() Config {
@@ -41,7 +51,16 @@ func Load() Config {
 		Mailer:   MailCfg(),
 		RabbitMQ: RabbitMQCfg(),
 		MinIO:    MinStorageCfg(serverCfg.AppName),
-		Limiter:  RateLimiterCfg{},
+		Limiter:  LimiterCfg(),
+		Lockout:  LockoutCfg(),
+		TwoFA:    TwoFactorCfg(),
+		OAuth:    OAuthCfg(),
+		Verify:   VerificationCfg(),
+		Deletion: DeletionCfg(),
+		Feed:     FeedCfg(),
+		Reaction: ReactionCfg(),
+		WS:       WSCfg(),
+		Presence: PresenceCfg(),
 	}
 }
 
@@ -53,6 +72,17 @@ func getString(k, d string) string {
 	return v
 }
 
+// getStrings reads a comma separated list, nil when k is unset.
+func getStrings(k string) []string {
+	var out []string
+	for _, v := range strings.Split(os.Getenv(k), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
 func getBool(k string, d bool) bool {
 	v := os.Getenv(k)
 	if v == "" {
@@ -0,0 +1,39 @@
+package config
+
+import "time"
+
+const (
+	defaultDeletionGracePeriod    = 30 * 24 * time.Hour
+	defaultDeletionPurgeInterval  = time.Hour
+	defaultDeletionPurgeBatchSize = 100
+)
+
+type DeletionConfig struct {
+	// GracePeriod is how long a deleted account waits before it is purged.
+	// Logging in meanwhile keeps it.
+	GracePeriod time.Duration
+	// PurgeInterval is how often the accounts past their grace period are purged.
+	PurgeInterval time.Duration
+	// PurgeBatchSize caps the number of accounts looked up per round trip.
+	PurgeBatchSize int
+}
+
+func DeletionCfg() DeletionConfig {
+	cfg := DeletionConfig{
+		GracePeriod:    getDuration("ACCOUNT_DELETION_GRACE_PERIOD", defaultDeletionGracePeriod),
+		PurgeInterval:  getDuration("ACCOUNT_PURGE_INTERVAL", defaultDeletionPurgeInterval),
+		PurgeBatchSize: getInt("ACCOUNT_PURGE_BATCH_SIZE", defaultDeletionPurgeBatchSize),
+	}
+
+	if cfg.GracePeriod < 0 {
+		cfg.GracePeriod = 0
+	}
+	// The interval drives a time.Ticker, which panics on non-positive values.
+	if cfg.PurgeInterval <= 0 {
+		cfg.PurgeInterval = defaultDeletionPurgeInterval
+	}
+	if cfg.PurgeBatchSize <= 0 {
+		cfg.PurgeBatchSize = defaultDeletionPurgeBatchSize
+	}
+	return cfg
+}
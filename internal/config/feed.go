@@ -0,0 +1,19 @@
+package config
+
+type FeedConfig struct {
+	// FanoutThreshold is the follower count from which an author's posts are
+	// no longer pushed into follower timelines but merged in at read time.
+	FanoutThreshold int
+	// MaxLength caps the number of post IDs kept in a single home timeline.
+	MaxLength int
+	// FanoutBatchSize is the number of followers loaded per fan-out round trip.
+	FanoutBatchSize int
+}
+
+func FeedCfg() FeedConfig {
+	return FeedConfig{
+		FanoutThreshold: getInt("FEED_FANOUT_THRESHOLD", 10000),
+		MaxLength:       getInt("FEED_MAX_LENGTH", 800),
+		FanoutBatchSize: getInt("FEED_FANOUT_BATCH_SIZE", 1000),
+	}
+}
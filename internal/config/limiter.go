@@ -1,4 +1,46 @@
 package config
 
+import "time"
+
+// RateLimitPolicy allows Limit requests per Window for every caller. A zero
+// limit or window turns the policy off.
+type RateLimitPolicy struct {
+	Limit  int
+	Window time.Duration
+}
+
+func (p RateLimitPolicy) Enabled() bool {
+	return p.Limit > 0 && p.Window > 0
+}
+
 type RateLimiterCfg struct {
+	Enabled         bool
+	Login           RateLimitPolicy
+	Register        RateLimitPolicy
+	ForgotPassword  RateLimitPolicy
+	MagicLink       RateLimitPolicy
+	VerifyEmail     RateLimitPolicy
+	EmailChange     RateLimitPolicy
+	PresignedUpload RateLimitPolicy
+}
+
+func LimiterCfg() RateLimiterCfg {
+	return RateLimiterCfg{
+		Enabled:         getBool("RATE_LIMIT_ENABLED", true),
+		Login:           getPolicy("RATE_LIMIT_LOGIN", 10, time.Minute),
+		Register:        getPolicy("RATE_LIMIT_REGISTER", 5, time.Hour),
+		ForgotPassword:  getPolicy("RATE_LIMIT_FORGOT_PASSWORD", 5, time.Hour),
+		MagicLink:       getPolicy("RATE_LIMIT_MAGIC_LINK", 5, time.Hour),
+		VerifyEmail:     getPolicy("RATE_LIMIT_VERIFY_EMAIL", 3, time.Hour),
+		EmailChange:     getPolicy("RATE_LIMIT_EMAIL_CHANGE", 3, time.Hour),
+		PresignedUpload: getPolicy("RATE_LIMIT_PRESIGNED_UPLOAD", 60, time.Minute),
+	}
+}
+
+// getPolicy reads <prefix>_LIMIT and <prefix>_WINDOW.
+func getPolicy(prefix string, limit int, window time.Duration) RateLimitPolicy {
+	return RateLimitPolicy{
+		Limit:  getInt(prefix+"_LIMIT", limit),
+		Window: getDuration(prefix+"_WINDOW", window),
+	}
 }
@@ -0,0 +1,33 @@
+package config
+
+import "time"
+
+type LockoutConfig struct {
+	// MaxFailures is the number of failed logins that locks an account.
+	MaxFailures int
+	// Duration is how long a locked account, or a blocked IP, stays so.
+	Duration time.Duration
+	// Window is how long a failed login counts after the last one.
+	Window time.Duration
+	// DelayAfter is the number of failed logins an account gets before each
+	// further attempt has to wait, BaseDelay at first and twice as long after
+	// every failure up to MaxDelay.
+	DelayAfter int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+	// IPMaxFailures is the number of failed logins, to any account, that
+	// blocks an IP.
+	IPMaxFailures int
+}
+
+func LockoutCfg() LockoutConfig {
+	return LockoutConfig{
+		MaxFailures:   getInt("LOGIN_MAX_FAILURES", 5),
+		Duration:      getDuration("LOGIN_LOCKOUT_DURATION", 15*time.Minute),
+		Window:        getDuration("LOGIN_FAILURE_WINDOW", 15*time.Minute),
+		DelayAfter:    getInt("LOGIN_DELAY_AFTER", 2),
+		BaseDelay:     getDuration("LOGIN_BASE_DELAY", time.Second),
+		MaxDelay:      getDuration("LOGIN_MAX_DELAY", 30*time.Second),
+		IPMaxFailures: getInt("LOGIN_IP_MAX_FAILURES", 30),
+	}
+}
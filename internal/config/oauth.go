@@ -0,0 +1,43 @@
+package config
+
+import "strings"
+
+type OAuthProviderConfig struct {
+	// Name is the provider in the login routes and user identities, e.g. "google".
+	Name string
+	// Issuer is the OpenID Connect issuer URL, where the endpoints of the
+	// provider are discovered.
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the page of the client app the provider sends users
+	// back to; it posts the code to the callback route.
+	RedirectURL string
+	Scopes      []string
+}
+
+type OAuthConfig struct {
+	Providers []OAuthProviderConfig
+}
+
+// OAuthCfg reads the providers listed in OAUTH_PROVIDERS, each configured by
+// OAUTH_<NAME>_ISSUER, _CLIENT_ID, _CLIENT_SECRET, _REDIRECT_URL and _SCOPES.
+func OAuthCfg() OAuthConfig {
+	var cfg OAuthConfig
+	for _, name := range getStrings("OAUTH_PROVIDERS") {
+		prefix := "OAUTH_" + strings.ToUpper(name)
+		scopes := getStrings(prefix + "_SCOPES")
+		if len(scopes) == 0 {
+			scopes = []string{"openid", "email", "profile"}
+		}
+		cfg.Providers = append(cfg.Providers, OAuthProviderConfig{
+			Name:         strings.ToLower(name),
+			Issuer:       getString(prefix+"_ISSUER", ""),
+			ClientID:     getString(prefix+"_CLIENT_ID", ""),
+			ClientSecret: getString(prefix+"_CLIENT_SECRET", ""),
+			RedirectURL:  getString(prefix+"_REDIRECT_URL", ""),
+			Scopes:       scopes,
+		})
+	}
+	return cfg
+}
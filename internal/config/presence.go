@@ -0,0 +1,25 @@
+package config
+
+import "time"
+
+const defaultPresenceTTL = 2 * time.Minute
+
+type PresenceConfig struct {
+	// TTL is how long a connection counts as online after its last heartbeat.
+	// Heartbeats arrive with every pong, so it must exceed WS_PING_INTERVAL.
+	TTL time.Duration
+	// MaxFanout caps the followers told when a user comes online or goes
+	// offline. Followers beyond it see the change on their next lookup.
+	MaxFanout int
+}
+
+func PresenceCfg() PresenceConfig {
+	cfg := PresenceConfig{
+		TTL:       getDuration("PRESENCE_TTL", defaultPresenceTTL),
+		MaxFanout: getInt("PRESENCE_MAX_FANOUT", 5000),
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = defaultPresenceTTL
+	}
+	return cfg
+}
@@ -0,0 +1,34 @@
+package config
+
+import "time"
+
+const (
+	defaultReactionReconcileInterval  = time.Minute
+	defaultReactionReconcileBatchSize = 500
+)
+
+type ReactionConfig struct {
+	// CounterTTL is how long an untouched counter stays cached in Redis.
+	CounterTTL time.Duration
+	// ReconcileInterval is how often dirty counters are rewritten from Postgres.
+	ReconcileInterval time.Duration
+	// ReconcileBatchSize caps the number of counters rewritten per round trip.
+	ReconcileBatchSize int
+}
+
+func ReactionCfg() ReactionConfig {
+	cfg := ReactionConfig{
+		CounterTTL:         getDuration("REACTION_COUNTER_TTL", 7*24*time.Hour),
+		ReconcileInterval:  getDuration("REACTION_RECONCILE_INTERVAL", defaultReactionReconcileInterval),
+		ReconcileBatchSize: getInt("REACTION_RECONCILE_BATCH_SIZE", defaultReactionReconcileBatchSize),
+	}
+
+	// The interval drives a time.Ticker, which panics on non-positive values.
+	if cfg.ReconcileInterval <= 0 {
+		cfg.ReconcileInterval = defaultReactionReconcileInterval
+	}
+	if cfg.ReconcileBatchSize <= 0 {
+		cfg.ReconcileBatchSize = defaultReactionReconcileBatchSize
+	}
+	return cfg
+}
@@ -1,25 +1,26 @@
 package config
 
 type ServerConfig struct {
-	Env          string
-	AppName      string
-	Protocol     string
-	Domain       string
-	Version      string
-	Port         string
-	AuthUsername string
-	AuthPassword string
+	Env      string
+	AppName  string
+	Protocol string
+	Domain   string
+	Version  string
+	Port     string
+	// TrustedProxies are the addresses whose X-Forwarded-For header is
+	// believed when resolving the client IP, e.g. the nginx gateway. Without
+	// them every request behind the gateway shares its IP.
+	TrustedProxies []string
 }
 
 func ServerCfg() ServerConfig {
 	return ServerConfig{
-		Env:          getString("APP_ENV", "development"),
-		AppName:      getString("APP_NAME", "air-social"),
-		Protocol:     getString("APP_PROTOCOL", "http"),
-		Domain:       getString("APP_DOMAIN", "localhost"),
-		Version:      getString("APP_VERSION", "v1"),
-		Port:         getString("APP_PORT", "8080"),
-		AuthUsername: getString("APP_BASIC_AUTH_USERNAME", "admin"),
-		AuthPassword: getString("APP_BASIC_AUTH_PASSWORD", "password"),
+		Env:            getString("APP_ENV", "development"),
+		AppName:        getString("APP_NAME", "air-social"),
+		Protocol:       getString("APP_PROTOCOL", "http"),
+		Domain:         getString("APP_DOMAIN", "localhost"),
+		Version:        getString("APP_VERSION", "v1"),
+		Port:           getString("APP_PORT", "8080"),
+		TrustedProxies: getStrings("APP_TRUSTED_PROXIES"),
 	}
 }
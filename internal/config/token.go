@@ -3,7 +3,11 @@ package config
 import "time"
 
 type TokenConfig struct {
-	Secret          string
+	// KeysDir holds the PEM keys that sign and verify access tokens, one per
+	// file named <kid>.pem. When empty a throwaway key is generated at start.
+	KeysDir string
+	// SigningKeyID is the kid of the key new access tokens are signed with.
+	SigningKeyID    string
 	Aud             string
 	Iss             string
 	AccessTokenTTL  time.Duration
@@ -12,7 +16,8 @@ type TokenConfig struct {
 
 func TokenCfg() TokenConfig {
 	return TokenConfig{
-		Secret:          getString("JWT_SECRET", "my_secret_key"),
+		KeysDir:         getString("JWT_KEYS_DIR", ""),
+		SigningKeyID:    getString("JWT_SIGNING_KEY_ID", "default"),
 		Aud:             getString("JWT_AUD", "air-social"),
 		Iss:             getString("JWT_ISS", "air-social-api"),
 		AccessTokenTTL:  getDuration("JWT_ACCESS_TTL", time.Minute*15),
@@ -0,0 +1,27 @@
+package config
+
+import "time"
+
+type TwoFactorConfig struct {
+	// Issuer names the account in authenticator apps.
+	Issuer string
+	// EncryptionKey encrypts the TOTP secrets at rest. Changing it turns
+	// 2FA off for everybody, as their secrets can no longer be read.
+	EncryptionKey string
+	// ChallengeTTL is how long a login has to complete its second step.
+	ChallengeTTL time.Duration
+	// MaxAttempts is the number of wrong codes that end a login challenge.
+	MaxAttempts int
+	// RecoveryCodes is the number of one-time recovery codes issued.
+	RecoveryCodes int
+}
+
+func TwoFactorCfg() TwoFactorConfig {
+	return TwoFactorConfig{
+		Issuer:        getString("TWO_FACTOR_ISSUER", "Air Social"),
+		EncryptionKey: getString("TWO_FACTOR_ENCRYPTION_KEY", "my_two_factor_key"),
+		ChallengeTTL:  getDuration("TWO_FACTOR_CHALLENGE_TTL", 5*time.Minute),
+		MaxAttempts:   getInt("TWO_FACTOR_MAX_ATTEMPTS", 5),
+		RecoveryCodes: getInt("TWO_FACTOR_RECOVERY_CODES", 10),
+	}
+}
@@ -0,0 +1,19 @@
+package config
+
+import "os"
+
+type VerificationConfig struct {
+	// RequiredFor lists the actions, such as posting, messaging and uploads,
+	// that only users with a verified email may take.
+	RequiredFor []string
+}
+
+func VerificationCfg() VerificationConfig {
+	// Set but empty gates nothing, so only an unset list takes the default.
+	requiredFor := []string{"posting", "messaging", "uploads"}
+	if _, ok := os.LookupEnv("VERIFIED_EMAIL_REQUIRED_FOR"); ok {
+		requiredFor = getStrings("VERIFIED_EMAIL_REQUIRED_FOR")
+	}
+
+	return VerificationConfig{RequiredFor: requiredFor}
+}
@@ -0,0 +1,45 @@
+package config
+
+import "time"
+
+const (
+	defaultWSPongTimeout  = time.Minute
+	defaultWSPingInterval = 54 * time.Second
+)
+
+type WSConfig struct {
+	// WriteTimeout bounds a single write to a connection.
+	WriteTimeout time.Duration
+	// PongTimeout is how long a connection may stay silent before it is
+	// considered dead. Pings are sent every PingInterval, which must be shorter.
+	PongTimeout  time.Duration
+	PingInterval time.Duration
+	// MaxMessageSize caps the size of a single inbound message in bytes.
+	MaxMessageSize int
+	// SendBufferSize is the number of outbound messages queued per connection.
+	// A client that lets its queue fill up is disconnected.
+	SendBufferSize int
+	// AllowedOrigins lists the origins allowed to open a connection, separated
+	// by commas. Empty only accepts same-origin requests; "*" accepts any.
+	AllowedOrigins string
+}
+
+func WSCfg() WSConfig {
+	cfg := WSConfig{
+		WriteTimeout:   getDuration("WS_WRITE_TIMEOUT", 10*time.Second),
+		PongTimeout:    getDuration("WS_PONG_TIMEOUT", defaultWSPongTimeout),
+		PingInterval:   getDuration("WS_PING_INTERVAL", defaultWSPingInterval),
+		MaxMessageSize: getInt("WS_MAX_MESSAGE_SIZE", 64*1024),
+		SendBufferSize: getInt("WS_SEND_BUFFER_SIZE", 256),
+		AllowedOrigins: getString("WS_ALLOWED_ORIGINS", ""),
+	}
+
+	// The ping interval drives a time.Ticker and must fire before the peer times out.
+	if cfg.PongTimeout <= 0 {
+		cfg.PongTimeout = defaultWSPongTimeout
+	}
+	if cfg.PingInterval <= 0 || cfg.PingInterval >= cfg.PongTimeout {
+		cfg.PingInterval = cfg.PongTimeout * 9 / 10
+	}
+	return cfg
+}
@@ -1,19 +1,32 @@
 package di
 
 import (
+	"fmt"
+
 	"air-social/internal/config"
 	"air-social/internal/domain"
 	"air-social/internal/infrastructure/mailer"
 	minioInfra "air-social/internal/infrastructure/minio"
+	"air-social/internal/infrastructure/oidc"
 	"air-social/internal/infrastructure/rabbitmq"
 	redisInfra "air-social/internal/infrastructure/redis"
+	"air-social/pkg"
 )
 
 type Adapters struct {
-	FileStorage domain.FileStorage
-	Cache       domain.CacheStorage
-	EventPub    domain.EventPublisher
-	MailSender  domain.EmailSender
+	FileStorage   domain.FileStorage
+	Cache         domain.CacheStorage
+	FeedStore     domain.FeedStore
+	Reactions     domain.ReactionCounter
+	Presence      domain.PresenceStore
+	Limiter       domain.RateLimiter
+	LoginAttempts domain.LoginAttemptStore
+	Denylist      domain.TokenDenylist
+	EventPub      domain.EventPublisher
+	MailSender    domain.EmailSender
+	SigningKeys   *pkg.KeySet
+	SecretBox     *pkg.SecretBox
+	OAuth         domain.OAuthProviders
 }
 
 func initAdapters(cfg config.Config, infra *Infrastructures) (*Adapters, error) {
@@ -27,6 +40,36 @@ func initAdapters(cfg config.Config, infra *Infrastructures) (*Adapters, error)
 		return nil, err
 	}
 
+	feedStore, err := redisInfra.NewFeedStore(infra.Redis, cfg.Feed.MaxLength)
+	if err != nil {
+		return nil, err
+	}
+
+	reactions, err := redisInfra.NewReactionCounter(infra.Redis, cfg.Reaction.CounterTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	presence, err := redisInfra.NewPresenceStore(infra.Redis, cfg.Presence.TTL)
+	if err != nil {
+		return nil, err
+	}
+
+	limiter, err := redisInfra.NewRateLimiter(infra.Redis)
+	if err != nil {
+		return nil, err
+	}
+
+	loginAttempts, err := redisInfra.NewLoginAttemptStore(infra.Redis)
+	if err != nil {
+		return nil, err
+	}
+
+	denylist, err := redisInfra.NewTokenDenylist(infra.Redis)
+	if err != nil {
+		return nil, err
+	}
+
 	eventPub, err := rabbitmq.NewEventPublisher(infra.Rabbit)
 	if err != nil {
 		return nil, err
@@ -34,10 +77,42 @@ func initAdapters(cfg config.Config, infra *Infrastructures) (*Adapters, error)
 
 	mailSender := mailer.NewMailtrap(cfg.Mailer)
 
+	signingKeys, err := loadSigningKeys(cfg.Server.Env, cfg.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	secretBox, err := pkg.NewSecretBox(cfg.TwoFA.EncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Adapters{
-		FileStorage: fileStorage,
-		Cache:       cache,
-		EventPub:    eventPub,
-		MailSender:  mailSender,
+		FileStorage:   fileStorage,
+		Cache:         cache,
+		FeedStore:     feedStore,
+		Reactions:     reactions,
+		Presence:      presence,
+		Limiter:       limiter,
+		LoginAttempts: loginAttempts,
+		Denylist:      denylist,
+		EventPub:      eventPub,
+		MailSender:    mailSender,
+		SigningKeys:   signingKeys,
+		SecretBox:     secretBox,
+		OAuth:         oidc.NewProviders(cfg.OAuth),
 	}, nil
 }
+
+func loadSigningKeys(env string, cfg config.TokenConfig) (*pkg.KeySet, error) {
+	if cfg.KeysDir != "" {
+		return pkg.LoadKeySet(cfg.KeysDir, cfg.SigningKeyID)
+	}
+	// Fine for development, but tokens do not survive a restart and other
+	// instances cannot verify them.
+	if env != pkg.DEVELOPMENT && env != pkg.DEBUG {
+		return nil, fmt.Errorf("JWT_KEYS_DIR must be set when APP_ENV is %q", env)
+	}
+	pkg.Log().Warnw("[CONFIG] JWT_KEYS_DIR is not set, signing with a throwaway key")
+	return pkg.GenerateKeySet(cfg.SigningKeyID)
+}
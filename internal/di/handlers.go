@@ -3,17 +3,41 @@ package di
 import "air-social/internal/transport/http/handler"
 
 type Handlers struct {
-	Auth   *handler.AuthHandler
-	User   *handler.UserHandler
-	Media  *handler.MediaHandler
-	Health *handler.HealthHandler
+	Auth     *handler.AuthHandler
+	User     *handler.UserHandler
+	Media    *handler.MediaHandler
+	Post     *handler.PostHandler
+	Follow   *handler.FollowHandler
+	Feed     *handler.FeedHandler
+	Comment  *handler.CommentHandler
+	Reaction *handler.ReactionHandler
+	Group    *handler.GroupHandler
+	Chat     *handler.ChatHandler
+	Presence *handler.PresenceHandler
+	Session  *handler.SessionHandler
+	TwoFA    *handler.TwoFactorHandler
+	Admin    *handler.AdminHandler
+	Key      *handler.KeyHandler
+	Health   *handler.HealthHandler
 }
 
 func initHandlers(services *Services) *Handlers {
 	return &Handlers{
-		Auth:   handler.NewAuthHandler(services.Auth),
-		User:   handler.NewUserHandler(services.User),
-		Media:  handler.NewMediaHandler(services.Media),
-		Health: handler.NewHealthHandler(services.Health),
+		Auth:     handler.NewAuthHandler(services.Auth),
+		User:     handler.NewUserHandler(services.User),
+		Media:    handler.NewMediaHandler(services.Media),
+		Post:     handler.NewPostHandler(services.Post),
+		Follow:   handler.NewFollowHandler(services.Follow),
+		Feed:     handler.NewFeedHandler(services.Feed),
+		Comment:  handler.NewCommentHandler(services.Comment),
+		Reaction: handler.NewReactionHandler(services.Reaction),
+		Group:    handler.NewGroupHandler(services.Group),
+		Chat:     handler.NewChatHandler(services.Chat),
+		Presence: handler.NewPresenceHandler(services.Presence),
+		Session:  handler.NewSessionHandler(services.Token),
+		TwoFA:    handler.NewTwoFactorHandler(services.TwoFA),
+		Admin:    handler.NewAdminHandler(services.Admin),
+		Key:      handler.NewKeyHandler(services.Token),
+		Health:   handler.NewHealthHandler(services.Health),
 	}
 }
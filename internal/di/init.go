@@ -36,17 +36,24 @@ func Initialize(cfg config.Config) (*Container, func(), error) {
 		return handleError(err)
 	}
 
+	broker, err := ws.NewRedisBroker(infrastructures.Redis)
+	if err != nil {
+		return handleError(err)
+	}
+	hub := ws.NewHub(cfg.WS, broker)
 	repositories := initRepository(infrastructures)
-	services := initServices(cfg, url, infrastructures, repositories, adapters)
+	services := initServices(cfg, url, infrastructures, repositories, adapters, hub, hub)
 	handlers := initHandlers(services)
-	middlewares := middleware.NewManager(cfg.Server, services.Token)
+	ws.NewChatHandler(services.Chat).Register(hub)
+	hub.TrackPresence(services.Presence)
+	middlewares := middleware.NewManager(cfg, services.Token, services.User, adapters.Limiter)
 
-	server := transport.NewServer(cfg, url, middlewares, handlers.Auth, handlers.User, handlers.Media, handlers.Health)
+	server := transport.NewServer(cfg, url, middlewares, handlers.Auth, handlers.User, handlers.Media, handlers.Post, handlers.Follow, handlers.Feed, handlers.Comment, handlers.Reaction, handlers.Group, handlers.Chat, handlers.Presence, handlers.Session, handlers.TwoFA, handlers.Admin, handlers.Key, handlers.Health, hub)
 
 	return &Container{
 		Server: server,
-		Worker: initWorkers(infrastructures, adapters, services),
-		Hub:    ws.NewHub(),
+		Worker: initWorkers(cfg, infrastructures, adapters, services),
+		Hub:    hub,
 		Infra:  infrastructures,
 	}, cleanup, nil
 }
@@ -6,13 +6,31 @@ import (
 )
 
 type Repositories struct {
-	User  domain.UserRepository
-	Token domain.TokenRepository
+	User         domain.UserRepository
+	Token        domain.TokenRepository
+	Post         domain.PostRepository
+	Follow       domain.FollowRepository
+	Comment      domain.CommentRepository
+	Reaction     domain.ReactionRepository
+	Group        domain.GroupRepository
+	Conversation domain.ConversationRepository
+	Message      domain.MessageRepository
+	AuditLog     domain.AuditLogRepository
+	TwoFactor    domain.TwoFactorRepository
 }
 
 func initRepository(infra *Infrastructures) *Repositories {
 	return &Repositories{
-		User:  postgres.NewUserRepository(infra.DB),
-		Token: postgres.NewTokenRepository(infra.DB),
+		User:         postgres.NewUserRepository(infra.DB),
+		Token:        postgres.NewTokenRepository(infra.DB),
+		Post:         postgres.NewPostRepository(infra.DB),
+		Follow:       postgres.NewFollowRepository(infra.DB),
+		Comment:      postgres.NewCommentRepository(infra.DB),
+		Reaction:     postgres.NewReactionRepository(infra.DB),
+		Group:        postgres.NewGroupRepository(infra.DB),
+		Conversation: postgres.NewConversationRepository(infra.DB),
+		Message:      postgres.NewMessageRepository(infra.DB),
+		AuditLog:     postgres.NewAuditLogRepository(infra.DB),
+		TwoFactor:    postgres.NewTwoFactorRepository(infra.DB),
 	}
 }
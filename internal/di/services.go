@@ -8,12 +8,22 @@ import (
 )
 
 type Services struct {
-	Media  service.MediaService
-	Health service.HealthService
-	Token  service.TokenService
-	User   service.UserService
-	Auth   service.AuthService
-	Email  service.EmailService
+	Media    service.MediaService
+	Health   service.HealthService
+	Token    service.TokenService
+	User     service.UserService
+	Auth     service.AuthService
+	Email    service.EmailService
+	Post     service.PostService
+	Follow   service.FollowService
+	Feed     service.FeedService
+	Comment  service.CommentService
+	Reaction service.ReactionService
+	Group    service.GroupService
+	Chat     service.ChatService
+	Presence service.PresenceService
+	Admin    service.AdminService
+	TwoFA    service.TwoFactorService
 }
 
 func initServices(
@@ -22,6 +32,8 @@ func initServices(
 	infra *Infrastructures,
 	repository *Repositories,
 	adapter *Adapters,
+	realtime domain.RealtimeSender,
+	disconnector domain.RealtimeDisconnector,
 ) *Services {
 
 	mediaSvc := service.NewMediaService(adapter.FileStorage, adapter.Cache, domain.FileConfig{
@@ -35,17 +47,37 @@ func initServices(
 		URL:  cfg.RabbitMQ.URL,
 	}, infra.Minio, url)
 
-	tokenSvc := service.NewTokenService(repository.Token, cfg.Token)
-	userSvc := service.NewUserService(repository.User, mediaSvc)
-	authSvc := service.NewAuthService(userSvc, tokenSvc, url, adapter.EventPub, adapter.Cache)
+	tokenSvc := service.NewTokenService(repository.Token, adapter.Denylist, disconnector, adapter.SigningKeys, cfg.Token)
+	userSvc := service.NewUserService(repository.User, tokenSvc, mediaSvc, adapter.Reactions, cfg.Deletion)
+	twoFactorSvc := service.NewTwoFactorService(repository.TwoFactor, userSvc, adapter.SecretBox, cfg.TwoFA)
+	authSvc := service.NewAuthService(userSvc, tokenSvc, twoFactorSvc, adapter.OAuth, url, adapter.EventPub, adapter.Cache, adapter.LoginAttempts, cfg.Lockout, cfg.TwoFA)
 	emailSvc := service.NewEmailService(adapter.MailSender)
+	followSvc := service.NewFollowService(repository.Follow, userSvc, mediaSvc)
+	groupSvc := service.NewGroupService(repository.Group, userSvc, mediaSvc)
+	reactionSvc := service.NewReactionService(repository.Reaction, adapter.Reactions, repository.Post, repository.Comment, followSvc, groupSvc, mediaSvc, cfg.Reaction)
+	postSvc := service.NewPostService(repository.Post, followSvc, groupSvc, reactionSvc, mediaSvc, adapter.EventPub)
+	feedSvc := service.NewFeedService(adapter.FeedStore, followSvc, groupSvc, repository.Post, userSvc, reactionSvc, mediaSvc, cfg.Feed)
+	commentSvc := service.NewCommentService(repository.Comment, postSvc, reactionSvc, mediaSvc)
+	chatSvc := service.NewChatService(repository.Conversation, repository.Message, userSvc, mediaSvc, realtime)
+	presenceSvc := service.NewPresenceService(adapter.Presence, userSvc, followSvc, repository.Conversation, realtime, cfg.Presence)
+	adminSvc := service.NewAdminService(repository.User, repository.AuditLog, tokenSvc, mediaSvc)
 
 	return &Services{
-		Media:  mediaSvc,
-		Health: healthSvc,
-		Token:  tokenSvc,
-		User:   userSvc,
-		Auth:   authSvc,
-		Email:  emailSvc,
+		Media:    mediaSvc,
+		Health:   healthSvc,
+		Token:    tokenSvc,
+		User:     userSvc,
+		Auth:     authSvc,
+		Email:    emailSvc,
+		Post:     postSvc,
+		Follow:   followSvc,
+		Feed:     feedSvc,
+		Comment:  commentSvc,
+		Reaction: reactionSvc,
+		Group:    groupSvc,
+		Chat:     chatSvc,
+		Presence: presenceSvc,
+		Admin:    adminSvc,
+		TwoFA:    twoFactorSvc,
 	}
 }
@@ -1,12 +1,16 @@
 package di
 
 import (
+	"air-social/internal/config"
 	"air-social/internal/infrastructure/rabbitmq"
 	"air-social/internal/transport/worker"
 	"air-social/internal/transport/worker/email"
+	"air-social/internal/transport/worker/feed"
+	"air-social/internal/transport/worker/periodic"
 )
 
 func initWorkers(
+	cfg config.Config,
 	infra *Infrastructures,
 	adapters *Adapters,
 	services *Services,
@@ -29,5 +33,42 @@ func initWorkers(
 		rabbitmq.EmailResetPasswordQueueConfig,
 	)
 
-	return worker.NewManager(verifyWorker, resetWorker)
+	lockedWorker := email.NewEmailWorker(
+		infra.Rabbit,
+		adapters.Cache,
+		services.Email,
+		exchangeCfg,
+		rabbitmq.EmailAccountLockedQueueConfig,
+	)
+
+	magicLinkWorker := email.NewEmailWorker(
+		infra.Rabbit,
+		adapters.Cache,
+		services.Email,
+		exchangeCfg,
+		rabbitmq.EmailMagicLinkQueueConfig,
+	)
+
+	changeWorker := email.NewEmailWorker(
+		infra.Rabbit,
+		adapters.Cache,
+		services.Email,
+		exchangeCfg,
+		rabbitmq.EmailChangeQueueConfig,
+	)
+
+	fanoutWorker := feed.NewFanoutWorker(
+		infra.Rabbit,
+		services.Feed,
+		exchangeCfg,
+		rabbitmq.FeedFanoutQueueConfig,
+	)
+
+	// Rewrites the cached reaction counters that changed since the previous
+	// run, so drift from failed increments never outlives an interval.
+	reconcileWorker := periodic.NewWorker("reaction_reconcile", services.Reaction.Reconcile, cfg.Reaction.ReconcileInterval)
+
+	purgeWorker := periodic.NewWorker("account_purge", services.User.PurgeDeletedAccounts, cfg.Deletion.PurgeInterval)
+
+	return worker.NewManager(verifyWorker, resetWorker, lockedWorker, magicLinkWorker, changeWorker, fanoutWorker, reconcileWorker, purgeWorker)
 }
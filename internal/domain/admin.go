@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+type AuditLogRepository interface {
+	Create(ctx context.Context, entry *AuditLog) error
+	// List pages through the entries matching filter, newest first.
+	List(ctx context.Context, filter AuditLogFilter) ([]AuditLog, error)
+}
+
+// AuditAction names what a staff member did through the admin API.
+type AuditAction string
+
+const (
+	AuditUsersSearch      AuditAction = "users.search"
+	AuditUserView         AuditAction = "user.view"
+	AuditUserSessionsView AuditAction = "user.sessions.view"
+	AuditUserLogout       AuditAction = "user.logout"
+	AuditUserSuspend      AuditAction = "user.suspend"
+	AuditUserBan          AuditAction = "user.ban"
+	AuditUserUnban        AuditAction = "user.unban"
+	AuditUserVerifyEmail  AuditAction = "user.verify_email"
+	AuditUserResetImage   AuditAction = "user.reset_image"
+)
+
+// AuditLog records one admin action. The actor and target are nil once their
+// accounts are deleted.
+type AuditLog struct {
+	ID           int64       `db:"id"`
+	ActorID      *int64      `db:"actor_id"`
+	TargetUserID *int64      `db:"target_user_id"`
+	Action       AuditAction `db:"action"`
+	// Details is a JSON object with the input of the action.
+	Details   []byte    `db:"details"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type AuditLogFilter struct {
+	ActorID      int64 // 0 means any
+	TargetUserID int64 // 0 means any
+	BeforeID     int64 // 0 means from the newest
+	Limit        int
+}
+
+// AdminActor is the staff member performing an admin action.
+type AdminActor struct {
+	ID   int64
+	Role UserRole
+}
+
+type AdminSearchUsersRequest struct {
+	PageRequest
+	Query  string     `form:"q" binding:"max=100"`
+	Role   UserRole   `form:"role" binding:"omitempty,oneof=user moderator admin"`
+	Status UserStatus `form:"status" binding:"omitempty,oneof=active suspended banned"`
+}
+
+type SuspendUserRequest struct {
+	Until  time.Time `json:"until" binding:"required"`
+	Reason string    `json:"reason" binding:"required,max=500"`
+}
+
+type BanUserRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+type UnbanUserRequest struct {
+	Reason string `json:"reason" binding:"max=500"`
+}
+
+type ResetProfileImageRequest struct {
+	Feature UploadFeature `form:"feature" binding:"required,oneof=avatar cover"`
+}
+
+type ListAuditLogsRequest struct {
+	PageRequest
+	ActorID      int64 `form:"actor_id" binding:"omitempty,min=1"`
+	TargetUserID int64 `form:"user_id" binding:"omitempty,min=1"`
+}
+
+// AdminUserResponse is the user as staff see it, with the account state.
+type AdminUserResponse struct {
+	UserResponse
+	Status         UserStatus `json:"status"`
+	SuspendedUntil *time.Time `json:"suspended_until,omitempty"`
+	DeleteAfter    *time.Time `json:"delete_after,omitempty"`
+	VerifiedAt     *time.Time `json:"verified_at,omitempty"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+type AuditLogResponse struct {
+	ID           int64           `json:"id"`
+	ActorID      *int64          `json:"actor_id"`
+	TargetUserID *int64          `json:"target_user_id"`
+	Action       AuditAction     `json:"action"`
+	Details      json.RawMessage `json:"details" swaggertype:"object"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+type AdminSearchUsersParams struct {
+	Actor  AdminActor
+	Query  string
+	Role   UserRole
+	Status UserStatus
+	Page   PageParams
+}
+
+// AdminUserParams names the user Actor acts on.
+type AdminUserParams struct {
+	Actor  AdminActor
+	UserID int64
+}
+
+type SuspendUserParams struct {
+	Actor  AdminActor
+	UserID int64
+	Until  time.Time
+	Reason string
+}
+
+// ModerateUserParams is a ban or unban of UserID.
+type ModerateUserParams struct {
+	Actor  AdminActor
+	UserID int64
+	Reason string
+}
+
+type ResetProfileImageParams struct {
+	Actor   AdminActor
+	UserID  int64
+	Feature UploadFeature
+}
+
+type ListAuditLogsParams struct {
+	ActorID      int64
+	TargetUserID int64
+	Page         PageParams
+}
+
+func (a *AuditLog) ToResponse() AuditLogResponse {
+	details := json.RawMessage(a.Details)
+	if len(details) == 0 {
+		details = json.RawMessage("{}")
+	}
+	return AuditLogResponse{
+		ID:           a.ID,
+		ActorID:      a.ActorID,
+		TargetUserID: a.TargetUserID,
+		Action:       a.Action,
+		Details:      details,
+		CreatedAt:    a.CreatedAt,
+	}
+}
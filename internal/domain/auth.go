@@ -1,5 +1,38 @@
 package domain
 
+import (
+	"context"
+	"time"
+)
+
+// LoginAttemptStore tracks failed logins per email and per client IP across
+// instances. An empty email or ip is skipped by every method.
+type LoginAttemptStore interface {
+	// Throttle returns how long logins of email and from ip are held off.
+	Throttle(ctx context.Context, email, ip string) (LoginThrottle, error)
+	// Fail counts a failed login against email and ip and returns the new
+	// counts. Each count expires window after its last failure.
+	Fail(ctx context.Context, email, ip string, window time.Duration) (LoginAttempts, error)
+	// Delay holds off logins of email and from ip for d.
+	Delay(ctx context.Context, email, ip string, d time.Duration) error
+	// Lock locks the account of email out for d and clears its count.
+	Lock(ctx context.Context, email string, d time.Duration) error
+	// Reset clears the counts, delays and lock of email and ip.
+	Reset(ctx context.Context, email, ip string) error
+}
+
+type LoginAttempts struct {
+	EmailFailures int
+	IPFailures    int
+}
+
+type LoginThrottle struct {
+	// Locked is how long the account stays locked out.
+	Locked time.Duration
+	// Delayed is how long until the next attempt is accepted.
+	Delayed time.Duration
+}
+
 type RegisterRequest struct {
 	Email    string `json:"email" binding:"required,email,max=255"`
 	Username string `json:"username" binding:"required,min=3,max=30"`
@@ -9,7 +42,9 @@ type RegisterRequest struct {
 type LoginRequest struct {
 	Email    string `json:"email" binding:"required,email,max=255"`
 	Password string `json:"password" binding:"required,min=8,max=64"`
-	DeviceID string `json:"device_id" binding:"required"`
+	DeviceID string `json:"device_id" binding:"required,max=255"`
+	// DeviceName is a label for the session list, e.g. "Pixel 8".
+	DeviceName string `json:"device_name" binding:"max=100"`
 }
 
 type RefreshRequest struct {
@@ -24,26 +59,55 @@ type ForgotPasswordRequest struct {
 	Email string `json:"email" binding:"required,email"`
 }
 
+// MagicLinkRequest asks for a sign-in link by email. The session it opens
+// is for the device that asked.
+type MagicLinkRequest struct {
+	Email    string `json:"email" binding:"required,email,max=255"`
+	DeviceID string `json:"device_id" binding:"required,max=255"`
+	// DeviceName is a label for the session list, e.g. "Pixel 8".
+	DeviceName string `json:"device_name" binding:"max=100"`
+}
+
 type ResetPasswordRequest struct {
 	Token    string `json:"token" binding:"required"`
 	Password string `json:"password" binding:"required,min=8,max=64"`
 }
 
+// LoginResponse holds either the session of the user or, when the account
+// has 2FA on, the challenge to complete at /auth/2fa/verify.
 type LoginResponse struct {
-	User  UserResponse `json:"user"`
-	Token TokenInfo    `json:"token"`
+	User      UserResponse        `json:"user,omitzero"`
+	Token     TokenInfo           `json:"token,omitzero"`
+	TwoFactor *TwoFactorChallenge `json:"two_factor,omitempty"`
 }
 
 type AuthClaims struct {
 	UserID   int64
 	DeviceID string
-	Role     int64
+	Role     UserRole
+	// TokenID is the jti of the access token, empty for tokens issued
+	// before it was added.
+	TokenID string
+	// SessionID is the session the token was issued for, empty for tokens
+	// issued before it was added.
+	SessionID string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
 }
 
 type LoginParams struct {
-	Email    string
-	Password string
-	DeviceID string
+	Email      string
+	Password   string
+	DeviceID   string
+	DeviceName string
+	UserAgent  string
+	IP         string
+}
+
+type RefreshParams struct {
+	RefreshToken string
+	UserAgent    string
+	IP           string
 }
 
 type RegisterParams struct {
@@ -56,6 +120,28 @@ type LogoutParams struct {
 	UserID       int64
 	DeviceID     string
 	IsAllDevices bool
+	// The access token of the request, revoked on logout.
+	TokenID        string
+	TokenExpiresAt time.Time
+}
+
+type MagicLinkParams struct {
+	Email      string
+	DeviceID   string
+	DeviceName string
+}
+
+type MagicLinkLoginParams struct {
+	Token     string
+	UserAgent string
+	IP        string
+}
+
+// MagicLink is a sign-in link waiting to be used, cached under its token.
+type MagicLink struct {
+	Email      string `json:"email"`
+	DeviceID   string `json:"device_id"`
+	DeviceName string `json:"device_name"`
 }
 
 type ResetPasswordParams struct {
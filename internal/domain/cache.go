@@ -12,7 +12,28 @@ const (
 	WorkerEmailVerify    = "worker:email:verify:"
 	WorkerEmailReset     = "worker:email:reset:"
 	WorkerEmailRetry     = "worker:email:retry:"
+	WorkerEmailUnlock    = "worker:email:unlock:"
+	WorkerEmailMagicLink = "worker:email:magic_link:"
+	WorkerEmailChange    = "worker:email:change:"
 	UploadImageVerify    = "upload:verify:"
+	FeedHomeTimeline     = "feed:home:timeline:"
+	ReactionCount        = "reaction:count:"
+	ReactionDirtySet     = "reaction:dirty:targets"
+	WSUserChannel        = "ws:user:"
+	WSUserCloseChannel   = "ws:close:"
+	PresenceConns        = "presence:conns:"
+	PresenceLastSeen     = "presence:seen:"
+	RateLimitBucket      = "ratelimit:"
+	LoginFailures        = "login:failures:"
+	LoginDelay           = "login:delay:"
+	LoginLock            = "login:lock:"
+	TokenDenied          = "token:denied:"
+	TokenSessionDenied   = "token:session_denied:"
+	TokenRevokedBefore   = "token:revoked_before_ms:"
+	LoginTwoFactor       = "login:2fa:"
+	LoginOAuthState      = "login:oauth:"
+	LoginMagicLink       = "login:magic_link:"
+	UserEmailChange      = "user:email_change:"
 )
 
 const (
@@ -48,6 +69,116 @@ func GetEmailRetryKey(token string) string {
 	return fmt.Sprintf(WorkerEmailRetry+"%s", token)
 }
 
+func GetAccountUnlockKey(token string) string {
+	return fmt.Sprintf(WorkerEmailUnlock+"%s", token)
+}
+
+func GetMagicLinkKey(token string) string {
+	return WorkerEmailMagicLink + token
+}
+
+// GetMagicLinkTokenKey holds the token of the last sign-in link sent to
+// email, the only one that still works.
+func GetMagicLinkTokenKey(email string) string {
+	return LoginMagicLink + email
+}
+
+// GetEmailChangeTokenKey holds the user whose email change the confirm or
+// cancel token belongs to.
+func GetEmailChangeTokenKey(token string) string {
+	return WorkerEmailChange + token
+}
+
+// GetEmailChangeKey holds the pending email change of the user. Asking
+// again replaces it, voiding the links sent before.
+func GetEmailChangeKey(userID int64) string {
+	return fmt.Sprintf(UserEmailChange+"%d", userID)
+}
+
 func GetUploadImageKey(objectName string) string {
 	return fmt.Sprintf(UploadImageVerify+"%s", objectName)
 }
+
+func GetHomeTimelineKey(userID int64) string {
+	return fmt.Sprintf(FeedHomeTimeline+"%d", userID)
+}
+
+func GetReactionCountKey(target ReactionTarget) string {
+	return ReactionCount + target.String()
+}
+
+// GetWSUserChannelKey is the pub/sub channel carrying realtime frames for userID.
+func GetWSUserChannelKey(userID int64) string {
+	return fmt.Sprintf(WSUserChannel+"%d", userID)
+}
+
+// GetWSUserCloseChannelKey is the pub/sub channel asking every instance to
+// close the connections of userID.
+func GetWSUserCloseChannelKey(userID int64) string {
+	return fmt.Sprintf(WSUserCloseChannel+"%d", userID)
+}
+
+// GetPresenceConnsKey is the sorted set of live connections of userID, scored
+// by the time each one expires.
+func GetPresenceConnsKey(userID int64) string {
+	return fmt.Sprintf(PresenceConns+"%d", userID)
+}
+
+func GetPresenceLastSeenKey(userID int64) string {
+	return fmt.Sprintf(PresenceLastSeen+"%d", userID)
+}
+
+// GetRateLimitKey is the bucket of subject, a user or client IP, under the
+// named policy.
+func GetRateLimitKey(policy, subject string) string {
+	return RateLimitBucket + policy + ":" + subject
+}
+
+// GetLoginFailuresKey counts the failed logins of subject, an email or IP as
+// built by LoginEmailSubject and LoginIPSubject.
+func GetLoginFailuresKey(subject string) string {
+	return LoginFailures + subject
+}
+
+func GetLoginDelayKey(subject string) string {
+	return LoginDelay + subject
+}
+
+func GetLoginLockKey(email string) string {
+	return LoginLock + email
+}
+
+// GetDeniedTokenKey marks the access token with ID jti as revoked.
+func GetDeniedTokenKey(jti string) string {
+	return TokenDenied + jti
+}
+
+// GetDeniedSessionKey marks the access tokens of the session as revoked.
+func GetDeniedSessionKey(sessionID string) string {
+	return TokenSessionDenied + sessionID
+}
+
+// GetTokensRevokedBeforeKey holds the Unix time in milliseconds up to which
+// every access token of the user is revoked.
+func GetTokensRevokedBeforeKey(userID int64) string {
+	return fmt.Sprintf("%s%d", TokenRevokedBefore, userID)
+}
+
+// GetTwoFactorChallengeKey holds a login waiting for its second step under
+// the challenge token.
+func GetTwoFactorChallengeKey(token string) string {
+	return LoginTwoFactor + token
+}
+
+// GetOAuthStateKey holds a login waiting for the provider under its state.
+func GetOAuthStateKey(state string) string {
+	return LoginOAuthState + state
+}
+
+func LoginEmailSubject(email string) string {
+	return "email:" + email
+}
+
+func LoginIPSubject(ip string) string {
+	return "ip:" + ip
+}
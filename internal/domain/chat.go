@@ -1 +1,385 @@
 package domain
+
+import (
+	"context"
+	"time"
+)
+
+type ConversationRepository interface {
+	// GetOrCreateDirect returns the direct conversation between two users,
+	// creating it together with both memberships when it does not exist yet.
+	GetOrCreateDirect(ctx context.Context, userID, otherID int64) (*Conversation, error)
+	GetByID(ctx context.Context, id int64) (*Conversation, error)
+	GetMember(ctx context.Context, conversationID, userID int64) (*ConversationMember, error)
+	// ListByMember returns the conversations of a user, most recently active first.
+	ListByMember(ctx context.Context, filter ConversationListFilter) ([]Conversation, error)
+	// ListMembers returns the members of all given conversations.
+	ListMembers(ctx context.Context, conversationIDs []int64) ([]ConversationMember, error)
+	ListMemberIDs(ctx context.Context, conversationID int64) ([]int64, error)
+	// ListPeerIDs returns the users sharing at least one conversation with userID.
+	ListPeerIDs(ctx context.Context, userID int64) ([]int64, error)
+
+	// The group mutations below store their system messages in the same
+	// transaction, assigning Seq like MessageRepository.Create, so the history
+	// always matches the membership.
+
+	// CreateGroup stores conv with its members and fills in conv.ID and the
+	// conversation of every event.
+	CreateGroup(ctx context.Context, conv *Conversation, members []ConversationMember, events []*Message) error
+	// Update stores the title and avatar of conv.
+	Update(ctx context.Context, conv *Conversation, events []*Message) error
+	// AddMembers adds userIDs as plain members, skipping existing ones.
+	AddMembers(ctx context.Context, conversationID int64, userIDs []int64, events []*Message) error
+	// RemoveMember removes a member. When the owner leaves, the longest
+	// standing admin, or else member, becomes the owner; the conversation is
+	// deleted once its last member is gone.
+	RemoveMember(ctx context.Context, conversationID, userID int64, event *Message) error
+	UpdateMemberRole(ctx context.Context, conversationID, userID int64, role MemberRole, event *Message) error
+
+	// MarkDelivered and MarkRead move the receipt position of a member forward
+	// to seq, capped at the newest message. Reading implies delivery. They
+	// return the new position, or 0 when it did not advance.
+	MarkDelivered(ctx context.Context, conversationID, userID, seq int64) (int64, error)
+	MarkRead(ctx context.Context, conversationID, userID, seq int64) (int64, error)
+}
+
+type MessageRepository interface {
+	// Create assigns the next sequence number of the conversation to the
+	// message and stores it. Sequence numbers start at 1 and have no gaps.
+	Create(ctx context.Context, msg *Message) error
+	List(ctx context.Context, filter MessageListFilter) ([]Message, error)
+	// ListByIDs returns the existing messages among ids.
+	ListByIDs(ctx context.Context, ids []int64) ([]Message, error)
+}
+
+type ConversationType string
+
+const (
+	ConversationDirect ConversationType = "direct"
+	ConversationGroup  ConversationType = "group"
+)
+
+// MaxGroupMembers caps the size of a group, creator included.
+const MaxGroupMembers = 256
+
+type MemberRole string
+
+const (
+	RoleOwner  MemberRole = "owner"
+	RoleAdmin  MemberRole = "admin"
+	RoleMember MemberRole = "member"
+)
+
+var memberRoleRank = map[MemberRole]int{
+	RoleMember: 1,
+	RoleAdmin:  2,
+	RoleOwner:  3,
+}
+
+// CanManage reports whether the role may rename the group, change its avatar
+// and add members.
+func (r MemberRole) CanManage() bool {
+	return r == RoleOwner || r == RoleAdmin
+}
+
+// Outranks reports whether a member with role r may remove one with role o.
+func (r MemberRole) Outranks(o MemberRole) bool {
+	return memberRoleRank[r] > memberRoleRank[o]
+}
+
+type MessageType string
+
+const (
+	MessageText  MessageType = "text"
+	MessageImage MessageType = "image"
+	MessageVoice MessageType = "voice"
+	// MessageSystem records a change to the group. Event says what happened,
+	// SenderID who did it and TargetID whom it affected, if anyone; Content
+	// is a readable summary such as "alice added bob".
+	MessageSystem MessageType = "system"
+)
+
+type SystemEvent string
+
+const (
+	EventGroupCreated  SystemEvent = "group.created"
+	EventGroupRenamed  SystemEvent = "group.renamed"
+	EventAvatarChanged SystemEvent = "group.avatar_changed"
+	EventMemberAdded   SystemEvent = "member.added"
+	EventMemberRemoved SystemEvent = "member.removed"
+	EventMemberLeft    SystemEvent = "member.left"
+	EventRoleChanged   SystemEvent = "member.role_changed"
+)
+
+const (
+	RealtimeMessageNew       RealtimeType = "message.new"
+	RealtimeMessageDelivered RealtimeType = "message.delivered"
+	RealtimeMessageRead      RealtimeType = "message.read"
+	// RealtimeTyping is relayed to the other members as is and never stored.
+	RealtimeTyping RealtimeType = "typing"
+)
+
+// Conversation is a chat between its members. LastMessageID orders the inbox:
+// message IDs are global and increasing, so the most recently active
+// conversations have the highest value. LastSeq is the sequence number of the
+// newest message, 0 while the conversation is empty.
+type Conversation struct {
+	ID            int64            `db:"id"`
+	Type          ConversationType `db:"type"`
+	Title         string           `db:"title"`
+	Avatar        string           `db:"avatar"`
+	LastSeq       int64            `db:"last_seq"`
+	LastMessageID int64            `db:"last_message_id"`
+	CreatedAt     time.Time        `db:"created_at"`
+	UpdatedAt     time.Time        `db:"updated_at"`
+}
+
+// ConversationMember is the membership of a user. LastReadSeq and
+// LastDeliveredSeq are the receipt positions of the user: every message up to
+// that sequence number has been read or delivered. A member's own messages
+// count as read.
+type ConversationMember struct {
+	ConversationID   int64       `db:"conversation_id"`
+	UserID           int64       `db:"user_id"`
+	Role             MemberRole  `db:"role"`
+	LastReadSeq      int64       `db:"last_read_seq"`
+	LastDeliveredSeq int64       `db:"last_delivered_seq"`
+	JoinedAt         time.Time   `db:"joined_at"`
+	User             UserSummary `db:"user"`
+}
+
+// Message is one entry of a conversation. Seq is assigned by the server and
+// increases by one per message within the conversation, so a client that sees
+// a jump in Seq knows it missed messages and can resync with AfterSeq. The
+// messages of a purged account stay, blanked and with a SenderID of 0, so the
+// sequence has no holes.
+type Message struct {
+	ID             int64         `db:"id"`
+	ConversationID int64         `db:"conversation_id"`
+	SenderID       int64         `db:"sender_id"`
+	Seq            int64         `db:"seq"`
+	Type           MessageType   `db:"type"`
+	Content        string        `db:"content"`
+	ObjectKey      *string       `db:"object_key"`
+	Feature        UploadFeature `db:"feature"`
+	Event          SystemEvent   `db:"event"`
+	TargetID       *int64        `db:"target_id"`
+	CreatedAt      time.Time     `db:"created_at"`
+}
+
+type ConversationListFilter struct {
+	UserID int64
+	// Cursor keys of the last conversation of the previous page.
+	// BeforeID of 0 means from the first page.
+	BeforeLastMessageID int64
+	BeforeID            int64
+	Limit               int
+}
+
+// MessageListFilter pages through a conversation. With AfterSeq set, messages
+// newer than AfterSeq are returned oldest first; otherwise messages older than
+// BeforeSeq (0 means from the newest) are returned newest first.
+type MessageListFilter struct {
+	ConversationID int64
+	BeforeSeq      int64
+	AfterSeq       *int64
+	Limit          int
+}
+
+type CreateDirectConversationRequest struct {
+	UserID int64 `json:"user_id" binding:"required,min=1"`
+}
+
+type CreateGroupChatRequest struct {
+	Title     string  `json:"title" binding:"required,min=1,max=100"`
+	MemberIDs []int64 `json:"member_ids" binding:"required,min=1,max=255,dive,min=1"`
+}
+
+type UpdateGroupChatRequest struct {
+	Title *string `json:"title" binding:"omitempty,min=1,max=100"`
+	// Avatar is an object key uploaded with domain "messages" and feature
+	// "avatar"; an empty string removes the avatar.
+	Avatar *string `json:"avatar"`
+}
+
+type AddMembersRequest struct {
+	UserIDs []int64 `json:"user_ids" binding:"required,min=1,max=100,dive,min=1"`
+}
+
+type UpdateMemberRoleRequest struct {
+	Role MemberRole `json:"role" binding:"required,oneof=admin member"`
+}
+
+type ReceiptRequest struct {
+	Seq int64 `json:"seq" binding:"required,min=1"`
+}
+
+type MessageAttachmentItem struct {
+	ObjectKey string        `json:"object_key" binding:"required"`
+	Feature   UploadFeature `json:"feature" binding:"required,oneof=feed_image voice_chat"`
+}
+
+type SendMessageRequest struct {
+	Content    string                 `json:"content" binding:"max=5000"`
+	Attachment *MessageAttachmentItem `json:"attachment" binding:"omitempty"`
+}
+
+type ListMessagesRequest struct {
+	PageRequest
+	// AfterSeq switches to resync mode: messages after this sequence number,
+	// oldest first. The cursor is ignored in this mode.
+	AfterSeq *int64 `form:"after_seq" binding:"omitempty,min=0"`
+}
+
+type MessageAttachmentResponse struct {
+	ObjectKey string        `json:"object_key"`
+	URL       string        `json:"url"`
+	Feature   UploadFeature `json:"feature"`
+}
+
+type MessageResponse struct {
+	ID             int64                      `json:"id"`
+	ConversationID int64                      `json:"conversation_id"`
+	SenderID       int64                      `json:"sender_id"`
+	Seq            int64                      `json:"seq"`
+	Type           MessageType                `json:"type"`
+	Content        string                     `json:"content"`
+	Attachment     *MessageAttachmentResponse `json:"attachment"`
+	Event          SystemEvent                `json:"event,omitempty"`
+	TargetID       *int64                     `json:"target_id,omitempty"`
+	CreatedAt      time.Time                  `json:"created_at"`
+}
+
+// ConversationMemberResponse carries the receipt positions of the member, so
+// a sender knows a message is delivered to or read by a member once its seq
+// is at most LastDeliveredSeq or LastReadSeq.
+type ConversationMemberResponse struct {
+	UserSummary
+	Role             MemberRole `json:"role"`
+	LastReadSeq      int64      `json:"last_read_seq"`
+	LastDeliveredSeq int64      `json:"last_delivered_seq"`
+}
+
+type ConversationResponse struct {
+	ID          int64                        `json:"id"`
+	Type        ConversationType             `json:"type"`
+	Title       string                       `json:"title"`
+	Avatar      string                       `json:"avatar"`
+	Members     []ConversationMemberResponse `json:"members"`
+	LastSeq     int64                        `json:"last_seq"`
+	UnreadCount int64                        `json:"unread_count"`
+	LastMessage *MessageResponse             `json:"last_message"`
+	CreatedAt   time.Time                    `json:"created_at"`
+}
+
+type CreateGroupChatParams struct {
+	UserID    int64
+	Title     string
+	MemberIDs []int64
+}
+
+type UpdateGroupChatParams struct {
+	UserID         int64
+	ConversationID int64
+	Title          *string
+	Avatar         *string
+}
+
+type AddMembersParams struct {
+	UserID         int64
+	ConversationID int64
+	UserIDs        []int64
+}
+
+type RemoveMemberParams struct {
+	UserID         int64
+	ConversationID int64
+	MemberID       int64
+}
+
+type UpdateMemberRoleParams struct {
+	UserID         int64
+	ConversationID int64
+	MemberID       int64
+	Role           MemberRole
+}
+
+type ReceiptParams struct {
+	UserID         int64
+	ConversationID int64
+	Seq            int64
+}
+
+type TypingParams struct {
+	UserID         int64
+	ConversationID int64
+	Typing         bool
+}
+
+// ReceiptData is sent by clients to acknowledge messages over the WebSocket
+// and relayed to the members with UserID filled in.
+type ReceiptData struct {
+	ConversationID int64 `json:"conversation_id"`
+	UserID         int64 `json:"user_id,omitempty"`
+	Seq            int64 `json:"seq"`
+}
+
+// TypingData is sent by clients when they start or stop typing and relayed
+// to the other members with UserID filled in.
+type TypingData struct {
+	ConversationID int64 `json:"conversation_id"`
+	UserID         int64 `json:"user_id,omitempty"`
+	Typing         bool  `json:"typing"`
+}
+
+type SendMessageParams struct {
+	UserID         int64
+	ConversationID int64
+	Content        string
+	Attachment     *MessageAttachmentItem
+}
+
+type ListConversationsParams struct {
+	UserID int64
+	Page   PageParams
+}
+
+type ListMessagesParams struct {
+	UserID         int64
+	ConversationID int64
+	AfterSeq       *int64
+	Page           PageParams
+}
+
+func (m *Message) ToResponse() MessageResponse {
+	res := MessageResponse{
+		ID:             m.ID,
+		ConversationID: m.ConversationID,
+		SenderID:       m.SenderID,
+		Seq:            m.Seq,
+		Type:           m.Type,
+		Content:        m.Content,
+		Event:          m.Event,
+		TargetID:       m.TargetID,
+		CreatedAt:      m.CreatedAt,
+	}
+	if m.ObjectKey != nil {
+		res.Attachment = &MessageAttachmentResponse{
+			ObjectKey: *m.ObjectKey,
+			Feature:   m.Feature,
+		}
+	}
+	return res
+}
+
+func (c *Conversation) ToResponse() ConversationResponse {
+	return ConversationResponse{
+		ID:        c.ID,
+		Type:      c.Type,
+		Title:     c.Title,
+		Avatar:    c.Avatar,
+		Members:   []ConversationMemberResponse{},
+		LastSeq:   c.LastSeq,
+		CreatedAt: c.CreatedAt,
+	}
+}
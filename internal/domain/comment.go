@@ -1 +1,126 @@
 package domain
+
+import (
+	"context"
+	"time"
+)
+
+type CommentRepository interface {
+	// Create inserts the comment, fills in its tree position and bumps the
+	// reply count of the parent and the comment count of the post.
+	Create(ctx context.Context, comment *Comment) error
+	Update(ctx context.Context, comment *Comment) error
+	// Delete removes the comment together with all of its replies and returns
+	// the number of deleted rows.
+	Delete(ctx context.Context, comment *Comment) (int, error)
+	GetByID(ctx context.Context, id int64) (*Comment, error)
+	List(ctx context.Context, filter CommentListFilter) ([]Comment, error)
+}
+
+// CommentSort orders a level of the comment tree. "top" ranks by direct
+// reply count, not by reactions.
+type CommentSort string
+
+const (
+	CommentSortNewest CommentSort = "newest"
+	CommentSortTop    CommentSort = "top"
+)
+
+// Comment is a node of a post's comment tree. Path holds the IDs from the
+// root comment down to this one joined by "/", so a whole subtree can be
+// matched with a single prefix lookup.
+type Comment struct {
+	ID         int64       `db:"id"`
+	PostID     int64       `db:"post_id"`
+	AuthorID   int64       `db:"author_id"`
+	ParentID   *int64      `db:"parent_id"`
+	Path       string      `db:"path"`
+	Depth      int         `db:"depth"`
+	Content    string      `db:"content"`
+	ReplyCount int         `db:"reply_count"`
+	CreatedAt  time.Time   `db:"created_at"`
+	UpdatedAt  time.Time   `db:"updated_at"`
+	Author     UserSummary `db:"author"`
+}
+
+type CommentListFilter struct {
+	PostID   int64
+	ParentID int64 // 0 lists top-level comments
+	Sort     CommentSort
+	// Cursor keys of the last comment of the previous page.
+	// BeforeID of 0 means from the first page.
+	BeforeID         int64
+	BeforeReplyCount int64
+	Limit            int
+}
+
+type CreateCommentRequest struct {
+	Content  string `json:"content" binding:"required,max=2000"`
+	ParentID *int64 `json:"parent_id" binding:"omitempty,min=1"`
+}
+
+type UpdateCommentRequest struct {
+	Content string `json:"content" binding:"required,max=2000"`
+}
+
+type ListCommentsRequest struct {
+	PageRequest
+	Sort CommentSort `form:"sort" binding:"omitempty,oneof=newest top"`
+}
+
+type CommentResponse struct {
+	ID         int64           `json:"id"`
+	PostID     int64           `json:"post_id"`
+	ParentID   *int64          `json:"parent_id"`
+	Depth      int             `json:"depth"`
+	Author     UserSummary     `json:"author"`
+	Content    string          `json:"content"`
+	ReplyCount int             `json:"reply_count"`
+	Reactions  ReactionSummary `json:"reactions"`
+	Edited     bool            `json:"edited"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+type CreateCommentParams struct {
+	UserID   int64
+	PostID   int64
+	ParentID *int64
+	Content  string
+}
+
+type UpdateCommentParams struct {
+	UserID    int64
+	CommentID int64
+	Content   string
+}
+
+type ListCommentsParams struct {
+	ViewerID int64
+	PostID   int64
+	Sort     CommentSort
+	Page     PageParams
+}
+
+type ListRepliesParams struct {
+	ViewerID  int64
+	CommentID int64
+	Sort      CommentSort
+	Page      PageParams
+}
+
+func (c *Comment) ToResponse() CommentResponse {
+	return CommentResponse{
+		ID:         c.ID,
+		PostID:     c.PostID,
+		ParentID:   c.ParentID,
+		Depth:      c.Depth,
+		Author:     c.Author,
+		Content:    c.Content,
+		ReplyCount: c.ReplyCount,
+		Reactions:  NewReactionSummary(nil, ""),
+		Edited:     c.UpdatedAt.After(c.CreatedAt),
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+}
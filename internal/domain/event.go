@@ -10,6 +10,14 @@ type EventType string
 const (
 	EmailVerify        EventType = "email.verify"
 	EmailResetPassword EventType = "email.reset.password"
+	EmailAccountLocked EventType = "email.account.locked"
+	EmailMagicLink     EventType = "email.magic_link"
+	EmailChangeConfirm EventType = "email.change.confirm"
+	EmailChangeNotice  EventType = "email.change.notice"
+	PostCreated        EventType = "post.created"
+	// PostVisibilityChanged is sent when a private post becomes visible to
+	// followers, so it can be fanned out like a new post.
+	PostVisibilityChanged EventType = "post.visibility_changed"
 )
 
 type EventHandler interface {
@@ -34,3 +42,10 @@ type EventEmailData struct {
 	Link   string `json:"link"`
 	Expiry string `json:"expiry"`
 }
+
+type EventPostData struct {
+	PostID     int64          `json:"post_id"`
+	AuthorID   int64          `json:"author_id"`
+	Visibility PostVisibility `json:"visibility"`
+	GroupID    *int64         `json:"group_id,omitempty"`
+}
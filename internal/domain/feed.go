@@ -0,0 +1,15 @@
+package domain
+
+import "context"
+
+// FeedStore keeps the precomputed home timelines as sorted sets of post IDs.
+// Post IDs are used as scores, so newer posts always sort first.
+type FeedStore interface {
+	Push(ctx context.Context, userIDs []int64, postID int64) error
+	Range(ctx context.Context, userID, beforeID int64, limit int) ([]int64, error)
+}
+
+type ListFeedParams struct {
+	UserID int64
+	Page   PageParams
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type FollowRepository interface {
+	// Create returns false when the relationship already exists.
+	Create(ctx context.Context, followerID, followeeID int64) (bool, error)
+	// Delete returns false when there was no relationship to remove.
+	Delete(ctx context.Context, followerID, followeeID int64) (bool, error)
+	IsFollowing(ctx context.Context, followerID, followeeID int64) (bool, error)
+	ListFollowers(ctx context.Context, userID, beforeID int64, limit int) ([]FollowEntry, error)
+	ListFollowing(ctx context.Context, userID, beforeID int64, limit int) ([]FollowEntry, error)
+	// ListFollowerIDs pages through follower IDs in ascending order, starting after afterID.
+	ListFollowerIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error)
+	// ListPopularFollowing returns the followed accounts with at least minFollowers followers.
+	ListPopularFollowing(ctx context.Context, userID int64, minFollowers int) ([]int64, error)
+	// FilterFollowing returns the subset of followeeIDs that followerID follows.
+	FilterFollowing(ctx context.Context, followerID int64, followeeIDs []int64) ([]int64, error)
+}
+
+type FollowEntry struct {
+	FollowID   int64     `db:"follow_id"`
+	FollowedAt time.Time `db:"followed_at"`
+	UserSummary
+}
+
+type FollowUserResponse struct {
+	UserSummary
+	FollowedAt time.Time `json:"followed_at"`
+}
+
+type FollowParams struct {
+	FollowerID int64
+	FolloweeID int64
+}
+
+type ListFollowsParams struct {
+	UserID int64
+	Page   PageParams
+}
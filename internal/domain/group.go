@@ -0,0 +1,254 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type GroupRepository interface {
+	// Create stores the group together with the active owner membership and
+	// fills in group.ID.
+	Create(ctx context.Context, group *Group, ownerID int64) error
+	// Update stores the name, description, visibility and cover of group.
+	Update(ctx context.Context, group *Group) error
+	// Delete removes the group with its memberships and posts, returning the
+	// object keys of the deleted post media so the files can be removed.
+	Delete(ctx context.Context, id int64) ([]string, error)
+	GetByID(ctx context.Context, id int64) (*Group, error)
+	// List returns discoverable groups, or the groups filter.MemberID is an
+	// active member of, newest first.
+	List(ctx context.Context, filter GroupListFilter) ([]Group, error)
+
+	GetMember(ctx context.Context, groupID, userID int64) (*GroupMember, error)
+	// ListMembers pages through the members with the given status by user ID.
+	ListMembers(ctx context.Context, filter GroupMemberFilter) ([]GroupMember, error)
+	// ListMemberships returns the memberships of userID in any of groupIDs,
+	// whatever their status.
+	ListMemberships(ctx context.Context, userID int64, groupIDs []int64) ([]GroupMember, error)
+	// ListMemberIDs pages through the active members by user ID.
+	ListMemberIDs(ctx context.Context, groupID, afterID int64, limit int) ([]int64, error)
+	// FilterMemberOf returns the groups among groupIDs userID is an active member of.
+	FilterMemberOf(ctx context.Context, userID int64, groupIDs []int64) ([]int64, error)
+	// SaveMember inserts the membership or overwrites its role and status.
+	SaveMember(ctx context.Context, member *GroupMember) error
+	DeleteMember(ctx context.Context, groupID, userID int64) error
+	// RemoveOwner removes the owner and hands ownership over to the longest
+	// standing admin, or else moderator or member. It fails with ErrConflict
+	// when nobody is left to take over.
+	RemoveOwner(ctx context.Context, groupID, ownerID int64) error
+	// TransferOwnership makes toID the owner and demotes the owner to admin.
+	TransferOwnership(ctx context.Context, groupID, ownerID, toID int64) error
+}
+
+// GroupVisibility controls who can find a group and read its content:
+// public groups are open to everyone and can be joined directly, private
+// groups are listed but their posts and members are for members only and
+// joining needs approval, secret groups are unlisted and invitation only.
+type GroupVisibility string
+
+const (
+	GroupPublic  GroupVisibility = "public"
+	GroupPrivate GroupVisibility = "private"
+	GroupSecret  GroupVisibility = "secret"
+)
+
+type GroupRole string
+
+const (
+	GroupRoleOwner     GroupRole = "owner"
+	GroupRoleAdmin     GroupRole = "admin"
+	GroupRoleModerator GroupRole = "moderator"
+	GroupRoleMember    GroupRole = "member"
+)
+
+var groupRoleRank = map[GroupRole]int{
+	GroupRoleMember:    1,
+	GroupRoleModerator: 2,
+	GroupRoleAdmin:     3,
+	GroupRoleOwner:     4,
+}
+
+// AtLeast reports whether r has the permissions of o.
+func (r GroupRole) AtLeast(o GroupRole) bool {
+	return groupRoleRank[r] >= groupRoleRank[o]
+}
+
+// Outranks reports whether a member with role r may moderate one with role o.
+func (r GroupRole) Outranks(o GroupRole) bool {
+	return groupRoleRank[r] > groupRoleRank[o]
+}
+
+type GroupMemberStatus string
+
+const (
+	GroupMemberActive GroupMemberStatus = "active"
+	// GroupMemberPending is a join request awaiting approval.
+	GroupMemberPending GroupMemberStatus = "pending"
+	// GroupMemberInvited is an invitation the user has not accepted yet.
+	GroupMemberInvited GroupMemberStatus = "invited"
+	// GroupMemberBanned keeps the user from joining or requesting again.
+	GroupMemberBanned GroupMemberStatus = "banned"
+)
+
+type Group struct {
+	ID          int64           `db:"id"`
+	Name        string          `db:"name"`
+	Description string          `db:"description"`
+	Visibility  GroupVisibility `db:"visibility"`
+	Cover       string          `db:"cover"`
+	MemberCount int             `db:"member_count"`
+	CreatedAt   time.Time       `db:"created_at"`
+	UpdatedAt   time.Time       `db:"updated_at"`
+}
+
+// GroupMember is the relation of a user to a group. Only active members have
+// the permissions of their role; the others are always plain members.
+type GroupMember struct {
+	GroupID   int64             `db:"group_id"`
+	UserID    int64             `db:"user_id"`
+	Role      GroupRole         `db:"role"`
+	Status    GroupMemberStatus `db:"status"`
+	CreatedAt time.Time         `db:"created_at"`
+	UpdatedAt time.Time         `db:"updated_at"`
+	User      UserSummary       `db:"user"`
+}
+
+func (m *GroupMember) IsActive() bool {
+	return m.Status == GroupMemberActive
+}
+
+// HasRole reports whether the member is active with at least the given role.
+func (m *GroupMember) HasRole(role GroupRole) bool {
+	return m.IsActive() && m.Role.AtLeast(role)
+}
+
+type GroupListFilter struct {
+	// MemberID lists the groups of this user instead of discoverable ones.
+	MemberID int64
+	BeforeID int64 // 0 means from the newest
+	Limit    int
+}
+
+type GroupMemberFilter struct {
+	GroupID     int64
+	Status      GroupMemberStatus
+	AfterUserID int64
+	Limit       int
+}
+
+type CreateGroupRequest struct {
+	Name        string          `json:"name" binding:"required,min=3,max=100"`
+	Description string          `json:"description" binding:"max=1000"`
+	Visibility  GroupVisibility `json:"visibility" binding:"omitempty,oneof=public private secret"`
+}
+
+type UpdateGroupRequest struct {
+	Name        *string          `json:"name" binding:"omitempty,min=3,max=100"`
+	Description *string          `json:"description" binding:"omitempty,max=1000"`
+	Visibility  *GroupVisibility `json:"visibility" binding:"omitempty,oneof=public private secret"`
+	// Cover is an object key uploaded with domain "groups" and feature
+	// "cover"; an empty string removes the cover.
+	Cover *string `json:"cover"`
+}
+
+type ListGroupsRequest struct {
+	PageRequest
+	// Joined lists the groups of the caller instead of discoverable ones.
+	Joined bool `form:"joined"`
+}
+
+type InviteGroupMemberRequest struct {
+	UserID int64 `json:"user_id" binding:"required,min=1"`
+}
+
+type UpdateGroupMemberRoleRequest struct {
+	// Setting "owner" transfers the ownership; the owner becomes an admin.
+	Role GroupRole `json:"role" binding:"required,oneof=owner admin moderator member"`
+}
+
+type GroupMembershipResponse struct {
+	Role   GroupRole         `json:"role"`
+	Status GroupMemberStatus `json:"status"`
+}
+
+type GroupResponse struct {
+	ID          int64           `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Visibility  GroupVisibility `json:"visibility"`
+	Cover       string          `json:"cover"`
+	MemberCount int             `json:"member_count"`
+	// Membership is the caller's relation to the group, nil when there is none.
+	Membership *GroupMembershipResponse `json:"membership"`
+	CreatedAt  time.Time                `json:"created_at"`
+}
+
+type GroupMemberResponse struct {
+	UserSummary
+	Role      GroupRole         `json:"role"`
+	Status    GroupMemberStatus `json:"status"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+type CreateGroupParams struct {
+	UserID      int64
+	Name        string
+	Description string
+	Visibility  GroupVisibility
+}
+
+type UpdateGroupParams struct {
+	UserID      int64
+	GroupID     int64
+	Name        *string
+	Description *string
+	Visibility  *GroupVisibility
+	Cover       *string
+}
+
+type ListGroupsParams struct {
+	ViewerID int64
+	Joined   bool
+	Page     PageParams
+}
+
+// GroupMemberParams names the member UserID acts on.
+type GroupMemberParams struct {
+	UserID   int64
+	GroupID  int64
+	MemberID int64
+}
+
+type UpdateGroupMemberRoleParams struct {
+	UserID   int64
+	GroupID  int64
+	MemberID int64
+	Role     GroupRole
+}
+
+type ListGroupMembersParams struct {
+	ViewerID int64
+	GroupID  int64
+	Page     PageParams
+}
+
+func (g *Group) ToResponse() GroupResponse {
+	return GroupResponse{
+		ID:          g.ID,
+		Name:        g.Name,
+		Description: g.Description,
+		Visibility:  g.Visibility,
+		Cover:       g.Cover,
+		MemberCount: g.MemberCount,
+		CreatedAt:   g.CreatedAt,
+	}
+}
+
+func (m *GroupMember) ToResponse() GroupMemberResponse {
+	return GroupMemberResponse{
+		UserSummary: m.User,
+		Role:        m.Role,
+		Status:      m.Status,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
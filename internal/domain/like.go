@@ -1 +1,141 @@
 package domain
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type ReactionRepository interface {
+	// Upsert sets the user's reaction on the target and returns the reaction
+	// it replaced, or an empty type when the user had not reacted yet.
+	Upsert(ctx context.Context, target ReactionTarget, userID int64, reaction ReactionType) (ReactionType, error)
+	// Delete removes the user's reaction and returns it, or an empty type
+	// when there was nothing to remove.
+	Delete(ctx context.Context, target ReactionTarget, userID int64) (ReactionType, error)
+	GetUserReactions(ctx context.Context, targetType ReactionTargetType, ids []int64, userID int64) (map[int64]ReactionType, error)
+	CountByTargets(ctx context.Context, targetType ReactionTargetType, ids []int64) (map[int64]ReactionCounts, error)
+	ListReactors(ctx context.Context, filter ReactorListFilter) ([]ReactorEntry, error)
+}
+
+// ReactionCounter caches per-type reaction counts. Increments are applied only
+// to counters that are already cached; every change marks the target dirty so
+// that the reconciliation job can rewrite it from Postgres.
+type ReactionCounter interface {
+	Incr(ctx context.Context, target ReactionTarget, deltas ReactionCounts) error
+	// Get returns the cached counts; targets that are not cached are omitted.
+	Get(ctx context.Context, targets []ReactionTarget) (map[ReactionTarget]ReactionCounts, error)
+	Set(ctx context.Context, target ReactionTarget, counts ReactionCounts) error
+	// PopDirty removes and returns up to count dirty targets.
+	PopDirty(ctx context.Context, count int) ([]ReactionTarget, error)
+	// MarkDirty puts targets back into the dirty set, e.g. after a failed reconcile.
+	MarkDirty(ctx context.Context, targets []ReactionTarget) error
+}
+
+type ReactionType string
+
+const (
+	ReactionLike  ReactionType = "like"
+	ReactionLove  ReactionType = "love"
+	ReactionHaha  ReactionType = "haha"
+	ReactionWow   ReactionType = "wow"
+	ReactionSad   ReactionType = "sad"
+	ReactionAngry ReactionType = "angry"
+)
+
+type ReactionTargetType string
+
+const (
+	ReactionTargetPost    ReactionTargetType = "post"
+	ReactionTargetComment ReactionTargetType = "comment"
+)
+
+type ReactionTarget struct {
+	Type ReactionTargetType
+	ID   int64
+}
+
+func (t ReactionTarget) String() string {
+	return fmt.Sprintf("%s:%d", t.Type, t.ID)
+}
+
+// ParseReactionTarget reverses ReactionTarget.String.
+func ParseReactionTarget(s string) (ReactionTarget, error) {
+	typ, id, ok := strings.Cut(s, ":")
+	if !ok {
+		return ReactionTarget{}, fmt.Errorf("invalid reaction target %q", s)
+	}
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return ReactionTarget{}, fmt.Errorf("invalid reaction target %q", s)
+	}
+	return ReactionTarget{Type: ReactionTargetType(typ), ID: n}, nil
+}
+
+// ReactionCounts holds the number of reactions per type.
+type ReactionCounts map[ReactionType]int64
+
+type ReactorEntry struct {
+	ReactionID int64        `db:"reaction_id"`
+	Type       ReactionType `db:"type"`
+	ReactedAt  time.Time    `db:"reacted_at"`
+	UserSummary
+}
+
+type ReactorListFilter struct {
+	Target   ReactionTarget
+	Type     ReactionType // empty lists every type
+	BeforeID int64        // 0 means from the newest
+	Limit    int
+}
+
+type ReactRequest struct {
+	Type ReactionType `json:"type" binding:"required,oneof=like love haha wow sad angry"`
+}
+
+type ListReactorsRequest struct {
+	PageRequest
+	Type ReactionType `form:"type" binding:"omitempty,oneof=like love haha wow sad angry"`
+}
+
+type ReactionSummary struct {
+	Counts         ReactionCounts `json:"counts"`
+	Total          int64          `json:"total"`
+	ViewerReaction *ReactionType  `json:"viewer_reaction"`
+}
+
+type ReactorResponse struct {
+	UserSummary
+	Type      ReactionType `json:"type"`
+	ReactedAt time.Time    `json:"reacted_at"`
+}
+
+type ReactParams struct {
+	UserID int64
+	Target ReactionTarget
+	Type   ReactionType
+}
+
+type ListReactorsParams struct {
+	ViewerID int64
+	Target   ReactionTarget
+	Type     ReactionType
+	Page     PageParams
+}
+
+func NewReactionSummary(counts ReactionCounts, viewer ReactionType) ReactionSummary {
+	summary := ReactionSummary{Counts: ReactionCounts{}}
+	for t, n := range counts {
+		if n <= 0 {
+			continue
+		}
+		summary.Counts[t] = n
+		summary.Total += n
+	}
+	if viewer != "" {
+		summary.ViewerReaction = &viewer
+	}
+	return summary
+}
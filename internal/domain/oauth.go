@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// OAuthProvider signs users in with an external OpenID Connect provider,
+// using the authorization code flow with PKCE.
+type OAuthProvider interface {
+	Name() string
+	// AuthCodeURL is where the user agrees to sign in. The provider then
+	// sends them to the redirect URL with a code and state.
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	// Exchange redeems code for the ID token of the user and returns its
+	// claims once the token is validated. Codes and tokens the provider
+	// refuses fail with pkg.ErrUnauthorized.
+	Exchange(ctx context.Context, code, codeVerifier string) (OAuthClaims, error)
+}
+
+// OAuthProviders are the configured providers by name.
+type OAuthProviders map[string]OAuthProvider
+
+// OAuthClaims are what an ID token tells about the user.
+type OAuthClaims struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+	Nonce             string
+}
+
+// UserIdentity links an account to the user of an external provider.
+type UserIdentity struct {
+	ID        int64     `db:"id"`
+	UserID    int64     `db:"user_id"`
+	Provider  string    `db:"provider"`
+	Subject   string    `db:"subject"`
+	Email     string    `db:"email"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// OAuthState is a login waiting for the provider, cached under its state.
+type OAuthState struct {
+	Provider     string `json:"provider"`
+	CodeVerifier string `json:"code_verifier"`
+	Nonce        string `json:"nonce"`
+}
+
+type OAuthCallbackRequest struct {
+	Code     string `json:"code" binding:"required,max=2048"`
+	State    string `json:"state" binding:"required,max=255"`
+	DeviceID string `json:"device_id" binding:"required,max=255"`
+	// DeviceName is a label for the session list, e.g. "Pixel 8".
+	DeviceName string `json:"device_name" binding:"max=100"`
+}
+
+type OAuthAuthorizeResponse struct {
+	// AuthorizationURL is where to send the user to sign in.
+	AuthorizationURL string `json:"authorization_url"`
+	State            string `json:"state"`
+}
+
+type OAuthLoginParams struct {
+	Provider   string
+	Code       string
+	State      string
+	DeviceID   string
+	DeviceName string
+	UserAgent  string
+	IP         string
+}
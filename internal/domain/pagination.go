@@ -0,0 +1,38 @@
+package domain
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+type PageRequest struct {
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+type PageParams struct {
+	Cursor string
+	Limit  int
+}
+
+// Page is a cursor-paginated list. Items holds a typed slice, e.g. []PostResponse.
+type Page struct {
+	Items      any    `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+}
+
+func (r PageRequest) ToParams() PageParams {
+	return PageParams{Cursor: r.Cursor, Limit: r.Limit}
+}
+
+// Size returns the effective page size, falling back to DefaultPageLimit.
+func (p PageParams) Size() int {
+	if p.Limit <= 0 {
+		return DefaultPageLimit
+	}
+	if p.Limit > MaxPageLimit {
+		return MaxPageLimit
+	}
+	return p.Limit
+}
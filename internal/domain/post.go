@@ -1 +1,169 @@
 package domain
+
+import (
+	"context"
+	"time"
+)
+
+type PostRepository interface {
+	Create(ctx context.Context, post *Post) error
+	Update(ctx context.Context, post *Post) error
+	Delete(ctx context.Context, id int64) error
+	GetByID(ctx context.Context, id int64) (*Post, error)
+	ListByAuthor(ctx context.Context, filter PostListFilter) ([]Post, error)
+	ListByAuthors(ctx context.Context, filter PostFeedFilter) ([]Post, error)
+	ListByGroup(ctx context.Context, filter PostGroupFilter) ([]Post, error)
+	// ListByIDs returns the existing posts among ids, newest first.
+	ListByIDs(ctx context.Context, ids []int64) ([]Post, error)
+}
+
+type PostVisibility string
+
+const (
+	VisibilityPublic    PostVisibility = "public"
+	VisibilityFollowers PostVisibility = "followers"
+	VisibilityPrivate   PostVisibility = "private"
+)
+
+// Post is a post on the author's profile, or in a group when GroupID is set.
+// Group posts are always public within their group and never listed by
+// ListByAuthor or ListByAuthors.
+type Post struct {
+	ID           int64          `db:"id"`
+	AuthorID     int64          `db:"author_id"`
+	GroupID      *int64         `db:"group_id"`
+	Content      string         `db:"content"`
+	Visibility   PostVisibility `db:"visibility"`
+	CommentCount int            `db:"comment_count"`
+	Version      int            `db:"version"`
+	CreatedAt    time.Time      `db:"created_at"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+	// AuthorHidden is set when the author is banned or awaiting deletion;
+	// their posts are shown to nobody.
+	AuthorHidden bool `db:"author_hidden"`
+
+	Media []PostMedia `db:"-"`
+}
+
+type PostMedia struct {
+	PostID    int64         `db:"post_id"`
+	ObjectKey string        `db:"object_key"`
+	Feature   UploadFeature `db:"feature"`
+	Position  int           `db:"position"`
+}
+
+type PostListFilter struct {
+	AuthorID     int64
+	Visibilities []PostVisibility
+	BeforeID     int64 // 0 means from the newest
+	Limit        int
+}
+
+type PostFeedFilter struct {
+	AuthorIDs    []int64
+	Visibilities []PostVisibility
+	BeforeID     int64 // 0 means from the newest
+	Limit        int
+}
+
+type PostGroupFilter struct {
+	GroupID  int64
+	BeforeID int64 // 0 means from the newest
+	Limit    int
+}
+
+type PostMediaItem struct {
+	ObjectKey string        `json:"object_key" binding:"required"`
+	Feature   UploadFeature `json:"feature" binding:"required,oneof=feed_image feed_video"`
+}
+
+type CreatePostRequest struct {
+	Content    string          `json:"content" binding:"max=5000"`
+	Visibility PostVisibility  `json:"visibility" binding:"omitempty,oneof=public followers private"`
+	Media      []PostMediaItem `json:"media" binding:"omitempty,max=10,dive"`
+}
+
+type CreateGroupPostRequest struct {
+	Content string          `json:"content" binding:"max=5000"`
+	Media   []PostMediaItem `json:"media" binding:"omitempty,max=10,dive"`
+}
+
+type UpdatePostRequest struct {
+	Content    *string          `json:"content" binding:"omitempty,max=5000"`
+	Visibility *PostVisibility  `json:"visibility" binding:"omitempty,oneof=public followers private"`
+	Media      *[]PostMediaItem `json:"media" binding:"omitempty,max=10,dive"`
+}
+
+type PostMediaResponse struct {
+	ObjectKey string        `json:"object_key"`
+	URL       string        `json:"url"`
+	Feature   UploadFeature `json:"feature"`
+	Position  int           `json:"position"`
+}
+
+type PostResponse struct {
+	ID           int64               `json:"id"`
+	AuthorID     int64               `json:"author_id"`
+	GroupID      *int64              `json:"group_id,omitempty"`
+	Content      string              `json:"content"`
+	Visibility   PostVisibility      `json:"visibility"`
+	Media        []PostMediaResponse `json:"media"`
+	CommentCount int                 `json:"comment_count"`
+	Reactions    ReactionSummary     `json:"reactions"`
+	Edited       bool                `json:"edited"`
+	CreatedAt    time.Time           `json:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+}
+
+type CreatePostParams struct {
+	AuthorID   int64
+	GroupID    *int64
+	Content    string
+	Visibility PostVisibility
+	Media      []PostMediaItem
+}
+
+type UpdatePostParams struct {
+	UserID     int64
+	PostID     int64
+	Content    *string
+	Visibility *PostVisibility
+	Media      *[]PostMediaItem
+}
+
+type ListPostsParams struct {
+	ViewerID int64
+	AuthorID int64
+	Page     PageParams
+}
+
+type ListGroupPostsParams struct {
+	ViewerID int64
+	GroupID  int64
+	Page     PageParams
+}
+
+func (p *Post) ToResponse() PostResponse {
+	media := make([]PostMediaResponse, 0, len(p.Media))
+	for _, m := range p.Media {
+		media = append(media, PostMediaResponse{
+			ObjectKey: m.ObjectKey,
+			Feature:   m.Feature,
+			Position:  m.Position,
+		})
+	}
+
+	return PostResponse{
+		ID:           p.ID,
+		AuthorID:     p.AuthorID,
+		GroupID:      p.GroupID,
+		Content:      p.Content,
+		Visibility:   p.Visibility,
+		Media:        media,
+		CommentCount: p.CommentCount,
+		Reactions:    NewReactionSummary(nil, ""),
+		Edited:       p.UpdatedAt.After(p.CreatedAt),
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+	}
+}
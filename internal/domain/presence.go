@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// PresenceStore tracks the live connections of users across instances. A
+// user is online while at least one connection has sent a heartbeat within
+// the store's TTL.
+type PresenceStore interface {
+	// Connect registers a connection and reports whether it is the user's
+	// first live one.
+	Connect(ctx context.Context, userID int64, connID string) (bool, error)
+	// Heartbeat keeps a connection alive for another TTL and refreshes the
+	// user's last-seen time.
+	Heartbeat(ctx context.Context, userID int64, connID string) error
+	// Disconnect removes a connection, records the last-seen time and reports
+	// whether it was the user's last live one.
+	Disconnect(ctx context.Context, userID int64, connID string) (bool, error)
+	// Get returns the presence of every user, in the order of userIDs.
+	Get(ctx context.Context, userIDs []int64) ([]Presence, error)
+}
+
+const (
+	RealtimePresence RealtimeType = "presence"
+)
+
+// MaxPresenceLookup caps the users of a single presence lookup.
+const MaxPresenceLookup = 100
+
+// Presence is the online status of a user. LastSeen is nil while the user is
+// online or when it is unknown.
+type Presence struct {
+	UserID   int64
+	Online   bool
+	LastSeen *time.Time
+}
+
+type PresenceLookupRequest struct {
+	IDs []int64 `form:"ids" binding:"required,min=1,max=100,dive,min=1"`
+}
+
+type UpdatePresenceSettingsRequest struct {
+	Hidden *bool `json:"hidden" binding:"required"`
+}
+
+// PresenceResponse is returned by lookups and pushed to peers as "presence"
+// when a user comes online or goes offline.
+type PresenceResponse struct {
+	UserID   int64      `json:"user_id"`
+	Online   bool       `json:"online"`
+	LastSeen *time.Time `json:"last_seen"`
+}
+
+func (p Presence) ToResponse() PresenceResponse {
+	return PresenceResponse{
+		UserID:   p.UserID,
+		Online:   p.Online,
+		LastSeen: p.LastSeen,
+	}
+}
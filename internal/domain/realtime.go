@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// RealtimeType names the kind of a message exchanged over a realtime connection.
+type RealtimeType string
+
+const (
+	RealtimeError RealtimeType = "error"
+)
+
+// RealtimeMessage is the envelope sent to connected clients.
+type RealtimeMessage struct {
+	Type RealtimeType `json:"type"`
+	Data any          `json:"data,omitempty"`
+}
+
+// RealtimeInbound is the envelope received from connected clients. Data is
+// decoded by the handler registered for Type.
+type RealtimeInbound struct {
+	Type RealtimeType    `json:"type"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+type RealtimeErrorData struct {
+	Message string `json:"message"`
+}
+
+// RealtimeSender delivers messages to every open connection of the given users.
+// Delivery is best effort: users without a connection are skipped silently.
+type RealtimeSender interface {
+	SendToUsers(ctx context.Context, userIDs []int64, msg RealtimeMessage) error
+}
+
+// RealtimeDisconnector closes every open connection of the given users, so a
+// revoked sign-in cannot keep a socket it authenticated before.
+type RealtimeDisconnector interface {
+	DisconnectUsers(ctx context.Context, userIDs []int64) error
+}
@@ -0,0 +1,59 @@
+package domain
+
+import "slices"
+
+// UserRole is the site-wide role of a user, carried in the access token. It is
+// unrelated to the roles members hold in groups and conversations.
+type UserRole string
+
+const (
+	UserRoleUser      UserRole = "user"
+	UserRoleModerator UserRole = "moderator"
+	UserRoleAdmin     UserRole = "admin"
+)
+
+// Permission names an action beyond what every signed-in user may do.
+type Permission string
+
+const (
+	PermissionUsersRead     Permission = "users:read"
+	PermissionUsersModerate Permission = "users:moderate"
+	PermissionUsersManage   Permission = "users:manage"
+	PermissionSystemHealth  Permission = "system:health"
+)
+
+// rolePermissions is the permission matrix. Plain users have none.
+var rolePermissions = map[UserRole][]Permission{
+	UserRoleUser: nil,
+	UserRoleModerator: {
+		PermissionUsersRead,
+		PermissionUsersModerate,
+	},
+	UserRoleAdmin: {
+		PermissionUsersRead,
+		PermissionUsersModerate,
+		PermissionUsersManage,
+		PermissionSystemHealth,
+	},
+}
+
+var userRoleRank = map[UserRole]int{
+	UserRoleUser:      1,
+	UserRoleModerator: 2,
+	UserRoleAdmin:     3,
+}
+
+func (r UserRole) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// Can reports whether the role grants p.
+func (r UserRole) Can(p Permission) bool {
+	return slices.Contains(rolePermissions[r], p)
+}
+
+// Outranks reports whether staff with role r may act on a user with role o.
+func (r UserRole) Outranks(o UserRole) bool {
+	return userRoleRank[r] > userRoleRank[o]
+}
@@ -11,19 +11,79 @@ type TokenRepository interface {
 	UpdateRevoked(ctx context.Context, id int64) error
 	UpdateRevokedByUser(ctx context.Context, userID int64) error
 	UpdateRevokedByDevice(ctx context.Context, userID int64, deviceID string) error
+	// UpdateRevokedBySession revokes the session of the user and fails with
+	// ErrNotFound when it has no active token.
+	UpdateRevokedBySession(ctx context.Context, userID int64, sessionID string) error
+	// ListActiveByUser returns the unrevoked, unexpired tokens of the user,
+	// newest first.
+	ListActiveByUser(ctx context.Context, userID int64) ([]RefreshToken, error)
 	DeleteExpiredAndRevoked(ctx context.Context, expiredBefore time.Time, revokedBefore time.Time) error
 }
 
+// TokenDenylist revokes access tokens before they expire, for every instance.
+// Entries only need to outlive the tokens they revoke.
+type TokenDenylist interface {
+	// Deny revokes the access token with ID jti for ttl.
+	Deny(ctx context.Context, jti string, ttl time.Duration) error
+	// RevokeBefore revokes every access token of the user issued up to t,
+	// to the millisecond, remembering it for ttl.
+	RevokeBefore(ctx context.Context, userID int64, t time.Time, ttl time.Duration) error
+	// DenySession revokes every access token issued for the session for ttl.
+	DenySession(ctx context.Context, sessionID string, ttl time.Duration) error
+	// IsRevoked reports whether the token of claims was revoked, on its own,
+	// with its session or by the user's mark. An empty TokenID or SessionID
+	// skips that check.
+	IsRevoked(ctx context.Context, claims *AuthClaims) (bool, error)
+}
+
 const AuditRetentionPeriod = 30 * 24 * time.Hour
 
+// RefreshToken is one link of a session. Every refresh revokes the token and
+// issues the next one with the same SessionID, so a session is the chain of
+// tokens since the device signed in.
 type RefreshToken struct {
 	ID        int64      `db:"id"`
 	UserID    int64      `db:"user_id"`
+	SessionID string     `db:"session_id"`
 	DeviceID  string     `db:"device_id"`
 	TokenHash string     `db:"token_hash"`
 	ExpiresAt time.Time  `db:"expires_at"`
 	RevokedAt *time.Time `db:"revoked_at"`
 	CreatedAt time.Time  `db:"created_at"`
+
+	// Session info, carried over or refreshed on rotation
+	DeviceName string    `db:"device_name"`
+	UserAgent  string    `db:"user_agent"`
+	IPAddress  string    `db:"ip_address"`
+	SignedInAt time.Time `db:"signed_in_at"`
+	LastUsedAt time.Time `db:"last_used_at"`
+
+	// UserRole is read along with the token, so a refresh picks up a role
+	// changed since the session began. It is not stored with the token.
+	UserRole UserRole `db:"user_role"`
+}
+
+// SessionClient describes the device a session is used from.
+type SessionClient struct {
+	DeviceID   string
+	DeviceName string
+	UserAgent  string
+	IP         string
+}
+
+// SessionResponse is a signed-in device of a user. LastUsedAt is when the
+// device last signed in or refreshed its tokens.
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	DeviceID   string    `json:"device_id"`
+	DeviceName string    `json:"device_name"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	SignedInAt time.Time `json:"signed_in_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	// Current marks the session of the caller.
+	Current bool `json:"current"`
 }
 
 type TokenInfo struct {
@@ -32,3 +92,16 @@ type TokenInfo struct {
 	ExpiresIn    int64  `json:"expires_in"`
 	TokenType    string `json:"token_type"`
 }
+
+func (t *RefreshToken) ToSessionResponse() SessionResponse {
+	return SessionResponse{
+		ID:         t.SessionID,
+		DeviceID:   t.DeviceID,
+		DeviceName: t.DeviceName,
+		UserAgent:  t.UserAgent,
+		IPAddress:  t.IPAddress,
+		SignedInAt: t.SignedInAt,
+		LastUsedAt: t.LastUsedAt,
+		ExpiresAt:  t.ExpiresAt,
+	}
+}
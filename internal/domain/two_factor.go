@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type TwoFactorRepository interface {
+	// Get returns the TOTP setup of the user, ErrNotFound without one.
+	Get(ctx context.Context, userID int64) (*TwoFactor, error)
+	// SavePending stores a secret awaiting its first code, replacing any
+	// earlier unconfirmed one. ErrConflict when 2FA is already on.
+	SavePending(ctx context.Context, userID int64, secret []byte) error
+	// Enable turns 2FA on with step as the last used one and replaces the
+	// recovery codes. ErrNotFound without a pending secret.
+	Enable(ctx context.Context, userID int64, step int64, codeHashes []string) error
+	// UseStep records step as used. ErrConflict when it is not newer than the
+	// last used one, i.e. the code was replayed.
+	UseStep(ctx context.Context, userID int64, step int64) error
+	// UseRecoveryCode spends an unused recovery code, ErrNotFound when the
+	// user has none with codeHash.
+	UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error
+	// Delete turns 2FA off and drops the recovery codes.
+	Delete(ctx context.Context, userID int64) error
+}
+
+// TwoFactor is the TOTP setup of a user. It is pending until EnabledAt is
+// set by the first code from the authenticator app.
+type TwoFactor struct {
+	UserID int64 `db:"user_id"`
+	// Secret is sealed, see pkg.SecretBox.
+	Secret    []byte     `db:"secret"`
+	LastStep  *int64     `db:"last_step"`
+	EnabledAt *time.Time `db:"enabled_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+func (t *TwoFactor) Enabled() bool {
+	return t.EnabledAt != nil
+}
+
+// TwoFactorLogin is a login waiting for its second step, cached under its
+// challenge token.
+type TwoFactorLogin struct {
+	UserID     int64     `json:"user_id"`
+	Email      string    `json:"email"`
+	DeviceID   string    `json:"device_id"`
+	DeviceName string    `json:"device_name"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+	Attempts   int       `json:"attempts"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+type ConfirmTwoFactorRequest struct {
+	Code string `json:"code" binding:"required,len=6,numeric"`
+}
+
+type DisableTwoFactorRequest struct {
+	Password string `json:"password" binding:"required"`
+	// Code is a code from the authenticator app or a recovery code.
+	Code string `json:"code" binding:"required,max=32"`
+}
+
+type VerifyTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	// Code is a code from the authenticator app or a recovery code.
+	Code string `json:"code" binding:"required,max=32"`
+}
+
+type TwoFactorEnrollResponse struct {
+	// Secret is for typing into apps that cannot scan the QR code.
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+	// QRCode is the URI as a PNG data URL.
+	QRCode string `json:"qr_code"`
+}
+
+type RecoveryCodesResponse struct {
+	// RecoveryCodes are only shown once; each signs in a single time.
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// TwoFactorChallenge is returned by a login that needs a second step.
+type TwoFactorChallenge struct {
+	ChallengeToken string `json:"challenge_token"`
+	ExpiresIn      int64  `json:"expires_in"`
+}
+
+type DisableTwoFactorParams struct {
+	UserID   int64
+	Password string
+	Code     string
+}
+
+type VerifyTwoFactorParams struct {
+	ChallengeToken string
+	Code           string
+}
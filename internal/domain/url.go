@@ -10,4 +10,8 @@ type URLFactory interface {
 
 	VerifyEmailLink(token string) string
 	ResetPasswordLink(token string) string
+	UnlockAccountLink(token string) string
+	MagicLoginLink(token string) string
+	ConfirmEmailChangeLink(token string) string
+	CancelEmailChangeLink(token string) string
 }
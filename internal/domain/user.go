@@ -11,8 +11,57 @@ type UserRepository interface {
 	GetByID(ctx context.Context, id int64) (*User, error)
 	GetByEmail(ctx context.Context, email string) (*User, error)
 	UpdateProfileImages(ctx context.Context, userID int64, url string, feature UploadFeature) error
+	SetHidePresence(ctx context.Context, userID int64, hidden bool) error
+	// ListHidingPresence returns the users among ids that hide their presence.
+	ListHidingPresence(ctx context.Context, ids []int64) ([]int64, error)
+	// Search pages through the users matching filter, newest first.
+	Search(ctx context.Context, filter UserSearchFilter) ([]User, error)
+	// UpdateStatus sets the account status; until only applies to suspensions.
+	UpdateStatus(ctx context.Context, userID int64, status UserStatus, until *time.Time) error
+	// UpdateEmail moves the user from oldEmail to the verified newEmail. It
+	// fails with pkg.ErrNotFound when the email is no longer oldEmail.
+	UpdateEmail(ctx context.Context, userID int64, oldEmail, newEmail string) error
+	// ScheduleDeletion sets when the account is purged; nil cancels it.
+	ScheduleDeletion(ctx context.Context, userID int64, at *time.Time) error
+	// ListDueForDeletion returns up to limit users whose deletion is due at now.
+	ListDueForDeletion(ctx context.Context, now time.Time, limit int) ([]int64, error)
+	// Purge deletes the user with their rows, blanking their messages
+	// instead. The user first leaves their group conversations, and hands
+	// their groups over, as on leaving; an owned group nobody is left in goes
+	// too. It fails with pkg.ErrNotFound when the deletion is no longer due,
+	// e.g. because the user logged in meanwhile.
+	Purge(ctx context.Context, userID int64) (*PurgedUser, error)
+
+	// GetByIdentity returns the user linked to subject at provider.
+	GetByIdentity(ctx context.Context, provider, subject string) (*User, error)
+	CreateIdentity(ctx context.Context, identity *UserIdentity) error
+	// CreateWithIdentity creates user together with its first identity.
+	CreateWithIdentity(ctx context.Context, user *User, identity *UserIdentity) error
 }
 
+// UserStatus tells whether the user may sign in. A suspension ends by itself
+// at SuspendedUntil, a ban lasts until it is lifted.
+type UserStatus string
+
+const (
+	UserStatusActive    UserStatus = "active"
+	UserStatusSuspended UserStatus = "suspended"
+	UserStatusBanned    UserStatus = "banned"
+)
+
+// GatedAction names what only users with a verified email may do, when it
+// is listed in VERIFIED_EMAIL_REQUIRED_FOR.
+type GatedAction string
+
+const (
+	GatedActionPosting   GatedAction = "posting"
+	GatedActionMessaging GatedAction = "messaging"
+	GatedActionUploads   GatedAction = "uploads"
+)
+
+// GatedActions are the actions that can be gated.
+var GatedActions = []GatedAction{GatedActionPosting, GatedActionMessaging, GatedActionUploads}
+
 type User struct {
 	// Identifier
 	ID           int64  `db:"id" json:"id"`
@@ -23,12 +72,22 @@ type User struct {
 	// Profile
 	Profile
 
+	// Social graph (denormalized)
+	FollowCounts
+
+	// Privacy
+	HidePresence bool `db:"hide_presence" json:"hide_presence"`
+
 	// System info
-	Verified   bool       `db:"verified" json:"verified"`
-	VerifiedAt *time.Time `db:"verified_at" json:"verified_at"`
-	CreatedAt  time.Time  `db:"created_at" json:"created_at"`
-	UpdatedAt  time.Time  `db:"updated_at" json:"updated_at"`
-	Version    int        `db:"version" json:"version"`
+	Role           UserRole   `db:"role" json:"role"`
+	Status         UserStatus `db:"status" json:"status"`
+	SuspendedUntil *time.Time `db:"suspended_until" json:"suspended_until"`
+	DeleteAfter    *time.Time `db:"delete_after" json:"delete_after"`
+	Verified       bool       `db:"verified" json:"verified"`
+	VerifiedAt     *time.Time `db:"verified_at" json:"verified_at"`
+	CreatedAt      time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at" json:"updated_at"`
+	Version        int        `db:"version" json:"version"`
 }
 
 type Profile struct {
@@ -40,6 +99,18 @@ type Profile struct {
 	Website    string `db:"website" json:"website"`
 }
 
+type FollowCounts struct {
+	FollowersCount int `db:"followers_count" json:"followers_count"`
+	FollowingCount int `db:"following_count" json:"following_count"`
+}
+
+type UserSummary struct {
+	ID       int64  `db:"id" json:"id"`
+	Username string `db:"username" json:"username"`
+	FullName string `db:"full_name" json:"full_name"`
+	Avatar   string `db:"avatar" json:"avatar"`
+}
+
 type UpdateProfileRequest struct {
 	FullName *string `json:"full_name" binding:"omitempty,min=2,max=100"`
 	Bio      *string `json:"bio" binding:"omitempty,max=255"`
@@ -53,6 +124,15 @@ type ChangePasswordRequest struct {
 	NewPassword     string `json:"new_password" binding:"required,min=8,max=64"`
 }
 
+type ChangeEmailRequest struct {
+	NewEmail        string `json:"new_email" binding:"required,email,max=255"`
+	CurrentPassword string `json:"current_password" binding:"required"`
+}
+
+type DeleteAccountRequest struct {
+	Password string `json:"password" binding:"required"`
+}
+
 type ConfirmProfileImageRequest struct {
 	ObjectKey string        `json:"object_key" binding:"required"`
 	Domain    UploadDomain  `json:"domain" binding:"required,oneof=users"`
@@ -63,15 +143,47 @@ type UserResponse struct {
 	ID        int64     `json:"id"`
 	Email     string    `json:"email"`
 	Username  string    `json:"username"`
+	Role      UserRole  `json:"role"`
+	Verified  bool      `json:"verified"`
+	CreatedAt time.Time `json:"created_at"`
+	// HidePresence is a private setting and is left out of public profiles.
+	HidePresence bool `json:"hide_presence"`
+	Profile
+	FollowCounts
+}
+
+// PublicProfileResponse is the profile other users see; it leaves out private
+// account data such as the email address.
+type PublicProfileResponse struct {
+	ID        int64     `json:"id"`
+	Username  string    `json:"username"`
 	Verified  bool      `json:"verified"`
 	CreatedAt time.Time `json:"created_at"`
 	Profile
+	FollowCounts
+}
+
+// AccountDeletionResponse tells when a deleted account is purged. Logging in
+// before then keeps it.
+type AccountDeletionResponse struct {
+	DeleteAfter time.Time `json:"delete_after"`
+}
+
+type UserSearchFilter struct {
+	// Query matches part of the email, username or full name.
+	Query    string
+	Role     UserRole
+	Status   UserStatus
+	BeforeID int64 // 0 means from the newest
+	Limit    int
 }
 
 type CreateUserParams struct {
 	Email          string
 	Username       string
 	PasswordHashed string
+	// Verified is set for emails a sign-in provider has verified.
+	Verified bool
 }
 
 type UpdateProfileParams struct {
@@ -89,13 +201,68 @@ type ChangePasswordParams struct {
 	NewPassword     string
 }
 
+type DeleteAccountParams struct {
+	UserID   int64
+	Password string
+}
+
+type ChangeEmailParams struct {
+	UserID          int64
+	NewEmail        string
+	CurrentPassword string
+}
+
+// EmailChange is an email change waiting for the new address to confirm it,
+// cached per user.
+type EmailChange struct {
+	OldEmail     string `json:"old_email"`
+	NewEmail     string `json:"new_email"`
+	ConfirmToken string `json:"confirm_token"`
+	CancelToken  string `json:"cancel_token"`
+}
+
+// PurgedUser is what a purge leaves to clean up outside Postgres.
+type PurgedUser struct {
+	// ObjectKeys are the files of the user and of the groups deleted with them.
+	ObjectKeys []string
+	// Reactions are the targets the user had reacted to, whose cached
+	// counters no longer match.
+	Reactions []ReactionTarget
+}
+
 func (u *User) ToResponse() UserResponse {
 	return UserResponse{
-		ID:        u.ID,
-		Email:     u.Email,
-		Username:  u.Username,
-		Profile:   u.Profile,
-		Verified:  u.Verified,
-		CreatedAt: u.CreatedAt,
+		ID:           u.ID,
+		Email:        u.Email,
+		Username:     u.Username,
+		Role:         u.Role,
+		Profile:      u.Profile,
+		FollowCounts: u.FollowCounts,
+		Verified:     u.Verified,
+		CreatedAt:    u.CreatedAt,
+		HidePresence: u.HidePresence,
+	}
+}
+
+// EffectiveStatus is the status at now, counting an expired suspension as
+// active.
+func (u *User) EffectiveStatus(now time.Time) UserStatus {
+	if u.Status == UserStatusSuspended && u.SuspendedUntil != nil && !u.SuspendedUntil.After(now) {
+		return UserStatusActive
+	}
+	if u.Status == "" {
+		return UserStatusActive
+	}
+	return u.Status
+}
+
+func (r UserResponse) ToPublic() PublicProfileResponse {
+	return PublicProfileResponse{
+		ID:           r.ID,
+		Username:     r.Username,
+		Verified:     r.Verified,
+		CreatedAt:    r.CreatedAt,
+		Profile:      r.Profile,
+		FollowCounts: r.FollowCounts,
 	}
 }
@@ -0,0 +1,269 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"air-social/internal/config"
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+	// jwksMinRefresh keeps tokens with unknown kids from making us fetch the
+	// JWKS of the provider on every login.
+	jwksMinRefresh = time.Minute
+	clockLeeway    = time.Minute
+	maxBodySize    = 1 << 20
+)
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// provider is an OpenID Connect client. Its endpoints are discovered from
+// the issuer on first use, so the API starts while the provider is down.
+type provider struct {
+	cfg    config.OAuthProviderConfig
+	client *http.Client
+
+	mu         sync.Mutex
+	meta       *discovery
+	keys       *pkg.KeySet
+	keysLoaded time.Time
+}
+
+func NewProvider(cfg config.OAuthProviderConfig, client *http.Client) *provider {
+	return &provider{cfg: cfg, client: client}
+}
+
+// NewProviders builds a client for each configured provider.
+func NewProviders(cfg config.OAuthConfig) domain.OAuthProviders {
+	client := &http.Client{Timeout: 10 * time.Second}
+	providers := make(domain.OAuthProviders, len(cfg.Providers))
+	for _, p := range cfg.Providers {
+		providers[p.Name] = NewProvider(p, client)
+	}
+	return providers
+}
+
+func (p *provider) Name() string {
+	return p.cfg.Name
+}
+
+func (p *provider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	q := url.Values{}
+	q.Set("response_type", "code")
+	q.Set("client_id", p.cfg.ClientID)
+	q.Set("redirect_uri", p.cfg.RedirectURL)
+	q.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", codeChallenge)
+	q.Set("code_challenge_method", "S256")
+
+	sep := "?"
+	if strings.Contains(meta.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return meta.AuthorizationEndpoint + sep + q.Encode(), nil
+}
+
+func (p *provider) Exchange(ctx context.Context, code, codeVerifier string) (domain.OAuthClaims, error) {
+	var empty domain.OAuthClaims
+
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return empty, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.cfg.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return empty, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return empty, fmt.Errorf("oidc %s token request: %w", p.cfg.Name, err)
+	}
+	defer res.Body.Close()
+
+	var tokens tokenResponse
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxBodySize)).Decode(&tokens); err != nil {
+		return empty, fmt.Errorf("oidc %s token response: %w", p.cfg.Name, err)
+	}
+	// A code that is wrong, used or expired comes back as a 400 with an
+	// OAuth error, which is the caller's fault rather than ours.
+	if res.StatusCode == http.StatusBadRequest || res.StatusCode == http.StatusUnauthorized {
+		return empty, fmt.Errorf("%w: oidc %s: %s %s", pkg.ErrUnauthorized, p.cfg.Name, tokens.Error, tokens.ErrorDescription)
+	}
+	if res.StatusCode != http.StatusOK {
+		return empty, fmt.Errorf("oidc %s token endpoint: status %d", p.cfg.Name, res.StatusCode)
+	}
+	if tokens.IDToken == "" {
+		return empty, fmt.Errorf("oidc %s: no id_token, is the openid scope requested?", p.cfg.Name)
+	}
+
+	return p.verifyIDToken(ctx, meta, tokens.IDToken)
+}
+
+// verifyIDToken checks the signature, issuer, audience and lifetime of the
+// token. The nonce is left to the caller, which knows the one it sent.
+func (p *provider) verifyIDToken(ctx context.Context, meta *discovery, raw string) (domain.OAuthClaims, error) {
+	var empty domain.OAuthClaims
+
+	keys, err := p.loadKeys(ctx, meta, tokenKeyID(raw))
+	if err != nil {
+		return empty, err
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(raw, claims, keys.Keyfunc,
+		jwt.WithValidMethods(keys.Methods()),
+		jwt.WithIssuer(meta.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(clockLeeway),
+	)
+	if err != nil {
+		return empty, fmt.Errorf("%w: oidc %s id token: %v", pkg.ErrUnauthorized, p.cfg.Name, err)
+	}
+
+	out := domain.OAuthClaims{
+		Subject:           stringClaim(claims, "sub"),
+		Email:             stringClaim(claims, "email"),
+		Name:              stringClaim(claims, "name"),
+		PreferredUsername: stringClaim(claims, "preferred_username"),
+		Nonce:             stringClaim(claims, "nonce"),
+	}
+	// Some providers send the flag as a string.
+	switch v := claims["email_verified"].(type) {
+	case bool:
+		out.EmailVerified = v
+	case string:
+		out.EmailVerified = v == "true"
+	}
+	if out.Subject == "" {
+		return empty, fmt.Errorf("%w: oidc %s id token has no subject", pkg.ErrUnauthorized, p.cfg.Name)
+	}
+	return out, nil
+}
+
+func (p *provider) discover(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.meta != nil {
+		return p.meta, nil
+	}
+
+	var meta discovery
+	if err := p.getJSON(ctx, strings.TrimSuffix(p.cfg.Issuer, "/")+discoveryPath, &meta); err != nil {
+		return nil, err
+	}
+	// The issuer is what ID tokens are checked against, so it must be the
+	// one configured rather than whatever the document claims.
+	if strings.TrimSuffix(meta.Issuer, "/") != strings.TrimSuffix(p.cfg.Issuer, "/") {
+		return nil, fmt.Errorf("oidc %s: discovered issuer %q does not match %q", p.cfg.Name, meta.Issuer, p.cfg.Issuer)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, fmt.Errorf("oidc %s: incomplete discovery document", p.cfg.Name)
+	}
+
+	p.meta = &meta
+	return p.meta, nil
+}
+
+// loadKeys returns the JWKS of the provider, fetching it again when it lacks
+// kid, as happens after the provider rotates its keys.
+func (p *provider) loadKeys(ctx context.Context, meta *discovery, kid string) (*pkg.KeySet, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.keys != nil && (p.keys.HasKey(kid) || time.Since(p.keysLoaded) < jwksMinRefresh) {
+		return p.keys, nil
+	}
+
+	var set pkg.JWKSet
+	if err := p.getJSON(ctx, meta.JWKSURI, &set); err != nil {
+		if p.keys != nil {
+			return p.keys, nil
+		}
+		return nil, err
+	}
+	keys, err := pkg.NewKeySetFromJWKS(set)
+	if err != nil {
+		return nil, fmt.Errorf("oidc %s: %w", p.cfg.Name, err)
+	}
+
+	p.keys = keys
+	p.keysLoaded = time.Now()
+	return p.keys, nil
+}
+
+func (p *provider) getJSON(ctx context.Context, url string, dst any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("oidc %s: %w", p.cfg.Name, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("oidc %s: GET %s: status %d", p.cfg.Name, url, res.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(res.Body, maxBodySize)).Decode(dst)
+}
+
+// tokenKeyID reads the kid header without verifying the token.
+func tokenKeyID(raw string) string {
+	token, _, err := jwt.NewParser().ParseUnverified(raw, jwt.MapClaims{})
+	if err != nil {
+		return ""
+	}
+	kid, _ := token.Header["kid"].(string)
+	return kid
+}
+
+func stringClaim(claims jwt.MapClaims, name string) string {
+	s, _ := claims[name].(string)
+	return s
+}
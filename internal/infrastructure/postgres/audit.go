@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+type auditLogRepository struct {
+	db *sqlx.DB
+}
+
+func NewAuditLogRepository(db *sqlx.DB) *auditLogRepository {
+	return &auditLogRepository{db: db}
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, entry *domain.AuditLog) error {
+	query := `
+		INSERT INTO admin_audit_logs (actor_id, target_user_id, action, details)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+	err := r.db.QueryRowxContext(ctx, query, entry.ActorID, entry.TargetUserID, entry.Action, entry.Details).
+		Scan(&entry.ID, &entry.CreatedAt)
+	return pkg.MapPostgresError(err)
+}
+
+func (r *auditLogRepository) List(ctx context.Context, f domain.AuditLogFilter) ([]domain.AuditLog, error) {
+	query := `
+		SELECT id, actor_id, target_user_id, action, details, created_at
+		FROM admin_audit_logs
+		WHERE ($1::BIGINT = 0 OR actor_id = $1)
+			AND ($2::BIGINT = 0 OR target_user_id = $2)
+			AND ($3::BIGINT = 0 OR id < $3)
+		ORDER BY id DESC
+		LIMIT $4
+	`
+	var entries []domain.AuditLog
+	if err := r.db.SelectContext(ctx, &entries, query, f.ActorID, f.TargetUserID, f.BeforeID, f.Limit); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/jmoiron/sqlx"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+const commentColumns = `
+	c.id, c.post_id, c.author_id, c.parent_id, c.path, c.depth, c.content,
+	c.reply_count, c.created_at, c.updated_at,
+	u.id AS "author.id", u.username AS "author.username",
+	u.full_name AS "author.full_name", u.avatar AS "author.avatar"
+`
+
+type commentRepository struct {
+	db *sqlx.DB
+}
+
+func NewCommentRepository(db *sqlx.DB) *commentRepository {
+	return &commentRepository{db: db}
+}
+
+func (r *commentRepository) Create(ctx context.Context, comment *domain.Comment) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := tx.GetContext(ctx, &comment.ID, `SELECT nextval(pg_get_serial_sequence('comments', 'id'))`); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	comment.Path = strconv.FormatInt(comment.ID, 10)
+	comment.Depth = 0
+	if comment.ParentID != nil {
+		var parent domain.Comment
+		query := `
+			UPDATE comments SET reply_count = reply_count + 1
+			WHERE id = $1
+			RETURNING path, depth
+		`
+		if err := tx.GetContext(ctx, &parent, query, *comment.ParentID); err != nil {
+			return pkg.MapPostgresError(err)
+		}
+		comment.Path = parent.Path + "/" + comment.Path
+		comment.Depth = parent.Depth + 1
+	}
+
+	query := `
+		INSERT INTO comments (id, post_id, author_id, parent_id, path, depth, content)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at, updated_at
+	`
+	if err := tx.QueryRowxContext(
+		ctx, query,
+		comment.ID, comment.PostID, comment.AuthorID, comment.ParentID, comment.Path, comment.Depth, comment.Content,
+	).Scan(&comment.CreatedAt, &comment.UpdatedAt); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE posts SET comment_count = comment_count + 1 WHERE id = $1`, comment.PostID); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	query = `SELECT id, username, full_name, avatar FROM users WHERE id = $1`
+	if err := tx.GetContext(ctx, &comment.Author, query, comment.AuthorID); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *commentRepository) Update(ctx context.Context, comment *domain.Comment) error {
+	query := `
+		UPDATE comments SET content = $1, updated_at = NOW()
+		WHERE id = $2
+		RETURNING updated_at
+	`
+	if err := r.db.QueryRowxContext(ctx, query, comment.Content, comment.ID).Scan(&comment.UpdatedAt); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	return nil
+}
+
+func (r *commentRepository) Delete(ctx context.Context, comment *domain.Comment) (int, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	query := `DELETE FROM comments WHERE path = $1 OR path LIKE $1 || '/%'`
+	res, err := tx.ExecContext(ctx, query, comment.Path)
+	if err != nil {
+		return 0, pkg.MapPostgresError(err)
+	}
+	n, _ := res.RowsAffected()
+	if n == 0 {
+		return 0, pkg.ErrNotFound
+	}
+
+	if comment.ParentID != nil {
+		query := `UPDATE comments SET reply_count = GREATEST(reply_count - 1, 0) WHERE id = $1`
+		if _, err := tx.ExecContext(ctx, query, *comment.ParentID); err != nil {
+			return 0, pkg.MapPostgresError(err)
+		}
+	}
+
+	query = `UPDATE posts SET comment_count = GREATEST(comment_count - $1, 0) WHERE id = $2`
+	if _, err := tx.ExecContext(ctx, query, n, comment.PostID); err != nil {
+		return 0, pkg.MapPostgresError(err)
+	}
+
+	return int(n), tx.Commit()
+}
+
+func (r *commentRepository) GetByID(ctx context.Context, id int64) (*domain.Comment, error) {
+	query := `SELECT ` + commentColumns + `
+		FROM comments c
+		JOIN users u ON u.id = c.author_id
+		WHERE c.id = $1
+	`
+	var comment domain.Comment
+	if err := r.db.GetContext(ctx, &comment, query, id); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return &comment, nil
+}
+
+func (r *commentRepository) List(ctx context.Context, f domain.CommentListFilter) ([]domain.Comment, error) {
+	args := []any{f.PostID, f.BeforeID, f.Limit}
+
+	parentCond := `c.parent_id IS NULL`
+	if f.ParentID != 0 {
+		args = append(args, f.ParentID)
+		parentCond = `c.parent_id = $4`
+	}
+
+	cursorCond := `($2::BIGINT = 0 OR c.id < $2)`
+	order := `c.id DESC`
+	if f.Sort == domain.CommentSortTop {
+		args = append(args, f.BeforeReplyCount)
+		p := "$" + strconv.Itoa(len(args))
+		cursorCond = `($2::BIGINT = 0 OR (c.reply_count, c.id) < (` + p + `::INT, $2))`
+		order = `c.reply_count DESC, c.id DESC`
+	}
+
+	query := `SELECT ` + commentColumns + `
+		FROM comments c
+		JOIN users u ON u.id = c.author_id
+		WHERE c.post_id = $1 AND ` + parentCond + ` AND ` + cursorCond + `
+		ORDER BY ` + order + `
+		LIMIT $3
+	`
+	var comments []domain.Comment
+	if err := r.db.SelectContext(ctx, &comments, query, args...); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return comments, nil
+}
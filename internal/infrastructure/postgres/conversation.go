@@ -0,0 +1,413 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+const conversationColumns = `c.id, c.type, c.title, c.avatar, c.last_seq, c.last_message_id, c.created_at, c.updated_at`
+
+const memberColumns = `
+	m.conversation_id, m.user_id, m.role, m.last_read_seq, m.last_delivered_seq, m.joined_at,
+	u.id AS "user.id", u.username AS "user.username",
+	u.full_name AS "user.full_name", u.avatar AS "user.avatar"
+`
+
+type conversationRepository struct {
+	db *sqlx.DB
+}
+
+func NewConversationRepository(db *sqlx.DB) *conversationRepository {
+	return &conversationRepository{db: db}
+}
+
+func (r *conversationRepository) GetOrCreateDirect(ctx context.Context, userID, otherID int64) (*domain.Conversation, error) {
+	if userID > otherID {
+		userID, otherID = otherID, userID
+	}
+	key := fmt.Sprintf("%d:%d", userID, otherID)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// The no-op update makes RETURNING yield the row on conflict as well, so
+	// concurrent first messages end up in the same conversation.
+	var conv domain.Conversation
+	query := `
+		INSERT INTO conversations (type, direct_key)
+		VALUES ($1, $2)
+		ON CONFLICT (direct_key) DO UPDATE SET direct_key = EXCLUDED.direct_key
+		RETURNING id, type, title, avatar, last_seq, last_message_id, created_at, updated_at
+	`
+	if err := tx.GetContext(ctx, &conv, query, domain.ConversationDirect, key); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+
+	query = `
+		INSERT INTO conversation_members (conversation_id, user_id)
+		VALUES ($1, $2), ($1, $3)
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := tx.ExecContext(ctx, query, conv.ID, userID, otherID); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &conv, nil
+}
+
+func (r *conversationRepository) GetByID(ctx context.Context, id int64) (*domain.Conversation, error) {
+	query := `SELECT ` + conversationColumns + ` FROM conversations c WHERE c.id = $1`
+
+	var conv domain.Conversation
+	if err := r.db.GetContext(ctx, &conv, query, id); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return &conv, nil
+}
+
+func (r *conversationRepository) GetMember(ctx context.Context, conversationID, userID int64) (*domain.ConversationMember, error) {
+	query := `
+		SELECT ` + memberColumns + `
+		FROM conversation_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.conversation_id = $1 AND m.user_id = $2
+	`
+
+	var member domain.ConversationMember
+	if err := r.db.GetContext(ctx, &member, query, conversationID, userID); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return &member, nil
+}
+
+func (r *conversationRepository) ListByMember(ctx context.Context, f domain.ConversationListFilter) ([]domain.Conversation, error) {
+	query := `
+		SELECT ` + conversationColumns + `
+		FROM conversation_members m
+		JOIN conversations c ON c.id = m.conversation_id
+		WHERE m.user_id = $1
+		AND ($2::BIGINT = 0 OR (c.last_message_id, c.id) < ($3::BIGINT, $2))
+		ORDER BY c.last_message_id DESC, c.id DESC
+		LIMIT $4
+	`
+
+	var convs []domain.Conversation
+	if err := r.db.SelectContext(ctx, &convs, query, f.UserID, f.BeforeID, f.BeforeLastMessageID, f.Limit); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return convs, nil
+}
+
+func (r *conversationRepository) ListMembers(ctx context.Context, conversationIDs []int64) ([]domain.ConversationMember, error) {
+	if len(conversationIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT ` + memberColumns + `
+		FROM conversation_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.conversation_id = ANY($1)
+		ORDER BY m.conversation_id, m.joined_at, m.user_id
+	`
+
+	var members []domain.ConversationMember
+	if err := r.db.SelectContext(ctx, &members, query, conversationIDs); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return members, nil
+}
+
+func (r *conversationRepository) ListMemberIDs(ctx context.Context, conversationID int64) ([]int64, error) {
+	query := `SELECT user_id FROM conversation_members WHERE conversation_id = $1`
+
+	var ids []int64
+	if err := r.db.SelectContext(ctx, &ids, query, conversationID); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return ids, nil
+}
+
+func (r *conversationRepository) ListPeerIDs(ctx context.Context, userID int64) ([]int64, error) {
+	query := `
+		SELECT DISTINCT p.user_id
+		FROM conversation_members m
+		JOIN conversation_members p ON p.conversation_id = m.conversation_id
+		WHERE m.user_id = $1 AND p.user_id <> $1
+	`
+
+	var ids []int64
+	if err := r.db.SelectContext(ctx, &ids, query, userID); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return ids, nil
+}
+
+func (r *conversationRepository) CreateGroup(
+	ctx context.Context,
+	conv *domain.Conversation,
+	members []domain.ConversationMember,
+	events []*domain.Message,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO conversations (type, title, avatar)
+		VALUES ($1, $2, $3)
+		RETURNING id, type, title, avatar, last_seq, last_message_id, created_at, updated_at
+	`
+	if err := tx.GetContext(ctx, conv, query, domain.ConversationGroup, conv.Title, conv.Avatar); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	userIDs := make([]int64, 0, len(members))
+	roles := make([]string, 0, len(members))
+	for _, m := range members {
+		userIDs = append(userIDs, m.UserID)
+		roles = append(roles, string(m.Role))
+	}
+
+	query = `
+		INSERT INTO conversation_members (conversation_id, user_id, role)
+		SELECT $1, t.user_id, t.role
+		FROM UNNEST($2::BIGINT[], $3::VARCHAR[]) AS t (user_id, role)
+	`
+	if _, err := tx.ExecContext(ctx, query, conv.ID, userIDs, roles); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	for _, ev := range events {
+		ev.ConversationID = conv.ID
+		if err := insertMessage(ctx, tx, ev); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	conv.LastSeq = int64(len(events))
+	if len(events) > 0 {
+		conv.LastMessageID = events[len(events)-1].ID
+	}
+	return nil
+}
+
+func (r *conversationRepository) Update(ctx context.Context, conv *domain.Conversation, events []*domain.Message) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, ev := range events {
+		if err := insertMessage(ctx, tx, ev); err != nil {
+			return err
+		}
+	}
+
+	query := `
+		UPDATE conversations SET title = $1, avatar = $2, updated_at = NOW()
+		WHERE id = $3
+		RETURNING last_seq, last_message_id, updated_at
+	`
+	if err := tx.QueryRowxContext(ctx, query, conv.Title, conv.Avatar, conv.ID).
+		Scan(&conv.LastSeq, &conv.LastMessageID, &conv.UpdatedAt); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *conversationRepository) AddMembers(ctx context.Context, conversationID int64, userIDs []int64, events []*domain.Message) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, ev := range events {
+		if err := insertMessage(ctx, tx, ev); err != nil {
+			return err
+		}
+	}
+
+	// New members start reading at their own "added" events, so the history
+	// from before they joined does not count as unread.
+	query := `
+		INSERT INTO conversation_members (conversation_id, user_id, last_read_seq, last_delivered_seq)
+		SELECT c.id, u.id, c.last_seq - $3, c.last_seq - $3
+		FROM conversations c, UNNEST($2::BIGINT[]) AS u (id)
+		WHERE c.id = $1
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := tx.ExecContext(ctx, query, conversationID, userIDs, len(events)); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *conversationRepository) RemoveMember(ctx context.Context, conversationID, userID int64, event *domain.Message) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := removeConversationMember(ctx, tx, conversationID, userID, event); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *conversationRepository) UpdateMemberRole(
+	ctx context.Context,
+	conversationID, userID int64,
+	role domain.MemberRole,
+	event *domain.Message,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if event != nil {
+		if err := insertMessage(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+
+	query := `UPDATE conversation_members SET role = $3 WHERE conversation_id = $1 AND user_id = $2`
+	res, err := tx.ExecContext(ctx, query, conversationID, userID, role)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrNotFound
+	}
+
+	return tx.Commit()
+}
+
+func (r *conversationRepository) MarkDelivered(ctx context.Context, conversationID, userID, seq int64) (int64, error) {
+	query := `
+		UPDATE conversation_members m
+		SET last_delivered_seq = LEAST($3, c.last_seq)
+		FROM conversations c
+		WHERE c.id = m.conversation_id AND m.conversation_id = $1 AND m.user_id = $2
+		AND m.last_delivered_seq < LEAST($3, c.last_seq)
+		RETURNING m.last_delivered_seq
+	`
+	return r.advanceReceipt(ctx, query, conversationID, userID, seq)
+}
+
+func (r *conversationRepository) MarkRead(ctx context.Context, conversationID, userID, seq int64) (int64, error) {
+	query := `
+		UPDATE conversation_members m
+		SET last_read_seq = LEAST($3, c.last_seq),
+			last_delivered_seq = GREATEST(m.last_delivered_seq, LEAST($3, c.last_seq))
+		FROM conversations c
+		WHERE c.id = m.conversation_id AND m.conversation_id = $1 AND m.user_id = $2
+		AND m.last_read_seq < LEAST($3, c.last_seq)
+		RETURNING m.last_read_seq
+	`
+	return r.advanceReceipt(ctx, query, conversationID, userID, seq)
+}
+
+// advanceReceipt runs a receipt update whose WHERE clause only matches when
+// the position moves forward, so stale or repeated acks return 0.
+func (r *conversationRepository) advanceReceipt(ctx context.Context, query string, conversationID, userID, seq int64) (int64, error) {
+	var pos int64
+	if err := r.db.GetContext(ctx, &pos, query, conversationID, userID, seq); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, pkg.MapPostgresError(err)
+	}
+	return pos, nil
+}
+
+// removeConversationMember is RemoveMember inside tx.
+func removeConversationMember(ctx context.Context, tx *sqlx.Tx, conversationID, userID int64, event *domain.Message) error {
+	// Storing the event first takes the conversation row lock, which
+	// serializes concurrent removals and ownership hand-overs.
+	if event != nil {
+		if err := insertMessage(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+
+	var role domain.MemberRole
+	query := `
+		DELETE FROM conversation_members
+		WHERE conversation_id = $1 AND user_id = $2
+		RETURNING role
+	`
+	if err := tx.GetContext(ctx, &role, query, conversationID, userID); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	if role == domain.RoleOwner {
+		query = `
+			UPDATE conversation_members SET role = $2
+			WHERE conversation_id = $1 AND user_id = (
+				SELECT user_id FROM conversation_members
+				WHERE conversation_id = $1
+				ORDER BY role = $3 DESC, joined_at, user_id
+				LIMIT 1
+			)
+		`
+		if _, err := tx.ExecContext(ctx, query, conversationID, domain.RoleOwner, domain.RoleAdmin); err != nil {
+			return pkg.MapPostgresError(err)
+		}
+	}
+
+	query = `
+		DELETE FROM conversations c
+		WHERE c.id = $1
+		AND NOT EXISTS (SELECT 1 FROM conversation_members m WHERE m.conversation_id = c.id)
+	`
+	if _, err := tx.ExecContext(ctx, query, conversationID); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	return nil
+}
+
+// lockConversation bumps the sequence of a conversation inside tx and returns
+// the new value. The row lock serializes concurrent senders, which keeps the
+// sequence free of gaps and duplicates.
+func lockConversation(ctx context.Context, tx *sqlx.Tx, conversationID int64) (int64, error) {
+	var seq int64
+	query := `
+		UPDATE conversations SET last_seq = last_seq + 1, updated_at = NOW()
+		WHERE id = $1
+		RETURNING last_seq
+	`
+	if err := tx.GetContext(ctx, &seq, query, conversationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, pkg.ErrNotFound
+		}
+		return 0, pkg.MapPostgresError(err)
+	}
+	return seq, nil
+}
@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+type followRepository struct {
+	db *sqlx.DB
+}
+
+func NewFollowRepository(db *sqlx.DB) *followRepository {
+	return &followRepository{db: db}
+}
+
+func (r *followRepository) Create(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO follows (follower_id, followee_id)
+		VALUES ($1, $2)
+		ON CONFLICT (follower_id, followee_id) DO NOTHING
+	`
+	res, err := tx.ExecContext(ctx, query, followerID, followeeID)
+	if err != nil {
+		return false, pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return false, nil
+	}
+
+	if err := updateFollowCounts(ctx, tx, followerID, followeeID, 1); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+func (r *followRepository) Delete(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	query := `DELETE FROM follows WHERE follower_id = $1 AND followee_id = $2`
+	res, err := tx.ExecContext(ctx, query, followerID, followeeID)
+	if err != nil {
+		return false, pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return false, nil
+	}
+
+	if err := updateFollowCounts(ctx, tx, followerID, followeeID, -1); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+func (r *followRepository) IsFollowing(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM follows WHERE follower_id = $1 AND followee_id = $2)`
+	var exists bool
+	if err := r.db.GetContext(ctx, &exists, query, followerID, followeeID); err != nil {
+		return false, pkg.MapPostgresError(err)
+	}
+	return exists, nil
+}
+
+func (r *followRepository) ListFollowers(ctx context.Context, userID, beforeID int64, limit int) ([]domain.FollowEntry, error) {
+	query := `
+		SELECT f.id AS follow_id, f.created_at AS followed_at,
+			u.id, u.username, u.full_name, u.avatar
+		FROM follows f
+		JOIN users u ON u.id = f.follower_id
+		WHERE f.followee_id = $1 AND ($2::BIGINT = 0 OR f.id < $2)
+			AND u.delete_after IS NULL
+		ORDER BY f.id DESC
+		LIMIT $3
+	`
+	var entries []domain.FollowEntry
+	if err := r.db.SelectContext(ctx, &entries, query, userID, beforeID, limit); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return entries, nil
+}
+
+func (r *followRepository) ListFollowing(ctx context.Context, userID, beforeID int64, limit int) ([]domain.FollowEntry, error) {
+	query := `
+		SELECT f.id AS follow_id, f.created_at AS followed_at,
+			u.id, u.username, u.full_name, u.avatar
+		FROM follows f
+		JOIN users u ON u.id = f.followee_id
+		WHERE f.follower_id = $1 AND ($2::BIGINT = 0 OR f.id < $2)
+			AND u.delete_after IS NULL
+		ORDER BY f.id DESC
+		LIMIT $3
+	`
+	var entries []domain.FollowEntry
+	if err := r.db.SelectContext(ctx, &entries, query, userID, beforeID, limit); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return entries, nil
+}
+
+func (r *followRepository) ListFollowerIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error) {
+	query := `
+		SELECT follower_id
+		FROM follows
+		WHERE followee_id = $1 AND follower_id > $2
+		ORDER BY follower_id
+		LIMIT $3
+	`
+	var ids []int64
+	if err := r.db.SelectContext(ctx, &ids, query, userID, afterID, limit); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return ids, nil
+}
+
+func (r *followRepository) ListPopularFollowing(ctx context.Context, userID int64, minFollowers int) ([]int64, error) {
+	query := `
+		SELECT u.id
+		FROM follows f
+		JOIN users u ON u.id = f.followee_id
+		WHERE f.follower_id = $1 AND u.followers_count >= $2
+	`
+	var ids []int64
+	if err := r.db.SelectContext(ctx, &ids, query, userID, minFollowers); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return ids, nil
+}
+
+func (r *followRepository) FilterFollowing(ctx context.Context, followerID int64, followeeIDs []int64) ([]int64, error) {
+	if len(followeeIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `SELECT followee_id FROM follows WHERE follower_id = $1 AND followee_id = ANY($2)`
+	var ids []int64
+	if err := r.db.SelectContext(ctx, &ids, query, followerID, followeeIDs); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return ids, nil
+}
+
+// updateFollowCounts keeps the denormalized counters on users in step with
+// the follows table. It runs in the same transaction as the insert/delete.
+//
+// Rows are always locked in ascending id order so that two users following
+// each other at the same time cannot deadlock.
+func updateFollowCounts(ctx context.Context, tx *sqlx.Tx, followerID, followeeID int64, delta int) error {
+	queries := []struct {
+		sql string
+		id  int64
+	}{
+		{`UPDATE users SET following_count = GREATEST(following_count + $1, 0) WHERE id = $2`, followerID},
+		{`UPDATE users SET followers_count = GREATEST(followers_count + $1, 0) WHERE id = $2`, followeeID},
+	}
+	if followeeID < followerID {
+		queries[0], queries[1] = queries[1], queries[0]
+	}
+
+	for _, q := range queries {
+		if _, err := tx.ExecContext(ctx, q.sql, delta, q.id); err != nil {
+			return pkg.MapPostgresError(err)
+		}
+	}
+	return nil
+}
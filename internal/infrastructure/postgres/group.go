@@ -0,0 +1,355 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+const groupColumns = `
+	g.id, g.name, g.description, g.visibility, g.cover, g.created_at, g.updated_at,
+	(SELECT COUNT(*) FROM group_members c WHERE c.group_id = g.id AND c.status = 'active') AS member_count
+`
+
+const groupMemberColumns = `
+	m.group_id, m.user_id, m.role, m.status, m.created_at, m.updated_at,
+	u.id AS "user.id", u.username AS "user.username",
+	u.full_name AS "user.full_name", u.avatar AS "user.avatar"
+`
+
+type groupRepository struct {
+	db *sqlx.DB
+}
+
+func NewGroupRepository(db *sqlx.DB) *groupRepository {
+	return &groupRepository{db: db}
+}
+
+func (r *groupRepository) Create(ctx context.Context, group *domain.Group, ownerID int64) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO groups (name, description, visibility, cover)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, updated_at
+	`
+	if err := tx.QueryRowxContext(ctx, query, group.Name, group.Description, group.Visibility, group.Cover).
+		Scan(&group.ID, &group.CreatedAt, &group.UpdatedAt); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	query = `
+		INSERT INTO group_members (group_id, user_id, role, status)
+		VALUES ($1, $2, $3, $4)
+	`
+	if _, err := tx.ExecContext(ctx, query, group.ID, ownerID, domain.GroupRoleOwner, domain.GroupMemberActive); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	group.MemberCount = 1
+
+	return tx.Commit()
+}
+
+func (r *groupRepository) Update(ctx context.Context, group *domain.Group) error {
+	query := `
+		UPDATE groups
+		SET name = $2, description = $3, visibility = $4, cover = $5, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+	err := r.db.QueryRowxContext(ctx, query, group.ID, group.Name, group.Description, group.Visibility, group.Cover).
+		Scan(&group.UpdatedAt)
+	return pkg.MapPostgresError(err)
+}
+
+func (r *groupRepository) Delete(ctx context.Context, id int64) ([]string, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	keys, err := deleteGroup(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (r *groupRepository) GetByID(ctx context.Context, id int64) (*domain.Group, error) {
+	query := `SELECT ` + groupColumns + ` FROM groups g WHERE g.id = $1`
+
+	var group domain.Group
+	if err := r.db.GetContext(ctx, &group, query, id); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return &group, nil
+}
+
+func (r *groupRepository) List(ctx context.Context, f domain.GroupListFilter) ([]domain.Group, error) {
+	var groups []domain.Group
+
+	if f.MemberID > 0 {
+		query := `
+			SELECT ` + groupColumns + `
+			FROM group_members m
+			JOIN groups g ON g.id = m.group_id
+			WHERE m.user_id = $1 AND m.status = $2 AND ($3::BIGINT = 0 OR g.id < $3)
+			ORDER BY g.id DESC
+			LIMIT $4
+		`
+		if err := r.db.SelectContext(ctx, &groups, query, f.MemberID, domain.GroupMemberActive, f.BeforeID, f.Limit); err != nil {
+			return nil, pkg.MapPostgresError(err)
+		}
+		return groups, nil
+	}
+
+	query := `
+		SELECT ` + groupColumns + `
+		FROM groups g
+		WHERE g.visibility <> $1 AND ($2::BIGINT = 0 OR g.id < $2)
+		ORDER BY g.id DESC
+		LIMIT $3
+	`
+	if err := r.db.SelectContext(ctx, &groups, query, domain.GroupSecret, f.BeforeID, f.Limit); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return groups, nil
+}
+
+func (r *groupRepository) GetMember(ctx context.Context, groupID, userID int64) (*domain.GroupMember, error) {
+	query := `
+		SELECT ` + groupMemberColumns + `
+		FROM group_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.group_id = $1 AND m.user_id = $2
+	`
+
+	var member domain.GroupMember
+	if err := r.db.GetContext(ctx, &member, query, groupID, userID); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return &member, nil
+}
+
+func (r *groupRepository) ListMembers(ctx context.Context, f domain.GroupMemberFilter) ([]domain.GroupMember, error) {
+	query := `
+		SELECT ` + groupMemberColumns + `
+		FROM group_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.group_id = $1 AND m.status = $2 AND m.user_id > $3
+		ORDER BY m.user_id
+		LIMIT $4
+	`
+
+	var members []domain.GroupMember
+	if err := r.db.SelectContext(ctx, &members, query, f.GroupID, f.Status, f.AfterUserID, f.Limit); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return members, nil
+}
+
+func (r *groupRepository) ListMemberships(ctx context.Context, userID int64, groupIDs []int64) ([]domain.GroupMember, error) {
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT ` + groupMemberColumns + `
+		FROM group_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.user_id = $1 AND m.group_id = ANY($2)
+	`
+
+	var members []domain.GroupMember
+	if err := r.db.SelectContext(ctx, &members, query, userID, groupIDs); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return members, nil
+}
+
+func (r *groupRepository) ListMemberIDs(ctx context.Context, groupID, afterID int64, limit int) ([]int64, error) {
+	query := `
+		SELECT user_id
+		FROM group_members
+		WHERE group_id = $1 AND status = $2 AND user_id > $3
+		ORDER BY user_id
+		LIMIT $4
+	`
+	var ids []int64
+	if err := r.db.SelectContext(ctx, &ids, query, groupID, domain.GroupMemberActive, afterID, limit); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return ids, nil
+}
+
+func (r *groupRepository) FilterMemberOf(ctx context.Context, userID int64, groupIDs []int64) ([]int64, error) {
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `SELECT group_id FROM group_members WHERE user_id = $1 AND status = $2 AND group_id = ANY($3)`
+	var ids []int64
+	if err := r.db.SelectContext(ctx, &ids, query, userID, domain.GroupMemberActive, groupIDs); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return ids, nil
+}
+
+func (r *groupRepository) SaveMember(ctx context.Context, member *domain.GroupMember) error {
+	query := `
+		INSERT INTO group_members (group_id, user_id, role, status)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (group_id, user_id) DO UPDATE
+		SET role = EXCLUDED.role, status = EXCLUDED.status, updated_at = NOW()
+		RETURNING created_at, updated_at
+	`
+	err := r.db.QueryRowxContext(ctx, query, member.GroupID, member.UserID, member.Role, member.Status).
+		Scan(&member.CreatedAt, &member.UpdatedAt)
+	return pkg.MapPostgresError(err)
+}
+
+func (r *groupRepository) DeleteMember(ctx context.Context, groupID, userID int64) error {
+	query := `DELETE FROM group_members WHERE group_id = $1 AND user_id = $2`
+	res, err := r.db.ExecContext(ctx, query, groupID, userID)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrNotFound
+	}
+	return nil
+}
+
+func (r *groupRepository) RemoveOwner(ctx context.Context, groupID, ownerID int64) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := removeGroupOwner(ctx, tx, groupID, ownerID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *groupRepository) TransferOwnership(ctx context.Context, groupID, ownerID, toID int64) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := lockGroup(ctx, tx, groupID); err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE group_members SET role = $4, updated_at = NOW()
+		WHERE group_id = $1 AND user_id = $2 AND role = $3
+	`
+	res, err := tx.ExecContext(ctx, query, groupID, ownerID, domain.GroupRoleOwner, domain.GroupRoleAdmin)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrConflict
+	}
+
+	query = `
+		UPDATE group_members SET role = $3, updated_at = NOW()
+		WHERE group_id = $1 AND user_id = $2 AND status = $4
+	`
+	res, err = tx.ExecContext(ctx, query, groupID, toID, domain.GroupRoleOwner, domain.GroupMemberActive)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrNotFound
+	}
+
+	return tx.Commit()
+}
+
+// deleteGroup removes the group inside tx and returns the object keys of the
+// media of its posts.
+func deleteGroup(ctx context.Context, tx *sqlx.Tx, id int64) ([]string, error) {
+	// Locking the group keeps new posts, which reference it, from being
+	// created until it is gone, so no media escapes the list below.
+	if err := lockGroup(ctx, tx, id); err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT pm.object_key
+		FROM post_media pm
+		JOIN posts p ON p.id = pm.post_id
+		WHERE p.group_id = $1
+	`
+	var keys []string
+	if err := tx.SelectContext(ctx, &keys, query, id); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM groups WHERE id = $1`, id); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return keys, nil
+}
+
+// removeGroupOwner is RemoveOwner inside tx.
+func removeGroupOwner(ctx context.Context, tx *sqlx.Tx, groupID, ownerID int64) error {
+	// The group lock serializes ownership changes, so two owners can never
+	// be promoted at once.
+	if err := lockGroup(ctx, tx, groupID); err != nil {
+		return err
+	}
+
+	query := `DELETE FROM group_members WHERE group_id = $1 AND user_id = $2 AND role = $3`
+	res, err := tx.ExecContext(ctx, query, groupID, ownerID, domain.GroupRoleOwner)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrNotFound
+	}
+
+	query = `
+		UPDATE group_members SET role = $2, updated_at = NOW()
+		WHERE group_id = $1 AND user_id = (
+			SELECT user_id FROM group_members
+			WHERE group_id = $1 AND status = $3
+			ORDER BY CASE role WHEN $4 THEN 0 WHEN $5 THEN 1 ELSE 2 END, created_at, user_id
+			LIMIT 1
+		)
+	`
+	res, err = tx.ExecContext(ctx, query, groupID, domain.GroupRoleOwner, domain.GroupMemberActive,
+		domain.GroupRoleAdmin, domain.GroupRoleModerator)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrConflict
+	}
+	return nil
+}
+
+// lockGroup takes the row lock of the group for the rest of the transaction.
+func lockGroup(ctx context.Context, tx *sqlx.Tx, groupID int64) error {
+	var id int64
+	err := tx.GetContext(ctx, &id, `SELECT id FROM groups WHERE id = $1 FOR UPDATE`, groupID)
+	return pkg.MapPostgresError(err)
+}
@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+// The sender of a message outlives their account as 0.
+const messageColumns = `id, conversation_id, COALESCE(sender_id, 0) AS sender_id, seq, type, content, object_key, feature, event, target_id, created_at`
+
+type messageRepository struct {
+	db *sqlx.DB
+}
+
+func NewMessageRepository(db *sqlx.DB) *messageRepository {
+	return &messageRepository{db: db}
+}
+
+func (r *messageRepository) Create(ctx context.Context, msg *domain.Message) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := insertMessage(ctx, tx, msg); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *messageRepository) List(ctx context.Context, f domain.MessageListFilter) ([]domain.Message, error) {
+	var (
+		query string
+		args  []any
+	)
+
+	if f.AfterSeq != nil {
+		query = `
+			SELECT ` + messageColumns + `
+			FROM messages
+			WHERE conversation_id = $1 AND seq > $2
+			ORDER BY seq ASC
+			LIMIT $3
+		`
+		args = []any{f.ConversationID, *f.AfterSeq, f.Limit}
+	} else {
+		query = `
+			SELECT ` + messageColumns + `
+			FROM messages
+			WHERE conversation_id = $1 AND ($2::BIGINT = 0 OR seq < $2)
+			ORDER BY seq DESC
+			LIMIT $3
+		`
+		args = []any{f.ConversationID, f.BeforeSeq, f.Limit}
+	}
+
+	var msgs []domain.Message
+	if err := r.db.SelectContext(ctx, &msgs, query, args...); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return msgs, nil
+}
+
+func (r *messageRepository) ListByIDs(ctx context.Context, ids []int64) ([]domain.Message, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query := `SELECT ` + messageColumns + ` FROM messages WHERE id = ANY($1)`
+
+	var msgs []domain.Message
+	if err := r.db.SelectContext(ctx, &msgs, query, ids); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return msgs, nil
+}
+
+// insertMessage stores msg inside tx with the next sequence number of its
+// conversation, makes it the conversation's last message and marks it read
+// for the sender.
+func insertMessage(ctx context.Context, tx *sqlx.Tx, msg *domain.Message) error {
+	seq, err := lockConversation(ctx, tx, msg.ConversationID)
+	if err != nil {
+		return err
+	}
+	msg.Seq = seq
+
+	query := `
+		INSERT INTO messages (conversation_id, sender_id, seq, type, content, object_key, feature, event, target_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at
+	`
+	if err := tx.QueryRowxContext(
+		ctx, query,
+		msg.ConversationID, msg.SenderID, msg.Seq, msg.Type, msg.Content, msg.ObjectKey, msg.Feature, msg.Event, msg.TargetID,
+	).Scan(&msg.ID, &msg.CreatedAt); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	query = `UPDATE conversations SET last_message_id = $1 WHERE id = $2`
+	if _, err := tx.ExecContext(ctx, query, msg.ID, msg.ConversationID); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	// The sender has seen their own message.
+	query = `
+		UPDATE conversation_members SET last_read_seq = $3, last_delivered_seq = $3
+		WHERE conversation_id = $1 AND user_id = $2
+	`
+	if _, err := tx.ExecContext(ctx, query, msg.ConversationID, msg.SenderID, msg.Seq); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	return nil
+}
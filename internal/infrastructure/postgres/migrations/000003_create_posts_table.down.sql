@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS post_media CASCADE;
+DROP TABLE IF EXISTS posts CASCADE;
\ No newline at end of file
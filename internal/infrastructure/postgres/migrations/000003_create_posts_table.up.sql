@@ -0,0 +1,20 @@
+CREATE TABLE
+    posts (
+        id BIGSERIAL PRIMARY KEY,
+        author_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+        content TEXT NOT NULL DEFAULT '',
+        visibility VARCHAR(20) NOT NULL DEFAULT 'public',
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+    );
+
+CREATE INDEX idx_posts_author_id_id ON posts (author_id, id DESC);
+
+CREATE TABLE
+    post_media (
+        post_id BIGINT NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+        object_key VARCHAR(255) NOT NULL UNIQUE,
+        feature VARCHAR(30) NOT NULL,
+        position SMALLINT NOT NULL,
+        PRIMARY KEY (post_id, position)
+    );
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE users
+DROP COLUMN IF EXISTS followers_count,
+DROP COLUMN IF EXISTS following_count;
+
+DROP TABLE IF EXISTS follows CASCADE;
\ No newline at end of file
@@ -0,0 +1,17 @@
+CREATE TABLE
+    follows (
+        id BIGSERIAL PRIMARY KEY,
+        follower_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+        followee_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        CONSTRAINT uq_follows_pair UNIQUE (follower_id, followee_id),
+        CONSTRAINT chk_follows_not_self CHECK (follower_id <> followee_id)
+    );
+
+CREATE INDEX idx_follows_followee_id_id ON follows (followee_id, id DESC);
+
+CREATE INDEX idx_follows_follower_id_id ON follows (follower_id, id DESC);
+
+ALTER TABLE users
+ADD COLUMN followers_count INT NOT NULL DEFAULT 0,
+ADD COLUMN following_count INT NOT NULL DEFAULT 0;
\ No newline at end of file
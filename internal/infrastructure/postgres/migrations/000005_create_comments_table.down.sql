@@ -0,0 +1,4 @@
+ALTER TABLE posts
+DROP COLUMN IF EXISTS comment_count;
+
+DROP TABLE IF EXISTS comments CASCADE;
\ No newline at end of file
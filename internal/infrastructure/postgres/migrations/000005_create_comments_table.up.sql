@@ -0,0 +1,22 @@
+CREATE TABLE
+    comments (
+        id BIGSERIAL PRIMARY KEY,
+        post_id BIGINT NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+        author_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+        parent_id BIGINT REFERENCES comments (id) ON DELETE CASCADE,
+        path TEXT NOT NULL,
+        depth INT NOT NULL DEFAULT 0,
+        content TEXT NOT NULL,
+        reply_count INT NOT NULL DEFAULT 0,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+    );
+
+CREATE INDEX idx_comments_post_id_parent_id_id ON comments (post_id, parent_id, id DESC);
+
+CREATE INDEX idx_comments_post_id_parent_id_top ON comments (post_id, parent_id, reply_count DESC, id DESC);
+
+CREATE INDEX idx_comments_path ON comments (path text_pattern_ops);
+
+ALTER TABLE posts
+ADD COLUMN comment_count INT NOT NULL DEFAULT 0;
\ No newline at end of file
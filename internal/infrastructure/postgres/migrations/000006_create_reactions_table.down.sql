@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS comment_reactions CASCADE;
+
+DROP TABLE IF EXISTS post_reactions CASCADE;
\ No newline at end of file
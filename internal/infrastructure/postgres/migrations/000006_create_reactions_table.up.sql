@@ -0,0 +1,23 @@
+CREATE TABLE
+    post_reactions (
+        id BIGSERIAL PRIMARY KEY,
+        post_id BIGINT NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+        user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+        type VARCHAR(20) NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        CONSTRAINT uq_post_reactions_user UNIQUE (post_id, user_id)
+    );
+
+CREATE INDEX idx_post_reactions_post_id_id ON post_reactions (post_id, id DESC);
+
+CREATE TABLE
+    comment_reactions (
+        id BIGSERIAL PRIMARY KEY,
+        comment_id BIGINT NOT NULL REFERENCES comments (id) ON DELETE CASCADE,
+        user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+        type VARCHAR(20) NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        CONSTRAINT uq_comment_reactions_user UNIQUE (comment_id, user_id)
+    );
+
+CREATE INDEX idx_comment_reactions_comment_id_id ON comment_reactions (comment_id, id DESC);
\ No newline at end of file
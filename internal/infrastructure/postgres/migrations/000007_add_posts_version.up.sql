@@ -0,0 +1,2 @@
+ALTER TABLE posts
+ADD COLUMN version INT NOT NULL DEFAULT 1;
\ No newline at end of file
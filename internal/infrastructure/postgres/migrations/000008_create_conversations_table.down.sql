@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS messages CASCADE;
+
+DROP TABLE IF EXISTS conversation_members CASCADE;
+
+DROP TABLE IF EXISTS conversations CASCADE;
\ No newline at end of file
@@ -0,0 +1,36 @@
+CREATE TABLE
+    conversations (
+        id BIGSERIAL PRIMARY KEY,
+        type VARCHAR(20) NOT NULL DEFAULT 'direct',
+        -- "<smaller user id>:<larger user id>" for direct conversations, so a
+        -- pair of users can only ever have one of them.
+        direct_key VARCHAR(50) UNIQUE,
+        last_seq BIGINT NOT NULL DEFAULT 0,
+        last_message_id BIGINT NOT NULL DEFAULT 0,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+    );
+
+CREATE TABLE
+    conversation_members (
+        conversation_id BIGINT NOT NULL REFERENCES conversations (id) ON DELETE CASCADE,
+        user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+        joined_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        PRIMARY KEY (conversation_id, user_id)
+    );
+
+CREATE INDEX idx_conversation_members_user_id ON conversation_members (user_id);
+
+CREATE TABLE
+    messages (
+        id BIGSERIAL PRIMARY KEY,
+        conversation_id BIGINT NOT NULL REFERENCES conversations (id) ON DELETE CASCADE,
+        sender_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+        seq BIGINT NOT NULL,
+        type VARCHAR(20) NOT NULL DEFAULT 'text',
+        content TEXT NOT NULL DEFAULT '',
+        object_key VARCHAR(255) UNIQUE,
+        feature VARCHAR(30) NOT NULL DEFAULT '',
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        CONSTRAINT uq_messages_conversation_seq UNIQUE (conversation_id, seq)
+    );
\ No newline at end of file
@@ -0,0 +1,10 @@
+ALTER TABLE messages
+DROP COLUMN IF EXISTS target_id,
+DROP COLUMN IF EXISTS event;
+
+ALTER TABLE conversation_members
+DROP COLUMN IF EXISTS role;
+
+ALTER TABLE conversations
+DROP COLUMN IF EXISTS avatar,
+DROP COLUMN IF EXISTS title;
\ No newline at end of file
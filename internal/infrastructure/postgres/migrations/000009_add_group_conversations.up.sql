@@ -0,0 +1,10 @@
+ALTER TABLE conversations
+ADD COLUMN title VARCHAR(100) NOT NULL DEFAULT '',
+ADD COLUMN avatar VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE conversation_members
+ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'member';
+
+ALTER TABLE messages
+ADD COLUMN event VARCHAR(30) NOT NULL DEFAULT '',
+ADD COLUMN target_id BIGINT REFERENCES users (id) ON DELETE SET NULL;
\ No newline at end of file
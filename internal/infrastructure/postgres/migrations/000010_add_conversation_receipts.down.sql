@@ -0,0 +1,3 @@
+ALTER TABLE conversation_members
+DROP COLUMN IF EXISTS last_delivered_seq,
+DROP COLUMN IF EXISTS last_read_seq;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE conversation_members
+ADD COLUMN last_read_seq BIGINT NOT NULL DEFAULT 0,
+ADD COLUMN last_delivered_seq BIGINT NOT NULL DEFAULT 0;
\ No newline at end of file
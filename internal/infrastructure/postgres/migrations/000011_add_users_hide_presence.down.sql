@@ -0,0 +1,2 @@
+ALTER TABLE users
+DROP COLUMN IF EXISTS hide_presence;
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE posts
+DROP COLUMN IF EXISTS group_id;
+
+DROP TABLE IF EXISTS group_members CASCADE;
+
+DROP TABLE IF EXISTS groups CASCADE;
\ No newline at end of file
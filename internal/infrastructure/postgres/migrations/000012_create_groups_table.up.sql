@@ -0,0 +1,33 @@
+CREATE TABLE
+    groups (
+        id BIGSERIAL PRIMARY KEY,
+        name VARCHAR(100) NOT NULL,
+        description TEXT NOT NULL DEFAULT '',
+        visibility VARCHAR(20) NOT NULL DEFAULT 'public',
+        cover VARCHAR(255) NOT NULL DEFAULT '',
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+    );
+
+CREATE INDEX idx_groups_visibility_id ON groups (visibility, id DESC);
+
+CREATE TABLE
+    group_members (
+        group_id BIGINT NOT NULL REFERENCES groups (id) ON DELETE CASCADE,
+        user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+        role VARCHAR(20) NOT NULL DEFAULT 'member',
+        -- active, pending (join request), invited or banned
+        status VARCHAR(20) NOT NULL DEFAULT 'active',
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        PRIMARY KEY (group_id, user_id)
+    );
+
+CREATE INDEX idx_group_members_user_id ON group_members (user_id);
+
+ALTER TABLE posts
+ADD COLUMN group_id BIGINT REFERENCES groups (id) ON DELETE CASCADE;
+
+CREATE INDEX idx_posts_group_id_id ON posts (group_id, id DESC)
+WHERE
+    group_id IS NOT NULL;
\ No newline at end of file
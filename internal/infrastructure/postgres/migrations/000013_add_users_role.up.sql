@@ -0,0 +1,3 @@
+ALTER TABLE users
+ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'user'
+CHECK (role IN ('user', 'moderator', 'admin'));
\ No newline at end of file
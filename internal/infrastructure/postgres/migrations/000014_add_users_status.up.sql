@@ -0,0 +1,4 @@
+ALTER TABLE users
+ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'active'
+CHECK (status IN ('active', 'suspended', 'banned')),
+ADD COLUMN suspended_until TIMESTAMPTZ;
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE
+    admin_audit_logs (
+        id BIGSERIAL PRIMARY KEY,
+        -- Kept when either account is deleted, so the trail survives them
+        actor_id BIGINT REFERENCES users (id) ON DELETE SET NULL,
+        target_user_id BIGINT REFERENCES users (id) ON DELETE SET NULL,
+        action VARCHAR(50) NOT NULL,
+        details JSONB NOT NULL DEFAULT '{}',
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+    );
+
+CREATE INDEX idx_admin_audit_logs_target_user_id ON admin_audit_logs (target_user_id, id DESC);
+
+CREATE INDEX idx_admin_audit_logs_actor_id ON admin_audit_logs (actor_id, id DESC);
\ No newline at end of file
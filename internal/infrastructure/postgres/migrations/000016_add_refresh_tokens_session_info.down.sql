@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_refresh_tokens_user_id_session_id;
+
+ALTER TABLE refresh_tokens
+DROP COLUMN IF EXISTS last_used_at,
+DROP COLUMN IF EXISTS signed_in_at,
+DROP COLUMN IF EXISTS ip_address,
+DROP COLUMN IF EXISTS user_agent,
+DROP COLUMN IF EXISTS device_name,
+DROP COLUMN IF EXISTS session_id;
\ No newline at end of file
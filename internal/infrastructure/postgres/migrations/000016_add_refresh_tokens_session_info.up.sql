@@ -0,0 +1,15 @@
+ALTER TABLE refresh_tokens
+-- Rotated tokens keep the session_id and signed_in_at of the token they replace
+ADD COLUMN session_id UUID NOT NULL DEFAULT gen_random_uuid (),
+ADD COLUMN device_name VARCHAR(100) NOT NULL DEFAULT '',
+ADD COLUMN user_agent VARCHAR(512) NOT NULL DEFAULT '',
+ADD COLUMN ip_address VARCHAR(45) NOT NULL DEFAULT '',
+ADD COLUMN signed_in_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+ADD COLUMN last_used_at TIMESTAMPTZ NOT NULL DEFAULT NOW ();
+
+UPDATE refresh_tokens
+SET
+    signed_in_at = created_at,
+    last_used_at = created_at;
+
+CREATE INDEX idx_refresh_tokens_user_id_session_id ON refresh_tokens (user_id, session_id);
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS user_recovery_codes CASCADE;
+
+DROP TABLE IF EXISTS user_two_factor CASCADE;
\ No newline at end of file
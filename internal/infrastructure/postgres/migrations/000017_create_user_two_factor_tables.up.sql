@@ -0,0 +1,22 @@
+CREATE TABLE
+    user_two_factor (
+        user_id BIGINT PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+        -- AES-GCM sealed TOTP secret, see TWO_FACTOR_ENCRYPTION_KEY
+        secret BYTEA NOT NULL,
+        -- Last TOTP time step accepted, so a code cannot be used twice
+        last_step BIGINT,
+        -- NULL until the first code confirms the enrollment
+        enabled_at TIMESTAMPTZ,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+    );
+
+CREATE TABLE
+    user_recovery_codes (
+        id BIGSERIAL PRIMARY KEY,
+        user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+        code_hash VARCHAR(64) NOT NULL,
+        used_at TIMESTAMPTZ,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW ()
+    );
+
+CREATE INDEX idx_user_recovery_codes_user_id ON user_recovery_codes (user_id, code_hash);
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE
+    user_identities (
+        id BIGSERIAL PRIMARY KEY,
+        user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+        -- Name of the provider in OAUTH_PROVIDERS, e.g. google
+        provider VARCHAR(50) NOT NULL,
+        -- The sub claim, stable for the user at the provider
+        subject VARCHAR(255) NOT NULL,
+        email VARCHAR(255) NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW (),
+        UNIQUE (provider, subject)
+    );
+
+CREATE INDEX idx_user_identities_user_id ON user_identities (user_id);
\ No newline at end of file
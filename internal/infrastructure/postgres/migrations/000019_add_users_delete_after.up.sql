@@ -0,0 +1,6 @@
+ALTER TABLE users
+ADD COLUMN delete_after TIMESTAMPTZ;
+
+CREATE INDEX idx_users_delete_after ON users (delete_after)
+WHERE
+    delete_after IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,9 @@
+-- Messages of purged senders cannot be kept once sender_id is required.
+DELETE FROM messages
+WHERE
+    sender_id IS NULL;
+
+ALTER TABLE messages
+ALTER COLUMN sender_id SET NOT NULL,
+DROP CONSTRAINT messages_sender_id_fkey,
+ADD CONSTRAINT messages_sender_id_fkey FOREIGN KEY (sender_id) REFERENCES users (id) ON DELETE CASCADE;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE messages
+ALTER COLUMN sender_id DROP NOT NULL,
+DROP CONSTRAINT messages_sender_id_fkey,
+ADD CONSTRAINT messages_sender_id_fkey FOREIGN KEY (sender_id) REFERENCES users (id) ON DELETE SET NULL;
\ No newline at end of file
@@ -0,0 +1,268 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+// postColumns reads a post joined with its author as u. Posts of banned
+// authors and of accounts awaiting deletion are flagged hidden.
+const postColumns = `
+	p.id, p.author_id, p.group_id, p.content, p.visibility, p.comment_count, p.version, p.created_at, p.updated_at,
+	(u.delete_after IS NOT NULL OR u.status = 'banned') AS author_hidden
+`
+
+type postRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostRepository(db *sqlx.DB) *postRepository {
+	return &postRepository{db: db}
+}
+
+func (r *postRepository) Create(ctx context.Context, post *domain.Post) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO posts (author_id, group_id, content, visibility)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, version, created_at, updated_at
+	`
+	if err := tx.QueryRowxContext(ctx, query, post.AuthorID, post.GroupID, post.Content, post.Visibility).
+		Scan(&post.ID, &post.Version, &post.CreatedAt, &post.UpdatedAt); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	if err := insertPostMedia(ctx, tx, post); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *postRepository) Update(ctx context.Context, post *domain.Post) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The version check rejects the update when the post changed since it was
+	// read, so a concurrent edit can't be overwritten along with its media.
+	query := `
+		UPDATE posts
+		SET content = $1, visibility = $2, updated_at = NOW(), version = version + 1
+		WHERE id = $3 AND version = $4
+		RETURNING updated_at, version
+	`
+	if err := tx.QueryRowxContext(ctx, query, post.Content, post.Visibility, post.ID, post.Version).
+		Scan(&post.UpdatedAt, &post.Version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return pkg.ErrConflict
+		}
+		return pkg.MapPostgresError(err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM post_media WHERE post_id = $1`, post.ID); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	if err := insertPostMedia(ctx, tx, post); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *postRepository) Delete(ctx context.Context, id int64) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM posts WHERE id = $1`, id)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrNotFound
+	}
+	return nil
+}
+
+func (r *postRepository) GetByID(ctx context.Context, id int64) (*domain.Post, error) {
+	query := `
+		SELECT ` + postColumns + `
+		FROM posts p
+		JOIN users u ON u.id = p.author_id
+		WHERE p.id = $1
+	`
+	var post domain.Post
+	if err := r.db.GetContext(ctx, &post, query, id); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+
+	posts := []domain.Post{post}
+	if err := r.attachMedia(ctx, posts); err != nil {
+		return nil, err
+	}
+	return &posts[0], nil
+}
+
+func (r *postRepository) ListByAuthor(ctx context.Context, f domain.PostListFilter) ([]domain.Post, error) {
+	query := `
+		SELECT ` + postColumns + `
+		FROM posts p
+		JOIN users u ON u.id = p.author_id
+		WHERE p.author_id = $1
+			AND p.group_id IS NULL
+			AND p.visibility = ANY($2)
+			AND ($3::BIGINT = 0 OR p.id < $3)
+			AND u.delete_after IS NULL AND u.status <> 'banned'
+		ORDER BY p.id DESC
+		LIMIT $4
+	`
+	var posts []domain.Post
+	if err := r.db.SelectContext(ctx, &posts, query, f.AuthorID, visibilityStrings(f.Visibilities), f.BeforeID, f.Limit); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+
+	if err := r.attachMedia(ctx, posts); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+func (r *postRepository) ListByAuthors(ctx context.Context, f domain.PostFeedFilter) ([]domain.Post, error) {
+	if len(f.AuthorIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT ` + postColumns + `
+		FROM posts p
+		JOIN users u ON u.id = p.author_id
+		WHERE p.author_id = ANY($1)
+			AND p.group_id IS NULL
+			AND p.visibility = ANY($2)
+			AND ($3::BIGINT = 0 OR p.id < $3)
+			AND u.delete_after IS NULL AND u.status <> 'banned'
+		ORDER BY p.id DESC
+		LIMIT $4
+	`
+	var posts []domain.Post
+	if err := r.db.SelectContext(ctx, &posts, query, f.AuthorIDs, visibilityStrings(f.Visibilities), f.BeforeID, f.Limit); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+
+	if err := r.attachMedia(ctx, posts); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+func (r *postRepository) ListByGroup(ctx context.Context, f domain.PostGroupFilter) ([]domain.Post, error) {
+	query := `
+		SELECT ` + postColumns + `
+		FROM posts p
+		JOIN users u ON u.id = p.author_id
+		WHERE p.group_id = $1 AND ($2::BIGINT = 0 OR p.id < $2)
+			AND u.delete_after IS NULL AND u.status <> 'banned'
+		ORDER BY p.id DESC
+		LIMIT $3
+	`
+	var posts []domain.Post
+	if err := r.db.SelectContext(ctx, &posts, query, f.GroupID, f.BeforeID, f.Limit); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+
+	if err := r.attachMedia(ctx, posts); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+func (r *postRepository) ListByIDs(ctx context.Context, ids []int64) ([]domain.Post, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT ` + postColumns + `
+		FROM posts p
+		JOIN users u ON u.id = p.author_id
+		WHERE p.id = ANY($1)
+			AND u.delete_after IS NULL AND u.status <> 'banned'
+		ORDER BY p.id DESC
+	`
+	var posts []domain.Post
+	if err := r.db.SelectContext(ctx, &posts, query, ids); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+
+	if err := r.attachMedia(ctx, posts); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// attachMedia loads attachments for all given posts in a single query
+// and assigns them in position order.
+func (r *postRepository) attachMedia(ctx context.Context, posts []domain.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(posts))
+	index := make(map[int64]int, len(posts))
+	for i, p := range posts {
+		ids[i] = p.ID
+		index[p.ID] = i
+	}
+
+	query := `
+		SELECT post_id, object_key, feature, position
+		FROM post_media
+		WHERE post_id = ANY($1)
+		ORDER BY post_id, position
+	`
+	var media []domain.PostMedia
+	if err := r.db.SelectContext(ctx, &media, query, ids); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	for _, m := range media {
+		i := index[m.PostID]
+		posts[i].Media = append(posts[i].Media, m)
+	}
+	return nil
+}
+
+func visibilityStrings(visibilities []domain.PostVisibility) []string {
+	out := make([]string, len(visibilities))
+	for i, v := range visibilities {
+		out[i] = string(v)
+	}
+	return out
+}
+
+func insertPostMedia(ctx context.Context, tx *sqlx.Tx, post *domain.Post) error {
+	query := `
+		INSERT INTO post_media (post_id, object_key, feature, position)
+		VALUES ($1, $2, $3, $4)
+	`
+	for i := range post.Media {
+		post.Media[i].PostID = post.ID
+		post.Media[i].Position = i
+		m := post.Media[i]
+		if _, err := tx.ExecContext(ctx, query, m.PostID, m.ObjectKey, m.Feature, m.Position); err != nil {
+			return pkg.MapPostgresError(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+// reactionTables maps a target type to its reaction table and foreign key
+// column. Only these identifiers are ever interpolated into queries.
+var reactionTables = map[domain.ReactionTargetType]struct{ table, column string }{
+	domain.ReactionTargetPost:    {"post_reactions", "post_id"},
+	domain.ReactionTargetComment: {"comment_reactions", "comment_id"},
+}
+
+type reactionRepository struct {
+	db *sqlx.DB
+}
+
+func NewReactionRepository(db *sqlx.DB) *reactionRepository {
+	return &reactionRepository{db: db}
+}
+
+// Upsert runs in a transaction so that the returned previous reaction is
+// read from the locked row. Reading it from the statement snapshot would let
+// two concurrent toggles both report "no previous reaction".
+func (r *reactionRepository) Upsert(
+	ctx context.Context,
+	target domain.ReactionTarget,
+	userID int64,
+	reaction domain.ReactionType,
+) (domain.ReactionType, error) {
+	t, ok := reactionTables[target.Type]
+	if !ok {
+		return "", pkg.ErrInvalidData
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	// A concurrent insert of the same row makes this wait for it to commit,
+	// so the DO NOTHING branch always finds a committed row to lock below.
+	insert := fmt.Sprintf(`
+		INSERT INTO %[1]s (%[2]s, user_id, type)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (%[2]s, user_id) DO NOTHING
+	`, t.table, t.column)
+
+	res, err := tx.ExecContext(ctx, insert, target.ID, userID, reaction)
+	if err != nil {
+		return "", pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 1 {
+		return "", tx.Commit()
+	}
+
+	lock := fmt.Sprintf(`SELECT type FROM %s WHERE %s = $1 AND user_id = $2 FOR UPDATE`, t.table, t.column)
+
+	var prev domain.ReactionType
+	if err := tx.GetContext(ctx, &prev, lock, target.ID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Removed by a concurrent unreact between the two statements.
+			return "", pkg.ErrConflict
+		}
+		return "", pkg.MapPostgresError(err)
+	}
+	if prev == reaction {
+		return prev, nil
+	}
+
+	update := fmt.Sprintf(`UPDATE %s SET type = $3 WHERE %s = $1 AND user_id = $2`, t.table, t.column)
+	if _, err := tx.ExecContext(ctx, update, target.ID, userID, reaction); err != nil {
+		return "", pkg.MapPostgresError(err)
+	}
+
+	return prev, tx.Commit()
+}
+
+func (r *reactionRepository) Delete(ctx context.Context, target domain.ReactionTarget, userID int64) (domain.ReactionType, error) {
+	t, ok := reactionTables[target.Type]
+	if !ok {
+		return "", pkg.ErrInvalidData
+	}
+
+	query := fmt.Sprintf(`DELETE FROM %s WHERE %s = $1 AND user_id = $2 RETURNING type`, t.table, t.column)
+
+	var types []domain.ReactionType
+	if err := r.db.SelectContext(ctx, &types, query, target.ID, userID); err != nil {
+		return "", pkg.MapPostgresError(err)
+	}
+	if len(types) == 0 {
+		return "", nil
+	}
+	return types[0], nil
+}
+
+func (r *reactionRepository) GetUserReactions(
+	ctx context.Context,
+	targetType domain.ReactionTargetType,
+	ids []int64,
+	userID int64,
+) (map[int64]domain.ReactionType, error) {
+	t, ok := reactionTables[targetType]
+	if !ok {
+		return nil, pkg.ErrInvalidData
+	}
+
+	result := make(map[int64]domain.ReactionType, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	query := fmt.Sprintf(`SELECT %[2]s AS target_id, type FROM %[1]s WHERE %[2]s = ANY($1) AND user_id = $2`, t.table, t.column)
+
+	var rows []struct {
+		TargetID int64               `db:"target_id"`
+		Type     domain.ReactionType `db:"type"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, ids, userID); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+
+	for _, row := range rows {
+		result[row.TargetID] = row.Type
+	}
+	return result, nil
+}
+
+func (r *reactionRepository) CountByTargets(
+	ctx context.Context,
+	targetType domain.ReactionTargetType,
+	ids []int64,
+) (map[int64]domain.ReactionCounts, error) {
+	t, ok := reactionTables[targetType]
+	if !ok {
+		return nil, pkg.ErrInvalidData
+	}
+
+	result := make(map[int64]domain.ReactionCounts, len(ids))
+	for _, id := range ids {
+		result[id] = domain.ReactionCounts{}
+	}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %[2]s AS target_id, type, COUNT(*) AS count
+		FROM %[1]s
+		WHERE %[2]s = ANY($1)
+		GROUP BY %[2]s, type
+	`, t.table, t.column)
+
+	var rows []struct {
+		TargetID int64               `db:"target_id"`
+		Type     domain.ReactionType `db:"type"`
+		Count    int64               `db:"count"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, ids); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+
+	for _, row := range rows {
+		result[row.TargetID][row.Type] = row.Count
+	}
+	return result, nil
+}
+
+func (r *reactionRepository) ListReactors(ctx context.Context, f domain.ReactorListFilter) ([]domain.ReactorEntry, error) {
+	t, ok := reactionTables[f.Target.Type]
+	if !ok {
+		return nil, pkg.ErrInvalidData
+	}
+
+	query := fmt.Sprintf(`
+		SELECT r.id AS reaction_id, r.type, r.created_at AS reacted_at,
+			u.id, u.username, u.full_name, u.avatar
+		FROM %[1]s r
+		JOIN users u ON u.id = r.user_id
+		WHERE r.%[2]s = $1
+			AND ($2::TEXT = '' OR r.type = $2)
+			AND ($3::BIGINT = 0 OR r.id < $3)
+		ORDER BY r.id DESC
+		LIMIT $4
+	`, t.table, t.column)
+
+	var entries []domain.ReactorEntry
+	if err := r.db.SelectContext(ctx, &entries, query, f.Target.ID, f.Type, f.BeforeID, f.Limit); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return entries, nil
+}
@@ -10,6 +10,11 @@ import (
 	"air-social/pkg"
 )
 
+const refreshTokenColumns = `
+	t.id, t.user_id, t.session_id, t.token_hash, t.expires_at, t.revoked_at, t.created_at, t.device_id,
+	t.device_name, t.user_agent, t.ip_address, t.signed_in_at, t.last_used_at
+`
+
 type tokenRepository struct {
 	db *sqlx.DB
 }
@@ -20,8 +25,14 @@ func NewTokenRepository(db *sqlx.DB) *tokenRepository {
 
 func (r *tokenRepository) Create(ctx context.Context, t domain.RefreshToken) error {
 	query := `
-		INSERT INTO refresh_tokens (user_id, token_hash, expires_at, device_id)
-		VALUES (:user_id, :token_hash, :expires_at, :device_id)
+		INSERT INTO refresh_tokens (
+			user_id, session_id, token_hash, expires_at, device_id,
+			device_name, user_agent, ip_address, signed_in_at, last_used_at
+		)
+		VALUES (
+			:user_id, :session_id, :token_hash, :expires_at, :device_id,
+			:device_name, :user_agent, :ip_address, :signed_in_at, :last_used_at
+		)
 	`
 	if _, err := r.db.NamedExecContext(ctx, query, t); err != nil {
 		return pkg.MapPostgresError(err)
@@ -31,9 +42,10 @@ func (r *tokenRepository) Create(ctx context.Context, t domain.RefreshToken) err
 
 func (r *tokenRepository) GetByHash(ctx context.Context, hash string) (domain.RefreshToken, error) {
 	query := `
-		SELECT id, user_id, token_hash, expires_at, revoked_at, created_at, device_id
-		FROM refresh_tokens
-		WHERE token_hash = $1
+		SELECT ` + refreshTokenColumns + `, u.role AS user_role
+		FROM refresh_tokens t
+		JOIN users u ON u.id = t.user_id
+		WHERE t.token_hash = $1
 	`
 	var token domain.RefreshToken
 	if err := r.db.GetContext(ctx, &token, query, hash); err != nil {
@@ -66,6 +78,35 @@ func (r *tokenRepository) UpdateRevokedByDevice(ctx context.Context, userID int6
 	return nil
 }
 
+func (r *tokenRepository) UpdateRevokedBySession(ctx context.Context, userID int64, sessionID string) error {
+	query := `
+		UPDATE refresh_tokens SET revoked_at = $1
+		WHERE user_id = $2 AND session_id = $3 AND revoked_at IS NULL AND expires_at > $1
+	`
+	res, err := r.db.ExecContext(ctx, query, pkg.TimeNowUTC(), userID, sessionID)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrNotFound
+	}
+	return nil
+}
+
+func (r *tokenRepository) ListActiveByUser(ctx context.Context, userID int64) ([]domain.RefreshToken, error) {
+	query := `
+		SELECT ` + refreshTokenColumns + `
+		FROM refresh_tokens t
+		WHERE t.user_id = $1 AND t.revoked_at IS NULL AND t.expires_at > $2
+		ORDER BY t.last_used_at DESC
+	`
+	var tokens []domain.RefreshToken
+	if err := r.db.SelectContext(ctx, &tokens, query, userID, pkg.TimeNowUTC()); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return tokens, nil
+}
+
 func (r *tokenRepository) DeleteExpiredAndRevoked(ctx context.Context, expiredBefore time.Time, revokedBefore time.Time) error {
 	query := `
         DELETE FROM refresh_tokens 
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+type twoFactorRepository struct {
+	db *sqlx.DB
+}
+
+func NewTwoFactorRepository(db *sqlx.DB) *twoFactorRepository {
+	return &twoFactorRepository{db: db}
+}
+
+func (r *twoFactorRepository) Get(ctx context.Context, userID int64) (*domain.TwoFactor, error) {
+	query := `
+		SELECT user_id, secret, last_step, enabled_at, created_at
+		FROM user_two_factor
+		WHERE user_id = $1
+	`
+	var tf domain.TwoFactor
+	if err := r.db.GetContext(ctx, &tf, query, userID); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return &tf, nil
+}
+
+func (r *twoFactorRepository) SavePending(ctx context.Context, userID int64, secret []byte) error {
+	query := `
+		INSERT INTO user_two_factor (user_id, secret)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = EXCLUDED.secret, last_step = NULL, created_at = NOW()
+		WHERE user_two_factor.enabled_at IS NULL
+	`
+	res, err := r.db.ExecContext(ctx, query, userID, secret)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrConflict
+	}
+	return nil
+}
+
+func (r *twoFactorRepository) Enable(ctx context.Context, userID int64, step int64, codeHashes []string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE user_two_factor SET enabled_at = $1, last_step = $2
+		WHERE user_id = $3 AND enabled_at IS NULL
+	`
+	res, err := tx.ExecContext(ctx, query, pkg.TimeNowUTC(), step, userID)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	for _, hash := range codeHashes {
+		query := `INSERT INTO user_recovery_codes (user_id, code_hash) VALUES ($1, $2)`
+		if _, err := tx.ExecContext(ctx, query, userID, hash); err != nil {
+			return pkg.MapPostgresError(err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *twoFactorRepository) UseStep(ctx context.Context, userID int64, step int64) error {
+	query := `
+		UPDATE user_two_factor SET last_step = $1
+		WHERE user_id = $2 AND enabled_at IS NOT NULL AND (last_step IS NULL OR last_step < $1)
+	`
+	res, err := r.db.ExecContext(ctx, query, step, userID)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrConflict
+	}
+	return nil
+}
+
+func (r *twoFactorRepository) UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error {
+	query := `
+		UPDATE user_recovery_codes SET used_at = $1
+		WHERE id = (
+			SELECT id FROM user_recovery_codes
+			WHERE user_id = $2 AND code_hash = $3 AND used_at IS NULL
+			LIMIT 1
+		)
+	`
+	res, err := r.db.ExecContext(ctx, query, pkg.TimeNowUTC(), userID, codeHash)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrNotFound
+	}
+	return nil
+}
+
+func (r *twoFactorRepository) Delete(ctx context.Context, userID int64) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	res, err := tx.ExecContext(ctx, `DELETE FROM user_two_factor WHERE user_id = $1`, userID)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrNotFound
+	}
+
+	return tx.Commit()
+}
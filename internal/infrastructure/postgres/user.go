@@ -2,7 +2,11 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 
@@ -22,7 +26,7 @@ func (r *userRepository) Create(ctx context.Context, user *domain.User) error {
 	query := `
         INSERT INTO users (email, username, password_hash)
         VALUES (:email, :username, :password_hash)
-        RETURNING id, created_at, updated_at, version
+        RETURNING id, role, created_at, updated_at, version
     `
 	rows, err := r.db.NamedQueryContext(ctx, query, user)
 	if err != nil {
@@ -113,3 +117,321 @@ func (r *userRepository) UpdateProfileImages(ctx context.Context, userID int64,
 
 	return pkg.MapPostgresError(err)
 }
+
+func (r *userRepository) SetHidePresence(ctx context.Context, userID int64, hidden bool) error {
+	query := `UPDATE users SET hide_presence = $1, updated_at = NOW() WHERE id = $2`
+	res, err := r.db.ExecContext(ctx, query, hidden, userID)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrNotFound
+	}
+	return nil
+}
+
+func (r *userRepository) ListHidingPresence(ctx context.Context, ids []int64) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query := `SELECT id FROM users WHERE id = ANY($1) AND hide_presence`
+
+	var hidden []int64
+	if err := r.db.SelectContext(ctx, &hidden, query, ids); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return hidden, nil
+}
+
+func (r *userRepository) Search(ctx context.Context, f domain.UserSearchFilter) ([]domain.User, error) {
+	var pattern string
+	if f.Query != "" {
+		pattern = "%" + escapeLike(f.Query) + "%"
+	}
+
+	// An expired suspension counts as active, as in User.EffectiveStatus.
+	query := `
+		SELECT * FROM users
+		WHERE ($1::TEXT = '' OR email ILIKE $1 OR username ILIKE $1 OR full_name ILIKE $1)
+			AND ($2::TEXT = '' OR role = $2)
+			AND ($3::TEXT = '' OR $3 = CASE
+				WHEN status = 'suspended' AND suspended_until <= NOW() THEN 'active'
+				ELSE status
+			END)
+			AND ($4::BIGINT = 0 OR id < $4)
+		ORDER BY id DESC
+		LIMIT $5
+	`
+	var users []domain.User
+	if err := r.db.SelectContext(ctx, &users, query, pattern, f.Role, f.Status, f.BeforeID, f.Limit); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return users, nil
+}
+
+func (r *userRepository) UpdateStatus(ctx context.Context, userID int64, status domain.UserStatus, until *time.Time) error {
+	query := `
+		UPDATE users
+		SET status = $2, suspended_until = $3, updated_at = NOW(), version = version + 1
+		WHERE id = $1
+	`
+	res, err := r.db.ExecContext(ctx, query, userID, status, until)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrNotFound
+	}
+	return nil
+}
+
+func (r *userRepository) UpdateEmail(ctx context.Context, userID int64, oldEmail, newEmail string) error {
+	query := `
+		UPDATE users
+		SET email = $3, verified = TRUE, verified_at = NOW(), updated_at = NOW(), version = version + 1
+		WHERE id = $1 AND email = $2
+	`
+	res, err := r.db.ExecContext(ctx, query, userID, oldEmail, newEmail)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrNotFound
+	}
+	return nil
+}
+
+func (r *userRepository) ScheduleDeletion(ctx context.Context, userID int64, at *time.Time) error {
+	query := `
+		UPDATE users
+		SET delete_after = $2, updated_at = NOW(), version = version + 1
+		WHERE id = $1
+	`
+	res, err := r.db.ExecContext(ctx, query, userID, at)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return pkg.ErrNotFound
+	}
+	return nil
+}
+
+func (r *userRepository) ListDueForDeletion(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	query := `
+		SELECT id FROM users
+		WHERE delete_after <= $1
+		ORDER BY delete_after
+		LIMIT $2
+	`
+	var ids []int64
+	if err := r.db.SelectContext(ctx, &ids, query, now, limit); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return ids, nil
+}
+
+func (r *userRepository) Purge(ctx context.Context, userID int64) (*domain.PurgedUser, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Locking the row makes a login that cancels the deletion wait for us,
+	// or us see that it did.
+	var avatar, cover string
+	query := `SELECT avatar, cover_image FROM users WHERE id = $1 AND delete_after <= NOW() FOR UPDATE`
+	if err := tx.QueryRowxContext(ctx, query, userID).Scan(&avatar, &cover); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+
+	var purged domain.PurgedUser
+	for _, key := range []string{avatar, cover} {
+		if key != "" {
+			purged.ObjectKeys = append(purged.ObjectKeys, key)
+		}
+	}
+
+	query = `
+		SELECT m.object_key FROM post_media m
+		JOIN posts p ON p.id = m.post_id
+		WHERE p.author_id = $1
+		UNION ALL
+		SELECT object_key FROM messages
+		WHERE sender_id = $1 AND object_key IS NOT NULL
+	`
+	var keys []string
+	if err := tx.SelectContext(ctx, &keys, query, userID); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	purged.ObjectKeys = append(purged.ObjectKeys, keys...)
+
+	// Only the reactions on the rows of other users outlive the purge.
+	query = `
+		SELECT 'post' AS type, r.post_id AS id FROM post_reactions r
+		JOIN posts p ON p.id = r.post_id
+		WHERE r.user_id = $1 AND p.author_id <> $1
+		UNION ALL
+		SELECT 'comment', r.comment_id FROM comment_reactions r
+		JOIN comments c ON c.id = r.comment_id
+		WHERE r.user_id = $1 AND c.author_id <> $1
+	`
+	if err := tx.SelectContext(ctx, &purged.Reactions, query, userID); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+
+	// Owned groups pass on as on leaving; one nobody else is in goes, with
+	// its posts.
+	var groupIDs []int64
+	query = `SELECT group_id FROM group_members WHERE user_id = $1 AND role = $2`
+	if err := tx.SelectContext(ctx, &groupIDs, query, userID, domain.GroupRoleOwner); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	for _, id := range groupIDs {
+		err := removeGroupOwner(ctx, tx, id, userID)
+		if errors.Is(err, pkg.ErrConflict) {
+			keys, err = deleteGroup(ctx, tx, id)
+			if err != nil {
+				return nil, err
+			}
+			purged.ObjectKeys = append(purged.ObjectKeys, keys...)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var conversationIDs []int64
+	query = `
+		SELECT m.conversation_id FROM conversation_members m
+		JOIN conversations c ON c.id = m.conversation_id
+		WHERE m.user_id = $1 AND c.type = $2
+	`
+	if err := tx.SelectContext(ctx, &conversationIDs, query, userID, domain.ConversationGroup); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	for _, id := range conversationIDs {
+		// The event is blanked with the other messages of the user below.
+		event := &domain.Message{
+			ConversationID: id,
+			SenderID:       userID,
+			Type:           domain.MessageSystem,
+			Event:          domain.EventMemberLeft,
+		}
+		if err := removeConversationMember(ctx, tx, id, userID, event); err != nil {
+			return nil, err
+		}
+	}
+
+	// Messages stay in their conversations, blanked, so the other members see
+	// no gap in the sequence; ON DELETE SET NULL drops their sender.
+	query = `UPDATE messages SET content = '', object_key = NULL WHERE sender_id = $1`
+	if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+
+	// Every other row of the user goes with it through ON DELETE CASCADE, but
+	// the counters that other users' rows keep of them have to be fixed first.
+	counters := []string{
+		`UPDATE users SET followers_count = GREATEST(followers_count - 1, 0)
+		WHERE id IN (SELECT followee_id FROM follows WHERE follower_id = $1)`,
+		`UPDATE users SET following_count = GREATEST(following_count - 1, 0)
+		WHERE id IN (SELECT follower_id FROM follows WHERE followee_id = $1)`,
+		// The comments of the user take their replies with them.
+		`WITH gone AS (
+			SELECT DISTINCT d.id, d.post_id FROM comments c
+			JOIN comments d ON d.path = c.path OR d.path LIKE c.path || '/%'
+			WHERE c.author_id = $1
+		)
+		UPDATE posts p SET comment_count = GREATEST(p.comment_count - g.n, 0)
+		FROM (SELECT post_id, COUNT(*) AS n FROM gone GROUP BY post_id) g
+		WHERE p.id = g.post_id AND p.author_id <> $1`,
+		`UPDATE comments p SET reply_count = GREATEST(p.reply_count - g.n, 0)
+		FROM (
+			SELECT parent_id, COUNT(*) AS n FROM comments
+			WHERE author_id = $1 AND parent_id IS NOT NULL
+			GROUP BY parent_id
+		) g
+		WHERE p.id = g.parent_id AND p.author_id <> $1`,
+	}
+	for _, q := range counters {
+		if _, err := tx.ExecContext(ctx, q, userID); err != nil {
+			return nil, pkg.MapPostgresError(err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM users WHERE id = $1`, userID); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	// Media of the user's posts in a deleted group is listed twice.
+	slices.Sort(purged.ObjectKeys)
+	purged.ObjectKeys = slices.Compact(purged.ObjectKeys)
+	return &purged, nil
+}
+
+func (r *userRepository) GetByIdentity(ctx context.Context, provider, subject string) (*domain.User, error) {
+	query := `
+		SELECT u.* FROM users u
+		JOIN user_identities i ON i.user_id = u.id
+		WHERE i.provider = $1 AND i.subject = $2
+	`
+	var user domain.User
+	if err := r.db.GetContext(ctx, &user, query, provider, subject); err != nil {
+		return nil, pkg.MapPostgresError(err)
+	}
+	return &user, nil
+}
+
+func (r *userRepository) CreateIdentity(ctx context.Context, identity *domain.UserIdentity) error {
+	return createIdentity(ctx, r.db, identity)
+}
+
+func (r *userRepository) CreateWithIdentity(ctx context.Context, user *domain.User, identity *domain.UserIdentity) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO users (email, username, password_hash, verified, verified_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, role, created_at, updated_at, version
+	`
+	err = tx.QueryRowxContext(ctx, query, user.Email, user.Username, user.PasswordHash, user.Verified, user.VerifiedAt).
+		Scan(&user.ID, &user.Role, &user.CreatedAt, &user.UpdatedAt, &user.Version)
+	if err != nil {
+		return pkg.MapPostgresError(err)
+	}
+
+	identity.UserID = user.ID
+	if err := createIdentity(ctx, tx, identity); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func createIdentity(ctx context.Context, q sqlx.QueryerContext, identity *domain.UserIdentity) error {
+	query := `
+		INSERT INTO user_identities (user_id, provider, subject, email)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+	err := q.QueryRowxContext(ctx, query, identity.UserID, identity.Provider, identity.Subject, identity.Email).
+		Scan(&identity.ID, &identity.CreatedAt)
+	return pkg.MapPostgresError(err)
+}
+
+// escapeLike makes s match literally inside a LIKE pattern.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -33,3 +33,48 @@ var EmailResetPasswordQueueConfig = QueueConfig{
 	DeadLetterQueue:      "email_reset_password_queue.dlq",
 	DeadLetterRoutingKey: "email.reset_password.dlq",
 }
+
+var EmailAccountLockedQueueConfig = QueueConfig{
+	Queue:                "email_account_locked_queue",
+	RoutingKey:           "email.account_locked",
+	DeadLetterExchange:   EventsExchange.Name,
+	DeadLetterQueue:      "email_account_locked_queue.dlq",
+	DeadLetterRoutingKey: "email.account_locked.dlq",
+}
+
+var EmailMagicLinkQueueConfig = QueueConfig{
+	Queue:                "email_magic_link_queue",
+	RoutingKey:           "email.magic_link",
+	DeadLetterExchange:   EventsExchange.Name,
+	DeadLetterQueue:      "email_magic_link_queue.dlq",
+	DeadLetterRoutingKey: "email.magic_link.dlq",
+}
+
+// Routing keys of the email change events consumed by
+// EmailChangeQueueConfig.
+const (
+	EmailChangeConfirmRoutingKey = "email.change.confirm"
+	EmailChangeNoticeRoutingKey  = "email.change.notice"
+)
+
+var EmailChangeQueueConfig = QueueConfig{
+	Queue:                "email_change_queue",
+	RoutingKey:           "email.change.*",
+	DeadLetterExchange:   EventsExchange.Name,
+	DeadLetterQueue:      "email_change_queue.dlq",
+	DeadLetterRoutingKey: "email.change.dlq",
+}
+
+// Routing keys of the post events consumed by FeedFanoutQueueConfig.
+const (
+	PostCreatedRoutingKey           = "post.created"
+	PostVisibilityChangedRoutingKey = "post.visibility_changed"
+)
+
+var FeedFanoutQueueConfig = QueueConfig{
+	Queue:                "feed_fanout_queue",
+	RoutingKey:           "post.*",
+	DeadLetterExchange:   EventsExchange.Name,
+	DeadLetterQueue:      "feed_fanout_queue.dlq",
+	DeadLetterRoutingKey: "post.created.dlq",
+}
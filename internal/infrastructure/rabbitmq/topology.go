@@ -1,12 +1,33 @@
-package email
+package rabbitmq
 
 import (
 	amqp "github.com/rabbitmq/amqp091-go"
-
-	"air-social/internal/infrastructure/rabbitmq"
 )
 
-func setupExchange(ch *amqp.Channel, cfg rabbitmq.ExchangeConfig) error {
+// Consume declares the exchange, the queue (with its dead letter queue) and
+// the binding described by the configs, then starts consuming with manual ack.
+func Consume(ch *amqp.Channel, eCfg ExchangeConfig, qCfg QueueConfig) (<-chan amqp.Delivery, error) {
+	if err := setupExchange(ch, eCfg); err != nil {
+		return nil, err
+	}
+
+	queueName, err := setupQueue(ch, qCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bindQueue(ch, queueName, eCfg, qCfg); err != nil {
+		return nil, err
+	}
+
+	if err := setupQos(ch); err != nil {
+		return nil, err
+	}
+
+	return startConsume(ch, queueName)
+}
+
+func setupExchange(ch *amqp.Channel, cfg ExchangeConfig) error {
 	return ch.ExchangeDeclare(
 		cfg.Name,
 		cfg.Type,
@@ -18,7 +39,7 @@ func setupExchange(ch *amqp.Channel, cfg rabbitmq.ExchangeConfig) error {
 	)
 }
 
-func setupQueue(ch *amqp.Channel, cfg rabbitmq.QueueConfig) (string, error) {
+func setupQueue(ch *amqp.Channel, cfg QueueConfig) (string, error) {
 	args := amqp.Table{}
 	if cfg.DeadLetterExchange != "" && cfg.DeadLetterRoutingKey != "" {
 		args["x-dead-letter-exchange"] = cfg.DeadLetterExchange
@@ -46,7 +67,7 @@ func setupQueue(ch *amqp.Channel, cfg rabbitmq.QueueConfig) (string, error) {
 	return q.Name, nil
 }
 
-func declareAndBindDLQ(ch *amqp.Channel, cfg rabbitmq.QueueConfig) error {
+func declareAndBindDLQ(ch *amqp.Channel, cfg QueueConfig) error {
 	if _, err := ch.QueueDeclare(
 		cfg.DeadLetterQueue,
 		true, // durable
@@ -70,8 +91,8 @@ func declareAndBindDLQ(ch *amqp.Channel, cfg rabbitmq.QueueConfig) error {
 func bindQueue(
 	ch *amqp.Channel,
 	queue string,
-	eCfg rabbitmq.ExchangeConfig,
-	qCfg rabbitmq.QueueConfig,
+	eCfg ExchangeConfig,
+	qCfg QueueConfig,
 ) error {
 	return ch.QueueBind(
 		queue,
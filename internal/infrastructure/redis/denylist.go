@@ -0,0 +1,79 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"air-social/internal/domain"
+)
+
+type tokenDenylist struct {
+	client *redis.Client
+}
+
+func newTokenDenylist(client *redis.Client) *tokenDenylist {
+	return &tokenDenylist{client: client}
+}
+
+func (d *tokenDenylist) Deny(ctx context.Context, jti string, ttl time.Duration) error {
+	// The token has expired already, nothing left to revoke.
+	if jti == "" || ttl <= 0 {
+		return nil
+	}
+	return d.client.Set(ctx, domain.GetDeniedTokenKey(jti), 1, ttl).Err()
+}
+
+// RevokeBefore stores the mark in milliseconds, the precision of the iat
+// claim it is compared with.
+func (d *tokenDenylist) RevokeBefore(ctx context.Context, userID int64, t time.Time, ttl time.Duration) error {
+	return d.client.Set(ctx, domain.GetTokensRevokedBeforeKey(userID), t.UnixMilli(), ttl).Err()
+}
+
+// DenySession keeps the session denied for ttl, past the expiry of any access
+// token issued for it.
+func (d *tokenDenylist) DenySession(ctx context.Context, sessionID string, ttl time.Duration) error {
+	if sessionID == "" || ttl <= 0 {
+		return nil
+	}
+	return d.client.Set(ctx, domain.GetDeniedSessionKey(sessionID), 1, ttl).Err()
+}
+
+func (d *tokenDenylist) IsRevoked(ctx context.Context, claims *domain.AuthClaims) (bool, error) {
+	var denied, sessionDenied *redis.IntCmd
+	mark := new(redis.StringCmd)
+
+	_, err := d.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		if claims.TokenID != "" {
+			denied = pipe.Exists(ctx, domain.GetDeniedTokenKey(claims.TokenID))
+		}
+		if claims.SessionID != "" {
+			sessionDenied = pipe.Exists(ctx, domain.GetDeniedSessionKey(claims.SessionID))
+		}
+		mark = pipe.Get(ctx, domain.GetTokensRevokedBeforeKey(claims.UserID))
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return false, err
+	}
+
+	if denied != nil && denied.Val() > 0 {
+		return true, nil
+	}
+	if sessionDenied != nil && sessionDenied.Val() > 0 {
+		return true, nil
+	}
+
+	before, err := mark.Int64()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	// A token issued in the same millisecond as the mark may have been minted
+	// concurrently with the revocation, so it goes too.
+	return claims.IssuedAt.UnixMilli() <= before, nil
+}
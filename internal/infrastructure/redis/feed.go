@@ -0,0 +1,66 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+
+	"air-social/internal/domain"
+)
+
+type feedStore struct {
+	client    *redis.Client
+	maxLength int64
+}
+
+func newFeedStore(client *redis.Client, maxLength int) *feedStore {
+	return &feedStore{client: client, maxLength: int64(maxLength)}
+}
+
+// Push adds postID to the timeline of every user in a single pipeline and
+// trims each timeline to its newest maxLength entries.
+func (f *feedStore) Push(ctx context.Context, userIDs []int64, postID int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	member := redis.Z{Score: float64(postID), Member: postID}
+	_, err := f.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range userIDs {
+			key := domain.GetHomeTimelineKey(id)
+			pipe.ZAdd(ctx, key, member)
+			pipe.ZRemRangeByRank(ctx, key, 0, -f.maxLength-1)
+		}
+		return nil
+	})
+	return err
+}
+
+// Range returns up to limit post IDs older than beforeID, newest first.
+// A zero beforeID starts from the newest entry.
+func (f *feedStore) Range(ctx context.Context, userID, beforeID int64, limit int) ([]int64, error) {
+	max := "+inf"
+	if beforeID > 0 {
+		max = "(" + strconv.FormatInt(beforeID, 10)
+	}
+
+	members, err := f.client.ZRevRangeByScore(ctx, domain.GetHomeTimelineKey(userID), &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   max,
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(members))
+	for _, m := range members {
+		id, err := strconv.ParseInt(m, 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,65 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"air-social/internal/domain"
+)
+
+// rateLimitScript is a token bucket in GCRA form: the key holds the
+// theoretical arrival time of the next request, which every allowed request
+// pushes forward by one interval. A request is allowed while that time is at
+// most burst intervals ahead. The clock of Redis is used so that instances
+// with skewed clocks share the same view of the bucket.
+//
+// KEYS[1] bucket
+// ARGV[1] interval (ms), ARGV[2] burst
+// Returns {allowed, remaining, retry after (ms), reset after (ms)}.
+var rateLimitScript = redis.NewScript(`
+local interval = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local tat = tonumber(redis.call('GET', KEYS[1]) or now)
+if tat < now then
+	tat = now
+end
+
+local next_tat = tat + interval
+local allow_at = next_tat - interval * burst
+if now < allow_at then
+	return {0, 0, allow_at - now, tat - now}
+end
+
+redis.call('SET', KEYS[1], next_tat, 'PX', next_tat - now)
+return {1, math.floor((now - allow_at) / interval), 0, next_tat - now}
+`)
+
+type rateLimiter struct {
+	client *redis.Client
+}
+
+func newRateLimiter(client *redis.Client) *rateLimiter {
+	return &rateLimiter{client: client}
+}
+
+func (l *rateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (domain.RateLimitResult, error) {
+	interval := max(window.Milliseconds()/int64(limit), 1)
+
+	res, err := rateLimitScript.Run(ctx, l.client, []string{key}, interval, limit).Int64Slice()
+	if err != nil {
+		return domain.RateLimitResult{}, err
+	}
+
+	return domain.RateLimitResult{
+		Allowed:    res[0] == 1,
+		Limit:      limit,
+		Remaining:  int(res[1]),
+		RetryAfter: time.Duration(res[2]) * time.Millisecond,
+		ResetAfter: time.Duration(res[3]) * time.Millisecond,
+	}, nil
+}
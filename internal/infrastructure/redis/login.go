@@ -0,0 +1,123 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"air-social/internal/domain"
+)
+
+type loginAttemptStore struct {
+	client *redis.Client
+}
+
+func newLoginAttemptStore(client *redis.Client) *loginAttemptStore {
+	return &loginAttemptStore{client: client}
+}
+
+func (l *loginAttemptStore) Throttle(ctx context.Context, email, ip string) (domain.LoginThrottle, error) {
+	var (
+		out    domain.LoginThrottle
+		locked *redis.DurationCmd
+		delays []*redis.DurationCmd
+	)
+	_, err := l.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		if email != "" {
+			locked = pipe.PTTL(ctx, domain.GetLoginLockKey(email))
+		}
+		for _, subject := range loginSubjects(email, ip) {
+			delays = append(delays, pipe.PTTL(ctx, domain.GetLoginDelayKey(subject)))
+		}
+		return nil
+	})
+	if err != nil {
+		return out, err
+	}
+
+	// PTTL answers negative values for missing keys, which count as zero.
+	if locked != nil {
+		out.Locked = max(locked.Val(), 0)
+	}
+	for _, d := range delays {
+		out.Delayed = max(out.Delayed, d.Val())
+	}
+	return out, nil
+}
+
+func (l *loginAttemptStore) Fail(ctx context.Context, email, ip string, window time.Duration) (domain.LoginAttempts, error) {
+	var (
+		out       domain.LoginAttempts
+		emailIncr *redis.IntCmd
+		ipIncr    *redis.IntCmd
+	)
+	_, err := l.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		if email != "" {
+			key := domain.GetLoginFailuresKey(domain.LoginEmailSubject(email))
+			emailIncr = pipe.Incr(ctx, key)
+			pipe.PExpire(ctx, key, window)
+		}
+		if ip != "" {
+			key := domain.GetLoginFailuresKey(domain.LoginIPSubject(ip))
+			ipIncr = pipe.Incr(ctx, key)
+			pipe.PExpire(ctx, key, window)
+		}
+		return nil
+	})
+	if err != nil {
+		return out, err
+	}
+
+	if emailIncr != nil {
+		out.EmailFailures = int(emailIncr.Val())
+	}
+	if ipIncr != nil {
+		out.IPFailures = int(ipIncr.Val())
+	}
+	return out, nil
+}
+
+func (l *loginAttemptStore) Delay(ctx context.Context, email, ip string, d time.Duration) error {
+	_, err := l.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, subject := range loginSubjects(email, ip) {
+			pipe.Set(ctx, domain.GetLoginDelayKey(subject), 1, d)
+		}
+		return nil
+	})
+	return err
+}
+
+func (l *loginAttemptStore) Lock(ctx context.Context, email string, d time.Duration) error {
+	_, err := l.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, domain.GetLoginLockKey(email), 1, d)
+		pipe.Del(ctx, domain.GetLoginFailuresKey(domain.LoginEmailSubject(email)))
+		return nil
+	})
+	return err
+}
+
+func (l *loginAttemptStore) Reset(ctx context.Context, email, ip string) error {
+	var keys []string
+	if email != "" {
+		keys = append(keys, domain.GetLoginLockKey(email))
+	}
+	for _, subject := range loginSubjects(email, ip) {
+		keys = append(keys, domain.GetLoginFailuresKey(subject), domain.GetLoginDelayKey(subject))
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return l.client.Del(ctx, keys...).Err()
+}
+
+func loginSubjects(email, ip string) []string {
+	subjects := make([]string, 0, 2)
+	if email != "" {
+		subjects = append(subjects, domain.LoginEmailSubject(email))
+	}
+	if ip != "" {
+		subjects = append(subjects, domain.LoginIPSubject(ip))
+	}
+	return subjects
+}
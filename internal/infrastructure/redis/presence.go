@@ -0,0 +1,119 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"air-social/internal/domain"
+)
+
+// connectScript drops expired connections, adds the new one and returns how
+// many live connections the user had before, so only the first connection
+// across all instances reports the user as coming online.
+//
+// KEYS[1] connection set
+// ARGV[1] now (ms), ARGV[2] expiry (ms), ARGV[3] connection ID, ARGV[4] ttl in seconds
+var connectScript = redis.NewScript(`
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', ARGV[1])
+local n = redis.call('ZCARD', KEYS[1])
+redis.call('ZADD', KEYS[1], ARGV[2], ARGV[3])
+redis.call('EXPIRE', KEYS[1], ARGV[4])
+return n
+`)
+
+// disconnectScript removes a connection, records the last-seen time and
+// returns how many live connections remain.
+//
+// KEYS[1] connection set, KEYS[2] last-seen key
+// ARGV[1] now (ms), ARGV[2] connection ID, ARGV[3] now (s)
+var disconnectScript = redis.NewScript(`
+redis.call('ZREM', KEYS[1], ARGV[2])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', ARGV[1])
+redis.call('SET', KEYS[2], ARGV[3])
+return redis.call('ZCARD', KEYS[1])
+`)
+
+type presenceStore struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func newPresenceStore(client *redis.Client, ttl time.Duration) *presenceStore {
+	return &presenceStore{client: client, ttl: ttl}
+}
+
+func (p *presenceStore) Connect(ctx context.Context, userID int64, connID string) (bool, error) {
+	now := time.Now()
+	n, err := connectScript.Run(ctx, p.client,
+		[]string{domain.GetPresenceConnsKey(userID)},
+		now.UnixMilli(), now.Add(p.ttl).UnixMilli(), connID, int64(p.ttl.Seconds()),
+	).Int64()
+	if err != nil {
+		return false, err
+	}
+	return n == 0, nil
+}
+
+func (p *presenceStore) Heartbeat(ctx context.Context, userID int64, connID string) error {
+	now := time.Now()
+	key := domain.GetPresenceConnsKey(userID)
+	_, err := p.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(now.Add(p.ttl).UnixMilli()), Member: connID})
+		pipe.Expire(ctx, key, p.ttl)
+		pipe.Set(ctx, domain.GetPresenceLastSeenKey(userID), now.Unix(), 0)
+		return nil
+	})
+	return err
+}
+
+func (p *presenceStore) Disconnect(ctx context.Context, userID int64, connID string) (bool, error) {
+	now := time.Now()
+	n, err := disconnectScript.Run(ctx, p.client,
+		[]string{domain.GetPresenceConnsKey(userID), domain.GetPresenceLastSeenKey(userID)},
+		now.UnixMilli(), connID, now.Unix(),
+	).Int64()
+	if err != nil {
+		return false, err
+	}
+	return n == 0, nil
+}
+
+// Get counts the unexpired connections and reads the last-seen time of every
+// user in a single pipeline.
+func (p *presenceStore) Get(ctx context.Context, userIDs []int64) ([]domain.Presence, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	counts := make([]*redis.IntCmd, len(userIDs))
+	seen := make([]*redis.StringCmd, len(userIDs))
+	_, err := p.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, id := range userIDs {
+			counts[i] = pipe.ZCount(ctx, domain.GetPresenceConnsKey(id), "("+now, "+inf")
+			seen[i] = pipe.Get(ctx, domain.GetPresenceLastSeenKey(id))
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	out := make([]domain.Presence, len(userIDs))
+	for i, id := range userIDs {
+		out[i].UserID = id
+		if counts[i].Val() > 0 {
+			out[i].Online = true
+			continue
+		}
+		if sec, err := seen[i].Int64(); err == nil {
+			t := time.Unix(sec, 0).UTC()
+			out[i].LastSeen = &t
+		}
+	}
+	return out, nil
+}
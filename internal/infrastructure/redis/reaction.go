@@ -0,0 +1,137 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"air-social/internal/domain"
+)
+
+// cachedField marks a counter hash as loaded, so that a target without any
+// reaction can be told apart from one that is not cached.
+const cachedField = "_"
+
+// incrScript applies the deltas only when the counter is cached; a missing
+// counter is loaded from Postgres on the next read instead of being created
+// from a partial delta. The target is marked dirty either way.
+//
+// KEYS[1] counter hash, KEYS[2] dirty set
+// ARGV[1] dirty member, ARGV[2] ttl in seconds, ARGV[3..] field/delta pairs
+var incrScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	for i = 3, #ARGV, 2 do
+		redis.call('HINCRBY', KEYS[1], ARGV[i], ARGV[i + 1])
+	end
+	redis.call('EXPIRE', KEYS[1], ARGV[2])
+end
+redis.call('SADD', KEYS[2], ARGV[1])
+return 1
+`)
+
+type reactionCounter struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+func newReactionCounter(client *redis.Client, ttl time.Duration) *reactionCounter {
+	return &reactionCounter{client: client, ttl: ttl}
+}
+
+func (r *reactionCounter) Incr(ctx context.Context, target domain.ReactionTarget, deltas domain.ReactionCounts) error {
+	args := []any{target.String(), int64(r.ttl.Seconds())}
+	for t, d := range deltas {
+		if d != 0 {
+			args = append(args, string(t), d)
+		}
+	}
+
+	keys := []string{domain.GetReactionCountKey(target), domain.ReactionDirtySet}
+	return incrScript.Run(ctx, r.client, keys, args...).Err()
+}
+
+func (r *reactionCounter) Get(ctx context.Context, targets []domain.ReactionTarget) (map[domain.ReactionTarget]domain.ReactionCounts, error) {
+	result := make(map[domain.ReactionTarget]domain.ReactionCounts, len(targets))
+	if len(targets) == 0 {
+		return result, nil
+	}
+
+	cmds := make([]*redis.MapStringStringCmd, len(targets))
+	if _, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, t := range targets {
+			cmds[i] = pipe.HGetAll(ctx, domain.GetReactionCountKey(t))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for i, cmd := range cmds {
+		fields := cmd.Val()
+		if _, ok := fields[cachedField]; !ok {
+			continue
+		}
+
+		counts := make(domain.ReactionCounts, len(fields))
+		for f, v := range fields {
+			if f == cachedField {
+				continue
+			}
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				continue
+			}
+			counts[domain.ReactionType(f)] = n
+		}
+		result[targets[i]] = counts
+	}
+	return result, nil
+}
+
+func (r *reactionCounter) Set(ctx context.Context, target domain.ReactionTarget, counts domain.ReactionCounts) error {
+	key := domain.GetReactionCountKey(target)
+
+	values := []any{cachedField, 1}
+	for t, n := range counts {
+		values = append(values, string(t), n)
+	}
+
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, values...)
+		pipe.Expire(ctx, key, r.ttl)
+		return nil
+	})
+	return err
+}
+
+func (r *reactionCounter) PopDirty(ctx context.Context, count int) ([]domain.ReactionTarget, error) {
+	members, err := r.client.SPopN(ctx, domain.ReactionDirtySet, int64(count)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]domain.ReactionTarget, 0, len(members))
+	for _, m := range members {
+		t, err := domain.ParseReactionTarget(m)
+		if err != nil {
+			continue
+		}
+		targets = append(targets, t)
+	}
+	return targets, nil
+}
+
+func (r *reactionCounter) MarkDirty(ctx context.Context, targets []domain.ReactionTarget) error {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	members := make([]any, len(targets))
+	for i, t := range targets {
+		members[i] = t.String()
+	}
+	return r.client.SAdd(ctx, domain.ReactionDirtySet, members...).Err()
+}
@@ -37,4 +37,46 @@ func NewRedisCache(client *redis.Client) (*redisCache, error) {
 		return nil, errors.New("redis client cannot nil")
 	}
 	return newRedisCache(client), nil
-}
\ No newline at end of file
+}
+
+func NewFeedStore(client *redis.Client, maxLength int) (*feedStore, error) {
+	if client == nil {
+		return nil, errors.New("redis client cannot nil")
+	}
+	return newFeedStore(client, maxLength), nil
+}
+
+func NewReactionCounter(client *redis.Client, ttl time.Duration) (*reactionCounter, error) {
+	if client == nil {
+		return nil, errors.New("redis client cannot nil")
+	}
+	return newReactionCounter(client, ttl), nil
+}
+
+func NewPresenceStore(client *redis.Client, ttl time.Duration) (*presenceStore, error) {
+	if client == nil {
+		return nil, errors.New("redis client cannot nil")
+	}
+	return newPresenceStore(client, ttl), nil
+}
+
+func NewRateLimiter(client *redis.Client) (*rateLimiter, error) {
+	if client == nil {
+		return nil, errors.New("redis client cannot nil")
+	}
+	return newRateLimiter(client), nil
+}
+
+func NewTokenDenylist(client *redis.Client) (*tokenDenylist, error) {
+	if client == nil {
+		return nil, errors.New("redis client cannot nil")
+	}
+	return newTokenDenylist(client), nil
+}
+
+func NewLoginAttemptStore(client *redis.Client) (*loginAttemptStore, error) {
+	if client == nil {
+		return nil, errors.New("redis client cannot nil")
+	}
+	return newLoginAttemptStore(client), nil
+}
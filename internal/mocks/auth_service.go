@@ -38,6 +38,120 @@ func (_m *AuthService) EXPECT() *AuthService_Expecter {
 	return &AuthService_Expecter{mock: &_m.Mock}
 }
 
+// CancelEmailChange provides a mock function for the type AuthService
+func (_mock *AuthService) CancelEmailChange(ctx context.Context, token string) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelEmailChange")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthService_CancelEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelEmailChange'
+type AuthService_CancelEmailChange_Call struct {
+	*mock.Call
+}
+
+// CancelEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *AuthService_Expecter) CancelEmailChange(ctx interface{}, token interface{}) *AuthService_CancelEmailChange_Call {
+	return &AuthService_CancelEmailChange_Call{Call: _e.mock.On("CancelEmailChange", ctx, token)}
+}
+
+func (_c *AuthService_CancelEmailChange_Call) Run(run func(ctx context.Context, token string)) *AuthService_CancelEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_CancelEmailChange_Call) Return(err error) *AuthService_CancelEmailChange_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthService_CancelEmailChange_Call) RunAndReturn(run func(ctx context.Context, token string) error) *AuthService_CancelEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmEmailChange provides a mock function for the type AuthService
+func (_mock *AuthService) ConfirmEmailChange(ctx context.Context, token string) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmEmailChange")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthService_ConfirmEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmEmailChange'
+type AuthService_ConfirmEmailChange_Call struct {
+	*mock.Call
+}
+
+// ConfirmEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *AuthService_Expecter) ConfirmEmailChange(ctx interface{}, token interface{}) *AuthService_ConfirmEmailChange_Call {
+	return &AuthService_ConfirmEmailChange_Call{Call: _e.mock.On("ConfirmEmailChange", ctx, token)}
+}
+
+func (_c *AuthService_ConfirmEmailChange_Call) Run(run func(ctx context.Context, token string)) *AuthService_ConfirmEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_ConfirmEmailChange_Call) Return(err error) *AuthService_ConfirmEmailChange_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthService_ConfirmEmailChange_Call) RunAndReturn(run func(ctx context.Context, token string) error) *AuthService_ConfirmEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ForgotPassword provides a mock function for the type AuthService
 func (_mock *AuthService) ForgotPassword(ctx context.Context, email string) error {
 	ret := _mock.Called(ctx, email)
@@ -275,9 +389,141 @@ func (_c *AuthService_Logout_Call) RunAndReturn(run func(ctx context.Context, in
 	return _c
 }
 
+// MagicLinkLogin provides a mock function for the type AuthService
+func (_mock *AuthService) MagicLinkLogin(ctx context.Context, input domain.MagicLinkLoginParams) (domain.LoginResponse, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MagicLinkLogin")
+	}
+
+	var r0 domain.LoginResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.MagicLinkLoginParams) (domain.LoginResponse, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.MagicLinkLoginParams) domain.LoginResponse); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(domain.LoginResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.MagicLinkLoginParams) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthService_MagicLinkLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MagicLinkLogin'
+type AuthService_MagicLinkLogin_Call struct {
+	*mock.Call
+}
+
+// MagicLinkLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.MagicLinkLoginParams
+func (_e *AuthService_Expecter) MagicLinkLogin(ctx interface{}, input interface{}) *AuthService_MagicLinkLogin_Call {
+	return &AuthService_MagicLinkLogin_Call{Call: _e.mock.On("MagicLinkLogin", ctx, input)}
+}
+
+func (_c *AuthService_MagicLinkLogin_Call) Run(run func(ctx context.Context, input domain.MagicLinkLoginParams)) *AuthService_MagicLinkLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.MagicLinkLoginParams
+		if args[1] != nil {
+			arg1 = args[1].(domain.MagicLinkLoginParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_MagicLinkLogin_Call) Return(loginResponse domain.LoginResponse, err error) *AuthService_MagicLinkLogin_Call {
+	_c.Call.Return(loginResponse, err)
+	return _c
+}
+
+func (_c *AuthService_MagicLinkLogin_Call) RunAndReturn(run func(ctx context.Context, input domain.MagicLinkLoginParams) (domain.LoginResponse, error)) *AuthService_MagicLinkLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OAuthLogin provides a mock function for the type AuthService
+func (_mock *AuthService) OAuthLogin(ctx context.Context, input domain.OAuthLoginParams) (domain.LoginResponse, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OAuthLogin")
+	}
+
+	var r0 domain.LoginResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.OAuthLoginParams) (domain.LoginResponse, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.OAuthLoginParams) domain.LoginResponse); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(domain.LoginResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.OAuthLoginParams) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthService_OAuthLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OAuthLogin'
+type AuthService_OAuthLogin_Call struct {
+	*mock.Call
+}
+
+// OAuthLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.OAuthLoginParams
+func (_e *AuthService_Expecter) OAuthLogin(ctx interface{}, input interface{}) *AuthService_OAuthLogin_Call {
+	return &AuthService_OAuthLogin_Call{Call: _e.mock.On("OAuthLogin", ctx, input)}
+}
+
+func (_c *AuthService_OAuthLogin_Call) Run(run func(ctx context.Context, input domain.OAuthLoginParams)) *AuthService_OAuthLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.OAuthLoginParams
+		if args[1] != nil {
+			arg1 = args[1].(domain.OAuthLoginParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_OAuthLogin_Call) Return(loginResponse domain.LoginResponse, err error) *AuthService_OAuthLogin_Call {
+	_c.Call.Return(loginResponse, err)
+	return _c
+}
+
+func (_c *AuthService_OAuthLogin_Call) RunAndReturn(run func(ctx context.Context, input domain.OAuthLoginParams) (domain.LoginResponse, error)) *AuthService_OAuthLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshToken provides a mock function for the type AuthService
-func (_mock *AuthService) RefreshToken(ctx context.Context, refreshToken string) (domain.TokenInfo, error) {
-	ret := _mock.Called(ctx, refreshToken)
+func (_mock *AuthService) RefreshToken(ctx context.Context, input domain.RefreshParams) (domain.TokenInfo, error) {
+	ret := _mock.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RefreshToken")
@@ -285,16 +531,16 @@ func (_mock *AuthService) RefreshToken(ctx context.Context, refreshToken string)
 
 	var r0 domain.TokenInfo
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.TokenInfo, error)); ok {
-		return returnFunc(ctx, refreshToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.RefreshParams) (domain.TokenInfo, error)); ok {
+		return returnFunc(ctx, input)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.TokenInfo); ok {
-		r0 = returnFunc(ctx, refreshToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.RefreshParams) domain.TokenInfo); ok {
+		r0 = returnFunc(ctx, input)
 	} else {
 		r0 = ret.Get(0).(domain.TokenInfo)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, refreshToken)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.RefreshParams) error); ok {
+		r1 = returnFunc(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -308,20 +554,20 @@ type AuthService_RefreshToken_Call struct {
 
 // RefreshToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - refreshToken string
-func (_e *AuthService_Expecter) RefreshToken(ctx interface{}, refreshToken interface{}) *AuthService_RefreshToken_Call {
-	return &AuthService_RefreshToken_Call{Call: _e.mock.On("RefreshToken", ctx, refreshToken)}
+//   - input domain.RefreshParams
+func (_e *AuthService_Expecter) RefreshToken(ctx interface{}, input interface{}) *AuthService_RefreshToken_Call {
+	return &AuthService_RefreshToken_Call{Call: _e.mock.On("RefreshToken", ctx, input)}
 }
 
-func (_c *AuthService_RefreshToken_Call) Run(run func(ctx context.Context, refreshToken string)) *AuthService_RefreshToken_Call {
+func (_c *AuthService_RefreshToken_Call) Run(run func(ctx context.Context, input domain.RefreshParams)) *AuthService_RefreshToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.RefreshParams
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(domain.RefreshParams)
 		}
 		run(
 			arg0,
@@ -336,7 +582,7 @@ func (_c *AuthService_RefreshToken_Call) Return(tokenInfo domain.TokenInfo, err
 	return _c
 }
 
-func (_c *AuthService_RefreshToken_Call) RunAndReturn(run func(ctx context.Context, refreshToken string) (domain.TokenInfo, error)) *AuthService_RefreshToken_Call {
+func (_c *AuthService_RefreshToken_Call) RunAndReturn(run func(ctx context.Context, input domain.RefreshParams) (domain.TokenInfo, error)) *AuthService_RefreshToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -407,6 +653,177 @@ func (_c *AuthService_Register_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// RequestEmailChange provides a mock function for the type AuthService
+func (_mock *AuthService) RequestEmailChange(ctx context.Context, input domain.ChangeEmailParams) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestEmailChange")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ChangeEmailParams) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthService_RequestEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestEmailChange'
+type AuthService_RequestEmailChange_Call struct {
+	*mock.Call
+}
+
+// RequestEmailChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.ChangeEmailParams
+func (_e *AuthService_Expecter) RequestEmailChange(ctx interface{}, input interface{}) *AuthService_RequestEmailChange_Call {
+	return &AuthService_RequestEmailChange_Call{Call: _e.mock.On("RequestEmailChange", ctx, input)}
+}
+
+func (_c *AuthService_RequestEmailChange_Call) Run(run func(ctx context.Context, input domain.ChangeEmailParams)) *AuthService_RequestEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ChangeEmailParams
+		if args[1] != nil {
+			arg1 = args[1].(domain.ChangeEmailParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_RequestEmailChange_Call) Return(err error) *AuthService_RequestEmailChange_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthService_RequestEmailChange_Call) RunAndReturn(run func(ctx context.Context, input domain.ChangeEmailParams) error) *AuthService_RequestEmailChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestMagicLink provides a mock function for the type AuthService
+func (_mock *AuthService) RequestMagicLink(ctx context.Context, input domain.MagicLinkParams) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestMagicLink")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.MagicLinkParams) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthService_RequestMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestMagicLink'
+type AuthService_RequestMagicLink_Call struct {
+	*mock.Call
+}
+
+// RequestMagicLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.MagicLinkParams
+func (_e *AuthService_Expecter) RequestMagicLink(ctx interface{}, input interface{}) *AuthService_RequestMagicLink_Call {
+	return &AuthService_RequestMagicLink_Call{Call: _e.mock.On("RequestMagicLink", ctx, input)}
+}
+
+func (_c *AuthService_RequestMagicLink_Call) Run(run func(ctx context.Context, input domain.MagicLinkParams)) *AuthService_RequestMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.MagicLinkParams
+		if args[1] != nil {
+			arg1 = args[1].(domain.MagicLinkParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_RequestMagicLink_Call) Return(err error) *AuthService_RequestMagicLink_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthService_RequestMagicLink_Call) RunAndReturn(run func(ctx context.Context, input domain.MagicLinkParams) error) *AuthService_RequestMagicLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResendVerification provides a mock function for the type AuthService
+func (_mock *AuthService) ResendVerification(ctx context.Context, userID int64) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendVerification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthService_ResendVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResendVerification'
+type AuthService_ResendVerification_Call struct {
+	*mock.Call
+}
+
+// ResendVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *AuthService_Expecter) ResendVerification(ctx interface{}, userID interface{}) *AuthService_ResendVerification_Call {
+	return &AuthService_ResendVerification_Call{Call: _e.mock.On("ResendVerification", ctx, userID)}
+}
+
+func (_c *AuthService_ResendVerification_Call) Run(run func(ctx context.Context, userID int64)) *AuthService_ResendVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_ResendVerification_Call) Return(err error) *AuthService_ResendVerification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthService_ResendVerification_Call) RunAndReturn(run func(ctx context.Context, userID int64) error) *AuthService_ResendVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResetPassword provides a mock function for the type AuthService
 func (_mock *AuthService) ResetPassword(ctx context.Context, input domain.ResetPasswordParams) error {
 	ret := _mock.Called(ctx, input)
@@ -464,6 +881,129 @@ func (_c *AuthService_ResetPassword_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// StartOAuth provides a mock function for the type AuthService
+func (_mock *AuthService) StartOAuth(ctx context.Context, provider string) (domain.OAuthAuthorizeResponse, error) {
+	ret := _mock.Called(ctx, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartOAuth")
+	}
+
+	var r0 domain.OAuthAuthorizeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.OAuthAuthorizeResponse, error)); ok {
+		return returnFunc(ctx, provider)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.OAuthAuthorizeResponse); ok {
+		r0 = returnFunc(ctx, provider)
+	} else {
+		r0 = ret.Get(0).(domain.OAuthAuthorizeResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthService_StartOAuth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartOAuth'
+type AuthService_StartOAuth_Call struct {
+	*mock.Call
+}
+
+// StartOAuth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+func (_e *AuthService_Expecter) StartOAuth(ctx interface{}, provider interface{}) *AuthService_StartOAuth_Call {
+	return &AuthService_StartOAuth_Call{Call: _e.mock.On("StartOAuth", ctx, provider)}
+}
+
+func (_c *AuthService_StartOAuth_Call) Run(run func(ctx context.Context, provider string)) *AuthService_StartOAuth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_StartOAuth_Call) Return(oAuthAuthorizeResponse domain.OAuthAuthorizeResponse, err error) *AuthService_StartOAuth_Call {
+	_c.Call.Return(oAuthAuthorizeResponse, err)
+	return _c
+}
+
+func (_c *AuthService_StartOAuth_Call) RunAndReturn(run func(ctx context.Context, provider string) (domain.OAuthAuthorizeResponse, error)) *AuthService_StartOAuth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlockAccount provides a mock function for the type AuthService
+func (_mock *AuthService) UnlockAccount(ctx context.Context, unlockToken string) error {
+	ret := _mock.Called(ctx, unlockToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockAccount")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, unlockToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthService_UnlockAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockAccount'
+type AuthService_UnlockAccount_Call struct {
+	*mock.Call
+}
+
+// UnlockAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - unlockToken string
+func (_e *AuthService_Expecter) UnlockAccount(ctx interface{}, unlockToken interface{}) *AuthService_UnlockAccount_Call {
+	return &AuthService_UnlockAccount_Call{Call: _e.mock.On("UnlockAccount", ctx, unlockToken)}
+}
+
+func (_c *AuthService_UnlockAccount_Call) Run(run func(ctx context.Context, unlockToken string)) *AuthService_UnlockAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_UnlockAccount_Call) Return(err error) *AuthService_UnlockAccount_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthService_UnlockAccount_Call) RunAndReturn(run func(ctx context.Context, unlockToken string) error) *AuthService_UnlockAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifyEmail provides a mock function for the type AuthService
 func (_mock *AuthService) VerifyEmail(ctx context.Context, emailToken string) error {
 	ret := _mock.Called(ctx, emailToken)
@@ -520,3 +1060,69 @@ func (_c *AuthService_VerifyEmail_Call) RunAndReturn(run func(ctx context.Contex
 	_c.Call.Return(run)
 	return _c
 }
+
+// VerifyTwoFactor provides a mock function for the type AuthService
+func (_mock *AuthService) VerifyTwoFactor(ctx context.Context, input domain.VerifyTwoFactorParams) (domain.LoginResponse, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyTwoFactor")
+	}
+
+	var r0 domain.LoginResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.VerifyTwoFactorParams) (domain.LoginResponse, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.VerifyTwoFactorParams) domain.LoginResponse); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(domain.LoginResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.VerifyTwoFactorParams) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthService_VerifyTwoFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyTwoFactor'
+type AuthService_VerifyTwoFactor_Call struct {
+	*mock.Call
+}
+
+// VerifyTwoFactor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.VerifyTwoFactorParams
+func (_e *AuthService_Expecter) VerifyTwoFactor(ctx interface{}, input interface{}) *AuthService_VerifyTwoFactor_Call {
+	return &AuthService_VerifyTwoFactor_Call{Call: _e.mock.On("VerifyTwoFactor", ctx, input)}
+}
+
+func (_c *AuthService_VerifyTwoFactor_Call) Run(run func(ctx context.Context, input domain.VerifyTwoFactorParams)) *AuthService_VerifyTwoFactor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.VerifyTwoFactorParams
+		if args[1] != nil {
+			arg1 = args[1].(domain.VerifyTwoFactorParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_VerifyTwoFactor_Call) Return(loginResponse domain.LoginResponse, err error) *AuthService_VerifyTwoFactor_Call {
+	_c.Call.Return(loginResponse, err)
+	return _c
+}
+
+func (_c *AuthService_VerifyTwoFactor_Call) RunAndReturn(run func(ctx context.Context, input domain.VerifyTwoFactorParams) (domain.LoginResponse, error)) *AuthService_VerifyTwoFactor_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -225,6 +225,74 @@ func (_c *TokenRepository_GetByHash_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// ListActiveByUser provides a mock function for the type TokenRepository
+func (_mock *TokenRepository) ListActiveByUser(ctx context.Context, userID int64) ([]domain.RefreshToken, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActiveByUser")
+	}
+
+	var r0 []domain.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]domain.RefreshToken, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []domain.RefreshToken); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenRepository_ListActiveByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActiveByUser'
+type TokenRepository_ListActiveByUser_Call struct {
+	*mock.Call
+}
+
+// ListActiveByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *TokenRepository_Expecter) ListActiveByUser(ctx interface{}, userID interface{}) *TokenRepository_ListActiveByUser_Call {
+	return &TokenRepository_ListActiveByUser_Call{Call: _e.mock.On("ListActiveByUser", ctx, userID)}
+}
+
+func (_c *TokenRepository_ListActiveByUser_Call) Run(run func(ctx context.Context, userID int64)) *TokenRepository_ListActiveByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenRepository_ListActiveByUser_Call) Return(refreshTokens []domain.RefreshToken, err error) *TokenRepository_ListActiveByUser_Call {
+	_c.Call.Return(refreshTokens, err)
+	return _c
+}
+
+func (_c *TokenRepository_ListActiveByUser_Call) RunAndReturn(run func(ctx context.Context, userID int64) ([]domain.RefreshToken, error)) *TokenRepository_ListActiveByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateRevoked provides a mock function for the type TokenRepository
 func (_mock *TokenRepository) UpdateRevoked(ctx context.Context, id int64) error {
 	ret := _mock.Called(ctx, id)
@@ -345,6 +413,69 @@ func (_c *TokenRepository_UpdateRevokedByDevice_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// UpdateRevokedBySession provides a mock function for the type TokenRepository
+func (_mock *TokenRepository) UpdateRevokedBySession(ctx context.Context, userID int64, sessionID string) error {
+	ret := _mock.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRevokedBySession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = returnFunc(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TokenRepository_UpdateRevokedBySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRevokedBySession'
+type TokenRepository_UpdateRevokedBySession_Call struct {
+	*mock.Call
+}
+
+// UpdateRevokedBySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - sessionID string
+func (_e *TokenRepository_Expecter) UpdateRevokedBySession(ctx interface{}, userID interface{}, sessionID interface{}) *TokenRepository_UpdateRevokedBySession_Call {
+	return &TokenRepository_UpdateRevokedBySession_Call{Call: _e.mock.On("UpdateRevokedBySession", ctx, userID, sessionID)}
+}
+
+func (_c *TokenRepository_UpdateRevokedBySession_Call) Run(run func(ctx context.Context, userID int64, sessionID string)) *TokenRepository_UpdateRevokedBySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenRepository_UpdateRevokedBySession_Call) Return(err error) *TokenRepository_UpdateRevokedBySession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TokenRepository_UpdateRevokedBySession_Call) RunAndReturn(run func(ctx context.Context, userID int64, sessionID string) error) *TokenRepository_UpdateRevokedBySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateRevokedByUser provides a mock function for the type TokenRepository
 func (_mock *TokenRepository) UpdateRevokedByUser(ctx context.Context, userID int64) error {
 	ret := _mock.Called(ctx, userID)
@@ -6,7 +6,9 @@ package mocks
 
 import (
 	"air-social/internal/domain"
+	"air-social/pkg"
 	"context"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	mock "github.com/stretchr/testify/mock"
@@ -91,8 +93,8 @@ func (_c *TokenService_CleanupDatabase_Call) RunAndReturn(run func(ctx context.C
 }
 
 // CreateSession provides a mock function for the type TokenService
-func (_mock *TokenService) CreateSession(ctx context.Context, userID int64, deviceID string) (domain.TokenInfo, error) {
-	ret := _mock.Called(ctx, userID, deviceID)
+func (_mock *TokenService) CreateSession(ctx context.Context, userID int64, role domain.UserRole, client domain.SessionClient) (domain.TokenInfo, error) {
+	ret := _mock.Called(ctx, userID, role, client)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateSession")
@@ -100,16 +102,16 @@ func (_mock *TokenService) CreateSession(ctx context.Context, userID int64, devi
 
 	var r0 domain.TokenInfo
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) (domain.TokenInfo, error)); ok {
-		return returnFunc(ctx, userID, deviceID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, domain.UserRole, domain.SessionClient) (domain.TokenInfo, error)); ok {
+		return returnFunc(ctx, userID, role, client)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) domain.TokenInfo); ok {
-		r0 = returnFunc(ctx, userID, deviceID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, domain.UserRole, domain.SessionClient) domain.TokenInfo); ok {
+		r0 = returnFunc(ctx, userID, role, client)
 	} else {
 		r0 = ret.Get(0).(domain.TokenInfo)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
-		r1 = returnFunc(ctx, userID, deviceID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, domain.UserRole, domain.SessionClient) error); ok {
+		r1 = returnFunc(ctx, userID, role, client)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -124,12 +126,13 @@ type TokenService_CreateSession_Call struct {
 // CreateSession is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID int64
-//   - deviceID string
-func (_e *TokenService_Expecter) CreateSession(ctx interface{}, userID interface{}, deviceID interface{}) *TokenService_CreateSession_Call {
-	return &TokenService_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, userID, deviceID)}
+//   - role domain.UserRole
+//   - client domain.SessionClient
+func (_e *TokenService_Expecter) CreateSession(ctx interface{}, userID interface{}, role interface{}, client interface{}) *TokenService_CreateSession_Call {
+	return &TokenService_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, userID, role, client)}
 }
 
-func (_c *TokenService_CreateSession_Call) Run(run func(ctx context.Context, userID int64, deviceID string)) *TokenService_CreateSession_Call {
+func (_c *TokenService_CreateSession_Call) Run(run func(ctx context.Context, userID int64, role domain.UserRole, client domain.SessionClient)) *TokenService_CreateSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -139,14 +142,19 @@ func (_c *TokenService_CreateSession_Call) Run(run func(ctx context.Context, use
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
-		var arg2 string
+		var arg2 domain.UserRole
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(domain.UserRole)
+		}
+		var arg3 domain.SessionClient
+		if args[3] != nil {
+			arg3 = args[3].(domain.SessionClient)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -157,14 +165,198 @@ func (_c *TokenService_CreateSession_Call) Return(tokenInfo domain.TokenInfo, er
 	return _c
 }
 
-func (_c *TokenService_CreateSession_Call) RunAndReturn(run func(ctx context.Context, userID int64, deviceID string) (domain.TokenInfo, error)) *TokenService_CreateSession_Call {
+func (_c *TokenService_CreateSession_Call) RunAndReturn(run func(ctx context.Context, userID int64, role domain.UserRole, client domain.SessionClient) (domain.TokenInfo, error)) *TokenService_CreateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsAccessTokenRevoked provides a mock function for the type TokenService
+func (_mock *TokenService) IsAccessTokenRevoked(ctx context.Context, claims *domain.AuthClaims) (bool, error) {
+	ret := _mock.Called(ctx, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAccessTokenRevoked")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AuthClaims) (bool, error)); ok {
+		return returnFunc(ctx, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.AuthClaims) bool); ok {
+		r0 = returnFunc(ctx, claims)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.AuthClaims) error); ok {
+		r1 = returnFunc(ctx, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenService_IsAccessTokenRevoked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAccessTokenRevoked'
+type TokenService_IsAccessTokenRevoked_Call struct {
+	*mock.Call
+}
+
+// IsAccessTokenRevoked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - claims *domain.AuthClaims
+func (_e *TokenService_Expecter) IsAccessTokenRevoked(ctx interface{}, claims interface{}) *TokenService_IsAccessTokenRevoked_Call {
+	return &TokenService_IsAccessTokenRevoked_Call{Call: _e.mock.On("IsAccessTokenRevoked", ctx, claims)}
+}
+
+func (_c *TokenService_IsAccessTokenRevoked_Call) Run(run func(ctx context.Context, claims *domain.AuthClaims)) *TokenService_IsAccessTokenRevoked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.AuthClaims
+		if args[1] != nil {
+			arg1 = args[1].(*domain.AuthClaims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenService_IsAccessTokenRevoked_Call) Return(b bool, err error) *TokenService_IsAccessTokenRevoked_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *TokenService_IsAccessTokenRevoked_Call) RunAndReturn(run func(ctx context.Context, claims *domain.AuthClaims) (bool, error)) *TokenService_IsAccessTokenRevoked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// JWKS provides a mock function for the type TokenService
+func (_mock *TokenService) JWKS() pkg.JWKSet {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for JWKS")
+	}
+
+	var r0 pkg.JWKSet
+	if returnFunc, ok := ret.Get(0).(func() pkg.JWKSet); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(pkg.JWKSet)
+	}
+	return r0
+}
+
+// TokenService_JWKS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JWKS'
+type TokenService_JWKS_Call struct {
+	*mock.Call
+}
+
+// JWKS is a helper method to define mock.On call
+func (_e *TokenService_Expecter) JWKS() *TokenService_JWKS_Call {
+	return &TokenService_JWKS_Call{Call: _e.mock.On("JWKS")}
+}
+
+func (_c *TokenService_JWKS_Call) Run(run func()) *TokenService_JWKS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TokenService_JWKS_Call) Return(jWKSet pkg.JWKSet) *TokenService_JWKS_Call {
+	_c.Call.Return(jWKSet)
+	return _c
+}
+
+func (_c *TokenService_JWKS_Call) RunAndReturn(run func() pkg.JWKSet) *TokenService_JWKS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function for the type TokenService
+func (_mock *TokenService) ListSessions(ctx context.Context, userID int64, currentDeviceID string) ([]domain.SessionResponse, error) {
+	ret := _mock.Called(ctx, userID, currentDeviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 []domain.SessionResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) ([]domain.SessionResponse, error)); ok {
+		return returnFunc(ctx, userID, currentDeviceID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) []domain.SessionResponse); ok {
+		r0 = returnFunc(ctx, userID, currentDeviceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SessionResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = returnFunc(ctx, userID, currentDeviceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenService_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type TokenService_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - currentDeviceID string
+func (_e *TokenService_Expecter) ListSessions(ctx interface{}, userID interface{}, currentDeviceID interface{}) *TokenService_ListSessions_Call {
+	return &TokenService_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, userID, currentDeviceID)}
+}
+
+func (_c *TokenService_ListSessions_Call) Run(run func(ctx context.Context, userID int64, currentDeviceID string)) *TokenService_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenService_ListSessions_Call) Return(sessionResponses []domain.SessionResponse, err error) *TokenService_ListSessions_Call {
+	_c.Call.Return(sessionResponses, err)
+	return _c
+}
+
+func (_c *TokenService_ListSessions_Call) RunAndReturn(run func(ctx context.Context, userID int64, currentDeviceID string) ([]domain.SessionResponse, error)) *TokenService_ListSessions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Refresh provides a mock function for the type TokenService
-func (_mock *TokenService) Refresh(ctx context.Context, refreshToken string) (domain.TokenInfo, error) {
-	ret := _mock.Called(ctx, refreshToken)
+func (_mock *TokenService) Refresh(ctx context.Context, refreshToken string, client domain.SessionClient) (domain.TokenInfo, error) {
+	ret := _mock.Called(ctx, refreshToken, client)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Refresh")
@@ -172,16 +364,16 @@ func (_mock *TokenService) Refresh(ctx context.Context, refreshToken string) (do
 
 	var r0 domain.TokenInfo
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.TokenInfo, error)); ok {
-		return returnFunc(ctx, refreshToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.SessionClient) (domain.TokenInfo, error)); ok {
+		return returnFunc(ctx, refreshToken, client)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.TokenInfo); ok {
-		r0 = returnFunc(ctx, refreshToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.SessionClient) domain.TokenInfo); ok {
+		r0 = returnFunc(ctx, refreshToken, client)
 	} else {
 		r0 = ret.Get(0).(domain.TokenInfo)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, refreshToken)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.SessionClient) error); ok {
+		r1 = returnFunc(ctx, refreshToken, client)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -196,11 +388,12 @@ type TokenService_Refresh_Call struct {
 // Refresh is a helper method to define mock.On call
 //   - ctx context.Context
 //   - refreshToken string
-func (_e *TokenService_Expecter) Refresh(ctx interface{}, refreshToken interface{}) *TokenService_Refresh_Call {
-	return &TokenService_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refreshToken)}
+//   - client domain.SessionClient
+func (_e *TokenService_Expecter) Refresh(ctx interface{}, refreshToken interface{}, client interface{}) *TokenService_Refresh_Call {
+	return &TokenService_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refreshToken, client)}
 }
 
-func (_c *TokenService_Refresh_Call) Run(run func(ctx context.Context, refreshToken string)) *TokenService_Refresh_Call {
+func (_c *TokenService_Refresh_Call) Run(run func(ctx context.Context, refreshToken string, client domain.SessionClient)) *TokenService_Refresh_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -210,9 +403,14 @@ func (_c *TokenService_Refresh_Call) Run(run func(ctx context.Context, refreshTo
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 domain.SessionClient
+		if args[2] != nil {
+			arg2 = args[2].(domain.SessionClient)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -223,7 +421,70 @@ func (_c *TokenService_Refresh_Call) Return(tokenInfo domain.TokenInfo, err erro
 	return _c
 }
 
-func (_c *TokenService_Refresh_Call) RunAndReturn(run func(ctx context.Context, refreshToken string) (domain.TokenInfo, error)) *TokenService_Refresh_Call {
+func (_c *TokenService_Refresh_Call) RunAndReturn(run func(ctx context.Context, refreshToken string, client domain.SessionClient) (domain.TokenInfo, error)) *TokenService_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAccessToken provides a mock function for the type TokenService
+func (_mock *TokenService) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	ret := _mock.Called(ctx, jti, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAccessToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, jti, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TokenService_RevokeAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAccessToken'
+type TokenService_RevokeAccessToken_Call struct {
+	*mock.Call
+}
+
+// RevokeAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jti string
+//   - expiresAt time.Time
+func (_e *TokenService_Expecter) RevokeAccessToken(ctx interface{}, jti interface{}, expiresAt interface{}) *TokenService_RevokeAccessToken_Call {
+	return &TokenService_RevokeAccessToken_Call{Call: _e.mock.On("RevokeAccessToken", ctx, jti, expiresAt)}
+}
+
+func (_c *TokenService_RevokeAccessToken_Call) Run(run func(ctx context.Context, jti string, expiresAt time.Time)) *TokenService_RevokeAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenService_RevokeAccessToken_Call) Return(err error) *TokenService_RevokeAccessToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TokenService_RevokeAccessToken_Call) RunAndReturn(run func(ctx context.Context, jti string, expiresAt time.Time) error) *TokenService_RevokeAccessToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -348,6 +609,69 @@ func (_c *TokenService_RevokeDeviceSession_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// RevokeSession provides a mock function for the type TokenService
+func (_mock *TokenService) RevokeSession(ctx context.Context, userID int64, sessionID string) error {
+	ret := _mock.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = returnFunc(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TokenService_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type TokenService_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - sessionID string
+func (_e *TokenService_Expecter) RevokeSession(ctx interface{}, userID interface{}, sessionID interface{}) *TokenService_RevokeSession_Call {
+	return &TokenService_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, userID, sessionID)}
+}
+
+func (_c *TokenService_RevokeSession_Call) Run(run func(ctx context.Context, userID int64, sessionID string)) *TokenService_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenService_RevokeSession_Call) Return(err error) *TokenService_RevokeSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TokenService_RevokeSession_Call) RunAndReturn(run func(ctx context.Context, userID int64, sessionID string) error) *TokenService_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RevokeSingle provides a mock function for the type TokenService
 func (_mock *TokenService) RevokeSingle(ctx context.Context, refreshToken string) error {
 	ret := _mock.Called(ctx, refreshToken)
@@ -79,6 +79,108 @@ func (_c *URLFactory_APIRouterPath_Call) RunAndReturn(run func() string) *URLFac
 	return _c
 }
 
+// CancelEmailChangeLink provides a mock function for the type URLFactory
+func (_mock *URLFactory) CancelEmailChangeLink(token string) string {
+	ret := _mock.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelEmailChangeLink")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// URLFactory_CancelEmailChangeLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelEmailChangeLink'
+type URLFactory_CancelEmailChangeLink_Call struct {
+	*mock.Call
+}
+
+// CancelEmailChangeLink is a helper method to define mock.On call
+//   - token string
+func (_e *URLFactory_Expecter) CancelEmailChangeLink(token interface{}) *URLFactory_CancelEmailChangeLink_Call {
+	return &URLFactory_CancelEmailChangeLink_Call{Call: _e.mock.On("CancelEmailChangeLink", token)}
+}
+
+func (_c *URLFactory_CancelEmailChangeLink_Call) Run(run func(token string)) *URLFactory_CancelEmailChangeLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *URLFactory_CancelEmailChangeLink_Call) Return(s string) *URLFactory_CancelEmailChangeLink_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *URLFactory_CancelEmailChangeLink_Call) RunAndReturn(run func(token string) string) *URLFactory_CancelEmailChangeLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmEmailChangeLink provides a mock function for the type URLFactory
+func (_mock *URLFactory) ConfirmEmailChangeLink(token string) string {
+	ret := _mock.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmEmailChangeLink")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// URLFactory_ConfirmEmailChangeLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmEmailChangeLink'
+type URLFactory_ConfirmEmailChangeLink_Call struct {
+	*mock.Call
+}
+
+// ConfirmEmailChangeLink is a helper method to define mock.On call
+//   - token string
+func (_e *URLFactory_Expecter) ConfirmEmailChangeLink(token interface{}) *URLFactory_ConfirmEmailChangeLink_Call {
+	return &URLFactory_ConfirmEmailChangeLink_Call{Call: _e.mock.On("ConfirmEmailChangeLink", token)}
+}
+
+func (_c *URLFactory_ConfirmEmailChangeLink_Call) Run(run func(token string)) *URLFactory_ConfirmEmailChangeLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *URLFactory_ConfirmEmailChangeLink_Call) Return(s string) *URLFactory_ConfirmEmailChangeLink_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *URLFactory_ConfirmEmailChangeLink_Call) RunAndReturn(run func(token string) string) *URLFactory_ConfirmEmailChangeLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FileStorageBaseURL provides a mock function for the type URLFactory
 func (_mock *URLFactory) FileStorageBaseURL() string {
 	ret := _mock.Called()
@@ -123,6 +225,57 @@ func (_c *URLFactory_FileStorageBaseURL_Call) RunAndReturn(run func() string) *U
 	return _c
 }
 
+// MagicLoginLink provides a mock function for the type URLFactory
+func (_mock *URLFactory) MagicLoginLink(token string) string {
+	ret := _mock.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MagicLoginLink")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// URLFactory_MagicLoginLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MagicLoginLink'
+type URLFactory_MagicLoginLink_Call struct {
+	*mock.Call
+}
+
+// MagicLoginLink is a helper method to define mock.On call
+//   - token string
+func (_e *URLFactory_Expecter) MagicLoginLink(token interface{}) *URLFactory_MagicLoginLink_Call {
+	return &URLFactory_MagicLoginLink_Call{Call: _e.mock.On("MagicLoginLink", token)}
+}
+
+func (_c *URLFactory_MagicLoginLink_Call) Run(run func(token string)) *URLFactory_MagicLoginLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *URLFactory_MagicLoginLink_Call) Return(s string) *URLFactory_MagicLoginLink_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *URLFactory_MagicLoginLink_Call) RunAndReturn(run func(token string) string) *URLFactory_MagicLoginLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MinioConsoleUI provides a mock function for the type URLFactory
 func (_mock *URLFactory) MinioConsoleUI() string {
 	ret := _mock.Called()
@@ -306,6 +459,57 @@ func (_c *URLFactory_SwaggerUI_Call) RunAndReturn(run func() string) *URLFactory
 	return _c
 }
 
+// UnlockAccountLink provides a mock function for the type URLFactory
+func (_mock *URLFactory) UnlockAccountLink(token string) string {
+	ret := _mock.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockAccountLink")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(token)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// URLFactory_UnlockAccountLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockAccountLink'
+type URLFactory_UnlockAccountLink_Call struct {
+	*mock.Call
+}
+
+// UnlockAccountLink is a helper method to define mock.On call
+//   - token string
+func (_e *URLFactory_Expecter) UnlockAccountLink(token interface{}) *URLFactory_UnlockAccountLink_Call {
+	return &URLFactory_UnlockAccountLink_Call{Call: _e.mock.On("UnlockAccountLink", token)}
+}
+
+func (_c *URLFactory_UnlockAccountLink_Call) Run(run func(token string)) *URLFactory_UnlockAccountLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *URLFactory_UnlockAccountLink_Call) Return(s string) *URLFactory_UnlockAccountLink_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *URLFactory_UnlockAccountLink_Call) RunAndReturn(run func(token string) string) *URLFactory_UnlockAccountLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifyEmailLink provides a mock function for the type URLFactory
 func (_mock *URLFactory) VerifyEmailLink(token string) string {
 	ret := _mock.Called(token)
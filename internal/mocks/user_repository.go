@@ -7,6 +7,7 @@ package mocks
 import (
 	"air-social/internal/domain"
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -95,55 +96,527 @@ func (_c *UserRepository_Create_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// CreateIdentity provides a mock function for the type UserRepository
+func (_mock *UserRepository) CreateIdentity(ctx context.Context, identity *domain.UserIdentity) error {
+	ret := _mock.Called(ctx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateIdentity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.UserIdentity) error); ok {
+		r0 = returnFunc(ctx, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserRepository_CreateIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIdentity'
+type UserRepository_CreateIdentity_Call struct {
+	*mock.Call
+}
+
+// CreateIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity *domain.UserIdentity
+func (_e *UserRepository_Expecter) CreateIdentity(ctx interface{}, identity interface{}) *UserRepository_CreateIdentity_Call {
+	return &UserRepository_CreateIdentity_Call{Call: _e.mock.On("CreateIdentity", ctx, identity)}
+}
+
+func (_c *UserRepository_CreateIdentity_Call) Run(run func(ctx context.Context, identity *domain.UserIdentity)) *UserRepository_CreateIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.UserIdentity
+		if args[1] != nil {
+			arg1 = args[1].(*domain.UserIdentity)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserRepository_CreateIdentity_Call) Return(err error) *UserRepository_CreateIdentity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserRepository_CreateIdentity_Call) RunAndReturn(run func(ctx context.Context, identity *domain.UserIdentity) error) *UserRepository_CreateIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWithIdentity provides a mock function for the type UserRepository
+func (_mock *UserRepository) CreateWithIdentity(ctx context.Context, user *domain.User, identity *domain.UserIdentity) error {
+	ret := _mock.Called(ctx, user, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithIdentity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, *domain.UserIdentity) error); ok {
+		r0 = returnFunc(ctx, user, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserRepository_CreateWithIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithIdentity'
+type UserRepository_CreateWithIdentity_Call struct {
+	*mock.Call
+}
+
+// CreateWithIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+//   - identity *domain.UserIdentity
+func (_e *UserRepository_Expecter) CreateWithIdentity(ctx interface{}, user interface{}, identity interface{}) *UserRepository_CreateWithIdentity_Call {
+	return &UserRepository_CreateWithIdentity_Call{Call: _e.mock.On("CreateWithIdentity", ctx, user, identity)}
+}
+
+func (_c *UserRepository_CreateWithIdentity_Call) Run(run func(ctx context.Context, user *domain.User, identity *domain.UserIdentity)) *UserRepository_CreateWithIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		var arg2 *domain.UserIdentity
+		if args[2] != nil {
+			arg2 = args[2].(*domain.UserIdentity)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserRepository_CreateWithIdentity_Call) Return(err error) *UserRepository_CreateWithIdentity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserRepository_CreateWithIdentity_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, identity *domain.UserIdentity) error) *UserRepository_CreateWithIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByEmail provides a mock function for the type UserRepository
 func (_mock *UserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
 	ret := _mock.Called(ctx, email)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByEmail")
+		panic("no return value specified for GetByEmail")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// UserRepository_GetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByEmail'
+type UserRepository_GetByEmail_Call struct {
+	*mock.Call
+}
+
+// GetByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *UserRepository_Expecter) GetByEmail(ctx interface{}, email interface{}) *UserRepository_GetByEmail_Call {
+	return &UserRepository_GetByEmail_Call{Call: _e.mock.On("GetByEmail", ctx, email)}
+}
+
+func (_c *UserRepository_GetByEmail_Call) Run(run func(ctx context.Context, email string)) *UserRepository_GetByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetByEmail_Call) Return(user *domain.User, err error) *UserRepository_GetByEmail_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *UserRepository_GetByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*domain.User, error)) *UserRepository_GetByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type UserRepository
+func (_mock *UserRepository) GetByID(ctx context.Context, id int64) (*domain.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// UserRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type UserRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *UserRepository_Expecter) GetByID(ctx interface{}, id interface{}) *UserRepository_GetByID_Call {
+	return &UserRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *UserRepository_GetByID_Call) Run(run func(ctx context.Context, id int64)) *UserRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetByID_Call) Return(user *domain.User, err error) *UserRepository_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *UserRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.User, error)) *UserRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIdentity provides a mock function for the type UserRepository
+func (_mock *UserRepository) GetByIdentity(ctx context.Context, provider string, subject string) (*domain.User, error) {
+	ret := _mock.Called(ctx, provider, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIdentity")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, provider, subject)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.User); ok {
+		r0 = returnFunc(ctx, provider, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, provider, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// UserRepository_GetByIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIdentity'
+type UserRepository_GetByIdentity_Call struct {
+	*mock.Call
+}
+
+// GetByIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+//   - subject string
+func (_e *UserRepository_Expecter) GetByIdentity(ctx interface{}, provider interface{}, subject interface{}) *UserRepository_GetByIdentity_Call {
+	return &UserRepository_GetByIdentity_Call{Call: _e.mock.On("GetByIdentity", ctx, provider, subject)}
+}
+
+func (_c *UserRepository_GetByIdentity_Call) Run(run func(ctx context.Context, provider string, subject string)) *UserRepository_GetByIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetByIdentity_Call) Return(user *domain.User, err error) *UserRepository_GetByIdentity_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *UserRepository_GetByIdentity_Call) RunAndReturn(run func(ctx context.Context, provider string, subject string) (*domain.User, error)) *UserRepository_GetByIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDueForDeletion provides a mock function for the type UserRepository
+func (_mock *UserRepository) ListDueForDeletion(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	ret := _mock.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDueForDeletion")
+	}
+
+	var r0 []int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]int64, error)); ok {
+		return returnFunc(ctx, now, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) []int64); ok {
+		r0 = returnFunc(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// UserRepository_ListDueForDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDueForDeletion'
+type UserRepository_ListDueForDeletion_Call struct {
+	*mock.Call
+}
+
+// ListDueForDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *UserRepository_Expecter) ListDueForDeletion(ctx interface{}, now interface{}, limit interface{}) *UserRepository_ListDueForDeletion_Call {
+	return &UserRepository_ListDueForDeletion_Call{Call: _e.mock.On("ListDueForDeletion", ctx, now, limit)}
+}
+
+func (_c *UserRepository_ListDueForDeletion_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *UserRepository_ListDueForDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserRepository_ListDueForDeletion_Call) Return(int64s []int64, err error) *UserRepository_ListDueForDeletion_Call {
+	_c.Call.Return(int64s, err)
+	return _c
+}
+
+func (_c *UserRepository_ListDueForDeletion_Call) RunAndReturn(run func(ctx context.Context, now time.Time, limit int) ([]int64, error)) *UserRepository_ListDueForDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHidingPresence provides a mock function for the type UserRepository
+func (_mock *UserRepository) ListHidingPresence(ctx context.Context, ids []int64) ([]int64, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHidingPresence")
+	}
+
+	var r0 []int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) ([]int64, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) []int64); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// UserRepository_ListHidingPresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHidingPresence'
+type UserRepository_ListHidingPresence_Call struct {
+	*mock.Call
+}
+
+// ListHidingPresence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *UserRepository_Expecter) ListHidingPresence(ctx interface{}, ids interface{}) *UserRepository_ListHidingPresence_Call {
+	return &UserRepository_ListHidingPresence_Call{Call: _e.mock.On("ListHidingPresence", ctx, ids)}
+}
+
+func (_c *UserRepository_ListHidingPresence_Call) Run(run func(ctx context.Context, ids []int64)) *UserRepository_ListHidingPresence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []int64
+		if args[1] != nil {
+			arg1 = args[1].([]int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserRepository_ListHidingPresence_Call) Return(int64s []int64, err error) *UserRepository_ListHidingPresence_Call {
+	_c.Call.Return(int64s, err)
+	return _c
+}
+
+func (_c *UserRepository_ListHidingPresence_Call) RunAndReturn(run func(ctx context.Context, ids []int64) ([]int64, error)) *UserRepository_ListHidingPresence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function for the type UserRepository
+func (_mock *UserRepository) Purge(ctx context.Context, userID int64) (*domain.PurgedUser, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
 	}
 
-	var r0 *domain.User
+	var r0 *domain.PurgedUser
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
-		return returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.PurgedUser, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
-		r0 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.PurgedUser); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
+			r0 = ret.Get(0).(*domain.PurgedUser)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// UserRepository_GetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByEmail'
-type UserRepository_GetByEmail_Call struct {
+// UserRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type UserRepository_Purge_Call struct {
 	*mock.Call
 }
 
-// GetByEmail is a helper method to define mock.On call
+// Purge is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-func (_e *UserRepository_Expecter) GetByEmail(ctx interface{}, email interface{}) *UserRepository_GetByEmail_Call {
-	return &UserRepository_GetByEmail_Call{Call: _e.mock.On("GetByEmail", ctx, email)}
+//   - userID int64
+func (_e *UserRepository_Expecter) Purge(ctx interface{}, userID interface{}) *UserRepository_Purge_Call {
+	return &UserRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, userID)}
 }
 
-func (_c *UserRepository_GetByEmail_Call) Run(run func(ctx context.Context, email string)) *UserRepository_GetByEmail_Call {
+func (_c *UserRepository_Purge_Call) Run(run func(ctx context.Context, userID int64)) *UserRepository_Purge_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -153,57 +626,178 @@ func (_c *UserRepository_GetByEmail_Call) Run(run func(ctx context.Context, emai
 	return _c
 }
 
-func (_c *UserRepository_GetByEmail_Call) Return(user *domain.User, err error) *UserRepository_GetByEmail_Call {
-	_c.Call.Return(user, err)
+func (_c *UserRepository_Purge_Call) Return(purgedUser *domain.PurgedUser, err error) *UserRepository_Purge_Call {
+	_c.Call.Return(purgedUser, err)
 	return _c
 }
 
-func (_c *UserRepository_GetByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*domain.User, error)) *UserRepository_GetByEmail_Call {
+func (_c *UserRepository_Purge_Call) RunAndReturn(run func(ctx context.Context, userID int64) (*domain.PurgedUser, error)) *UserRepository_Purge_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type UserRepository
-func (_mock *UserRepository) GetByID(ctx context.Context, id int64) (*domain.User, error) {
-	ret := _mock.Called(ctx, id)
+// ScheduleDeletion provides a mock function for the type UserRepository
+func (_mock *UserRepository) ScheduleDeletion(ctx context.Context, userID int64, at *time.Time) error {
+	ret := _mock.Called(ctx, userID, at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for ScheduleDeletion")
 	}
 
-	var r0 *domain.User
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *time.Time) error); ok {
+		r0 = returnFunc(ctx, userID, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserRepository_ScheduleDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduleDeletion'
+type UserRepository_ScheduleDeletion_Call struct {
+	*mock.Call
+}
+
+// ScheduleDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - at *time.Time
+func (_e *UserRepository_Expecter) ScheduleDeletion(ctx interface{}, userID interface{}, at interface{}) *UserRepository_ScheduleDeletion_Call {
+	return &UserRepository_ScheduleDeletion_Call{Call: _e.mock.On("ScheduleDeletion", ctx, userID, at)}
+}
+
+func (_c *UserRepository_ScheduleDeletion_Call) Run(run func(ctx context.Context, userID int64, at *time.Time)) *UserRepository_ScheduleDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 *time.Time
+		if args[2] != nil {
+			arg2 = args[2].(*time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserRepository_ScheduleDeletion_Call) Return(err error) *UserRepository_ScheduleDeletion_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserRepository_ScheduleDeletion_Call) RunAndReturn(run func(ctx context.Context, userID int64, at *time.Time) error) *UserRepository_ScheduleDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type UserRepository
+func (_mock *UserRepository) Search(ctx context.Context, filter domain.UserSearchFilter) ([]domain.User, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []domain.User
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*domain.User, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserSearchFilter) ([]domain.User, error)); ok {
+		return returnFunc(ctx, filter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *domain.User); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserSearchFilter) []domain.User); ok {
+		r0 = returnFunc(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.User)
+			r0 = ret.Get(0).([]domain.User)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.UserSearchFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// UserRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type UserRepository_GetByID_Call struct {
+// UserRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type UserRepository_Search_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// Search is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int64
-func (_e *UserRepository_Expecter) GetByID(ctx interface{}, id interface{}) *UserRepository_GetByID_Call {
-	return &UserRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+//   - filter domain.UserSearchFilter
+func (_e *UserRepository_Expecter) Search(ctx interface{}, filter interface{}) *UserRepository_Search_Call {
+	return &UserRepository_Search_Call{Call: _e.mock.On("Search", ctx, filter)}
 }
 
-func (_c *UserRepository_GetByID_Call) Run(run func(ctx context.Context, id int64)) *UserRepository_GetByID_Call {
+func (_c *UserRepository_Search_Call) Run(run func(ctx context.Context, filter domain.UserSearchFilter)) *UserRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.UserSearchFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserSearchFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserRepository_Search_Call) Return(users []domain.User, err error) *UserRepository_Search_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *UserRepository_Search_Call) RunAndReturn(run func(ctx context.Context, filter domain.UserSearchFilter) ([]domain.User, error)) *UserRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetHidePresence provides a mock function for the type UserRepository
+func (_mock *UserRepository) SetHidePresence(ctx context.Context, userID int64, hidden bool) error {
+	ret := _mock.Called(ctx, userID, hidden)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetHidePresence")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, bool) error); ok {
+		r0 = returnFunc(ctx, userID, hidden)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserRepository_SetHidePresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHidePresence'
+type UserRepository_SetHidePresence_Call struct {
+	*mock.Call
+}
+
+// SetHidePresence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - hidden bool
+func (_e *UserRepository_Expecter) SetHidePresence(ctx interface{}, userID interface{}, hidden interface{}) *UserRepository_SetHidePresence_Call {
+	return &UserRepository_SetHidePresence_Call{Call: _e.mock.On("SetHidePresence", ctx, userID, hidden)}
+}
+
+func (_c *UserRepository_SetHidePresence_Call) Run(run func(ctx context.Context, userID int64, hidden bool)) *UserRepository_SetHidePresence_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -213,20 +807,25 @@ func (_c *UserRepository_GetByID_Call) Run(run func(ctx context.Context, id int6
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *UserRepository_GetByID_Call) Return(user *domain.User, err error) *UserRepository_GetByID_Call {
-	_c.Call.Return(user, err)
+func (_c *UserRepository_SetHidePresence_Call) Return(err error) *UserRepository_SetHidePresence_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *UserRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id int64) (*domain.User, error)) *UserRepository_GetByID_Call {
+func (_c *UserRepository_SetHidePresence_Call) RunAndReturn(run func(ctx context.Context, userID int64, hidden bool) error) *UserRepository_SetHidePresence_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -288,6 +887,75 @@ func (_c *UserRepository_Update_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// UpdateEmail provides a mock function for the type UserRepository
+func (_mock *UserRepository) UpdateEmail(ctx context.Context, userID int64, oldEmail string, newEmail string) error {
+	ret := _mock.Called(ctx, userID, oldEmail, newEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, oldEmail, newEmail)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserRepository_UpdateEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmail'
+type UserRepository_UpdateEmail_Call struct {
+	*mock.Call
+}
+
+// UpdateEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - oldEmail string
+//   - newEmail string
+func (_e *UserRepository_Expecter) UpdateEmail(ctx interface{}, userID interface{}, oldEmail interface{}, newEmail interface{}) *UserRepository_UpdateEmail_Call {
+	return &UserRepository_UpdateEmail_Call{Call: _e.mock.On("UpdateEmail", ctx, userID, oldEmail, newEmail)}
+}
+
+func (_c *UserRepository_UpdateEmail_Call) Run(run func(ctx context.Context, userID int64, oldEmail string, newEmail string)) *UserRepository_UpdateEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *UserRepository_UpdateEmail_Call) Return(err error) *UserRepository_UpdateEmail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserRepository_UpdateEmail_Call) RunAndReturn(run func(ctx context.Context, userID int64, oldEmail string, newEmail string) error) *UserRepository_UpdateEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateProfileImages provides a mock function for the type UserRepository
 func (_mock *UserRepository) UpdateProfileImages(ctx context.Context, userID int64, url string, feature domain.UploadFeature) error {
 	ret := _mock.Called(ctx, userID, url, feature)
@@ -356,3 +1024,72 @@ func (_c *UserRepository_UpdateProfileImages_Call) RunAndReturn(run func(ctx con
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateStatus provides a mock function for the type UserRepository
+func (_mock *UserRepository) UpdateStatus(ctx context.Context, userID int64, status domain.UserStatus, until *time.Time) error {
+	ret := _mock.Called(ctx, userID, status, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, domain.UserStatus, *time.Time) error); ok {
+		r0 = returnFunc(ctx, userID, status, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type UserRepository_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - status domain.UserStatus
+//   - until *time.Time
+func (_e *UserRepository_Expecter) UpdateStatus(ctx interface{}, userID interface{}, status interface{}, until interface{}) *UserRepository_UpdateStatus_Call {
+	return &UserRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, userID, status, until)}
+}
+
+func (_c *UserRepository_UpdateStatus_Call) Run(run func(ctx context.Context, userID int64, status domain.UserStatus, until *time.Time)) *UserRepository_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 domain.UserStatus
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserStatus)
+		}
+		var arg3 *time.Time
+		if args[3] != nil {
+			arg3 = args[3].(*time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *UserRepository_UpdateStatus_Call) Return(err error) *UserRepository_UpdateStatus_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserRepository_UpdateStatus_Call) RunAndReturn(run func(ctx context.Context, userID int64, status domain.UserStatus, until *time.Time) error) *UserRepository_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
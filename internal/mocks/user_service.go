@@ -38,6 +38,132 @@ func (_m *UserService) EXPECT() *UserService_Expecter {
 	return &UserService_Expecter{mock: &_m.Mock}
 }
 
+// CancelDeletion provides a mock function for the type UserService
+func (_mock *UserService) CancelDeletion(ctx context.Context, userID int64) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelDeletion")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserService_CancelDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelDeletion'
+type UserService_CancelDeletion_Call struct {
+	*mock.Call
+}
+
+// CancelDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *UserService_Expecter) CancelDeletion(ctx interface{}, userID interface{}) *UserService_CancelDeletion_Call {
+	return &UserService_CancelDeletion_Call{Call: _e.mock.On("CancelDeletion", ctx, userID)}
+}
+
+func (_c *UserService_CancelDeletion_Call) Run(run func(ctx context.Context, userID int64)) *UserService_CancelDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserService_CancelDeletion_Call) Return(err error) *UserService_CancelDeletion_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserService_CancelDeletion_Call) RunAndReturn(run func(ctx context.Context, userID int64) error) *UserService_CancelDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeEmail provides a mock function for the type UserService
+func (_mock *UserService) ChangeEmail(ctx context.Context, userID int64, oldEmail string, newEmail string) error {
+	ret := _mock.Called(ctx, userID, oldEmail, newEmail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeEmail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, oldEmail, newEmail)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserService_ChangeEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeEmail'
+type UserService_ChangeEmail_Call struct {
+	*mock.Call
+}
+
+// ChangeEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - oldEmail string
+//   - newEmail string
+func (_e *UserService_Expecter) ChangeEmail(ctx interface{}, userID interface{}, oldEmail interface{}, newEmail interface{}) *UserService_ChangeEmail_Call {
+	return &UserService_ChangeEmail_Call{Call: _e.mock.On("ChangeEmail", ctx, userID, oldEmail, newEmail)}
+}
+
+func (_c *UserService_ChangeEmail_Call) Run(run func(ctx context.Context, userID int64, oldEmail string, newEmail string)) *UserService_ChangeEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *UserService_ChangeEmail_Call) Return(err error) *UserService_ChangeEmail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserService_ChangeEmail_Call) RunAndReturn(run func(ctx context.Context, userID int64, oldEmail string, newEmail string) error) *UserService_ChangeEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ChangePassword provides a mock function for the type UserService
 func (_mock *UserService) ChangePassword(ctx context.Context, input domain.ChangePasswordParams) error {
 	ret := _mock.Called(ctx, input)
@@ -227,6 +353,146 @@ func (_c *UserService_CreateUser_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// CreateUserWithIdentity provides a mock function for the type UserService
+func (_mock *UserService) CreateUserWithIdentity(ctx context.Context, input domain.CreateUserParams, identity domain.UserIdentity) (*domain.User, error) {
+	ret := _mock.Called(ctx, input, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUserWithIdentity")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateUserParams, domain.UserIdentity) (*domain.User, error)); ok {
+		return returnFunc(ctx, input, identity)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateUserParams, domain.UserIdentity) *domain.User); ok {
+		r0 = returnFunc(ctx, input, identity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CreateUserParams, domain.UserIdentity) error); ok {
+		r1 = returnFunc(ctx, input, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// UserService_CreateUserWithIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserWithIdentity'
+type UserService_CreateUserWithIdentity_Call struct {
+	*mock.Call
+}
+
+// CreateUserWithIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.CreateUserParams
+//   - identity domain.UserIdentity
+func (_e *UserService_Expecter) CreateUserWithIdentity(ctx interface{}, input interface{}, identity interface{}) *UserService_CreateUserWithIdentity_Call {
+	return &UserService_CreateUserWithIdentity_Call{Call: _e.mock.On("CreateUserWithIdentity", ctx, input, identity)}
+}
+
+func (_c *UserService_CreateUserWithIdentity_Call) Run(run func(ctx context.Context, input domain.CreateUserParams, identity domain.UserIdentity)) *UserService_CreateUserWithIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CreateUserParams
+		if args[1] != nil {
+			arg1 = args[1].(domain.CreateUserParams)
+		}
+		var arg2 domain.UserIdentity
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserIdentity)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserService_CreateUserWithIdentity_Call) Return(user *domain.User, err error) *UserService_CreateUserWithIdentity_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *UserService_CreateUserWithIdentity_Call) RunAndReturn(run func(ctx context.Context, input domain.CreateUserParams, identity domain.UserIdentity) (*domain.User, error)) *UserService_CreateUserWithIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAccount provides a mock function for the type UserService
+func (_mock *UserService) DeleteAccount(ctx context.Context, input domain.DeleteAccountParams) (domain.AccountDeletionResponse, error) {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAccount")
+	}
+
+	var r0 domain.AccountDeletionResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DeleteAccountParams) (domain.AccountDeletionResponse, error)); ok {
+		return returnFunc(ctx, input)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DeleteAccountParams) domain.AccountDeletionResponse); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Get(0).(domain.AccountDeletionResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.DeleteAccountParams) error); ok {
+		r1 = returnFunc(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// UserService_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
+type UserService_DeleteAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input domain.DeleteAccountParams
+func (_e *UserService_Expecter) DeleteAccount(ctx interface{}, input interface{}) *UserService_DeleteAccount_Call {
+	return &UserService_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", ctx, input)}
+}
+
+func (_c *UserService_DeleteAccount_Call) Run(run func(ctx context.Context, input domain.DeleteAccountParams)) *UserService_DeleteAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.DeleteAccountParams
+		if args[1] != nil {
+			arg1 = args[1].(domain.DeleteAccountParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserService_DeleteAccount_Call) Return(accountDeletionResponse domain.AccountDeletionResponse, err error) *UserService_DeleteAccount_Call {
+	_c.Call.Return(accountDeletionResponse, err)
+	return _c
+}
+
+func (_c *UserService_DeleteAccount_Call) RunAndReturn(run func(ctx context.Context, input domain.DeleteAccountParams) (domain.AccountDeletionResponse, error)) *UserService_DeleteAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByEmail provides a mock function for the type UserService
 func (_mock *UserService) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
 	ret := _mock.Called(ctx, email)
@@ -363,6 +629,80 @@ func (_c *UserService_GetByID_Call) RunAndReturn(run func(ctx context.Context, i
 	return _c
 }
 
+// GetByIdentity provides a mock function for the type UserService
+func (_mock *UserService) GetByIdentity(ctx context.Context, provider string, subject string) (*domain.User, error) {
+	ret := _mock.Called(ctx, provider, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIdentity")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, provider, subject)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.User); ok {
+		r0 = returnFunc(ctx, provider, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, provider, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// UserService_GetByIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIdentity'
+type UserService_GetByIdentity_Call struct {
+	*mock.Call
+}
+
+// GetByIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+//   - subject string
+func (_e *UserService_Expecter) GetByIdentity(ctx interface{}, provider interface{}, subject interface{}) *UserService_GetByIdentity_Call {
+	return &UserService_GetByIdentity_Call{Call: _e.mock.On("GetByIdentity", ctx, provider, subject)}
+}
+
+func (_c *UserService_GetByIdentity_Call) Run(run func(ctx context.Context, provider string, subject string)) *UserService_GetByIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserService_GetByIdentity_Call) Return(user *domain.User, err error) *UserService_GetByIdentity_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *UserService_GetByIdentity_Call) RunAndReturn(run func(ctx context.Context, provider string, subject string) (*domain.User, error)) *UserService_GetByIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProfile provides a mock function for the type UserService
 func (_mock *UserService) GetProfile(ctx context.Context, id int64) (domain.UserResponse, error) {
 	ret := _mock.Called(ctx, id)
@@ -429,6 +769,248 @@ func (_c *UserService_GetProfile_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// GetPublicProfile provides a mock function for the type UserService
+func (_mock *UserService) GetPublicProfile(ctx context.Context, id int64) (domain.PublicProfileResponse, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicProfile")
+	}
+
+	var r0 domain.PublicProfileResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (domain.PublicProfileResponse, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) domain.PublicProfileResponse); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.PublicProfileResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// UserService_GetPublicProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPublicProfile'
+type UserService_GetPublicProfile_Call struct {
+	*mock.Call
+}
+
+// GetPublicProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *UserService_Expecter) GetPublicProfile(ctx interface{}, id interface{}) *UserService_GetPublicProfile_Call {
+	return &UserService_GetPublicProfile_Call{Call: _e.mock.On("GetPublicProfile", ctx, id)}
+}
+
+func (_c *UserService_GetPublicProfile_Call) Run(run func(ctx context.Context, id int64)) *UserService_GetPublicProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserService_GetPublicProfile_Call) Return(publicProfileResponse domain.PublicProfileResponse, err error) *UserService_GetPublicProfile_Call {
+	_c.Call.Return(publicProfileResponse, err)
+	return _c
+}
+
+func (_c *UserService_GetPublicProfile_Call) RunAndReturn(run func(ctx context.Context, id int64) (domain.PublicProfileResponse, error)) *UserService_GetPublicProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkIdentity provides a mock function for the type UserService
+func (_mock *UserService) LinkIdentity(ctx context.Context, identity domain.UserIdentity) error {
+	ret := _mock.Called(ctx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkIdentity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.UserIdentity) error); ok {
+		r0 = returnFunc(ctx, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserService_LinkIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkIdentity'
+type UserService_LinkIdentity_Call struct {
+	*mock.Call
+}
+
+// LinkIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity domain.UserIdentity
+func (_e *UserService_Expecter) LinkIdentity(ctx interface{}, identity interface{}) *UserService_LinkIdentity_Call {
+	return &UserService_LinkIdentity_Call{Call: _e.mock.On("LinkIdentity", ctx, identity)}
+}
+
+func (_c *UserService_LinkIdentity_Call) Run(run func(ctx context.Context, identity domain.UserIdentity)) *UserService_LinkIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.UserIdentity
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserIdentity)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserService_LinkIdentity_Call) Return(err error) *UserService_LinkIdentity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserService_LinkIdentity_Call) RunAndReturn(run func(ctx context.Context, identity domain.UserIdentity) error) *UserService_LinkIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHidingPresence provides a mock function for the type UserService
+func (_mock *UserService) ListHidingPresence(ctx context.Context, ids []int64) ([]int64, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHidingPresence")
+	}
+
+	var r0 []int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) ([]int64, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) []int64); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// UserService_ListHidingPresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHidingPresence'
+type UserService_ListHidingPresence_Call struct {
+	*mock.Call
+}
+
+// ListHidingPresence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *UserService_Expecter) ListHidingPresence(ctx interface{}, ids interface{}) *UserService_ListHidingPresence_Call {
+	return &UserService_ListHidingPresence_Call{Call: _e.mock.On("ListHidingPresence", ctx, ids)}
+}
+
+func (_c *UserService_ListHidingPresence_Call) Run(run func(ctx context.Context, ids []int64)) *UserService_ListHidingPresence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []int64
+		if args[1] != nil {
+			arg1 = args[1].([]int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserService_ListHidingPresence_Call) Return(int64s []int64, err error) *UserService_ListHidingPresence_Call {
+	_c.Call.Return(int64s, err)
+	return _c
+}
+
+func (_c *UserService_ListHidingPresence_Call) RunAndReturn(run func(ctx context.Context, ids []int64) ([]int64, error)) *UserService_ListHidingPresence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeletedAccounts provides a mock function for the type UserService
+func (_mock *UserService) PurgeDeletedAccounts(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedAccounts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserService_PurgeDeletedAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedAccounts'
+type UserService_PurgeDeletedAccounts_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedAccounts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserService_Expecter) PurgeDeletedAccounts(ctx interface{}) *UserService_PurgeDeletedAccounts_Call {
+	return &UserService_PurgeDeletedAccounts_Call{Call: _e.mock.On("PurgeDeletedAccounts", ctx)}
+}
+
+func (_c *UserService_PurgeDeletedAccounts_Call) Run(run func(ctx context.Context)) *UserService_PurgeDeletedAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *UserService_PurgeDeletedAccounts_Call) Return(err error) *UserService_PurgeDeletedAccounts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserService_PurgeDeletedAccounts_Call) RunAndReturn(run func(ctx context.Context) error) *UserService_PurgeDeletedAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResolveMediaURLs provides a mock function for the type UserService
 func (_mock *UserService) ResolveMediaURLs(res *domain.UserResponse) {
 	_mock.Called(res)
@@ -469,6 +1051,69 @@ func (_c *UserService_ResolveMediaURLs_Call) RunAndReturn(run func(res *domain.U
 	return _c
 }
 
+// SetHidePresence provides a mock function for the type UserService
+func (_mock *UserService) SetHidePresence(ctx context.Context, userID int64, hidden bool) error {
+	ret := _mock.Called(ctx, userID, hidden)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetHidePresence")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, bool) error); ok {
+		r0 = returnFunc(ctx, userID, hidden)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserService_SetHidePresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHidePresence'
+type UserService_SetHidePresence_Call struct {
+	*mock.Call
+}
+
+// SetHidePresence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - hidden bool
+func (_e *UserService_Expecter) SetHidePresence(ctx interface{}, userID interface{}, hidden interface{}) *UserService_SetHidePresence_Call {
+	return &UserService_SetHidePresence_Call{Call: _e.mock.On("SetHidePresence", ctx, userID, hidden)}
+}
+
+func (_c *UserService_SetHidePresence_Call) Run(run func(ctx context.Context, userID int64, hidden bool)) *UserService_SetHidePresence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserService_SetHidePresence_Call) Return(err error) *UserService_SetHidePresence_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserService_SetHidePresence_Call) RunAndReturn(run func(ctx context.Context, userID int64, hidden bool) error) *UserService_SetHidePresence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdatePassword provides a mock function for the type UserService
 func (_mock *UserService) UpdatePassword(ctx context.Context, email string, passwordHashed string) error {
 	ret := _mock.Called(ctx, email, passwordHashed)
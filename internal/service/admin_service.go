@@ -0,0 +1,365 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+// AdminService lets staff manage user accounts. Every action on users is
+// written to the audit log.
+type AdminService interface {
+	SearchUsers(ctx context.Context, input domain.AdminSearchUsersParams) (domain.Page, error)
+	GetUser(ctx context.Context, input domain.AdminUserParams) (domain.AdminUserResponse, error)
+	ListUserSessions(ctx context.Context, input domain.AdminUserParams) ([]domain.SessionResponse, error)
+
+	// The actions below need the actor to outrank the user.
+	ForceLogout(ctx context.Context, input domain.AdminUserParams) error
+	Suspend(ctx context.Context, input domain.SuspendUserParams) (domain.AdminUserResponse, error)
+	Ban(ctx context.Context, input domain.ModerateUserParams) (domain.AdminUserResponse, error)
+	// Unban lifts a ban or suspension.
+	Unban(ctx context.Context, input domain.ModerateUserParams) (domain.AdminUserResponse, error)
+	VerifyEmail(ctx context.Context, input domain.AdminUserParams) (domain.AdminUserResponse, error)
+	ResetProfileImage(ctx context.Context, input domain.ResetProfileImageParams) (domain.AdminUserResponse, error)
+
+	ListAuditLogs(ctx context.Context, input domain.ListAuditLogsParams) (domain.Page, error)
+}
+
+type AdminServiceImpl struct {
+	userRepo  domain.UserRepository
+	auditRepo domain.AuditLogRepository
+	tokenSvc  TokenService
+	mediaSvc  MediaService
+}
+
+func NewAdminService(
+	userRepo domain.UserRepository,
+	auditRepo domain.AuditLogRepository,
+	tokenSvc TokenService,
+	mediaSvc MediaService,
+) *AdminServiceImpl {
+	return &AdminServiceImpl{
+		userRepo:  userRepo,
+		auditRepo: auditRepo,
+		tokenSvc:  tokenSvc,
+		mediaSvc:  mediaSvc,
+	}
+}
+
+func (s *AdminServiceImpl) SearchUsers(ctx context.Context, input domain.AdminSearchUsersParams) (domain.Page, error) {
+	var empty domain.Page
+
+	beforeID, err := decodeIDCursor(input.Page.Cursor)
+	if err != nil {
+		return empty, err
+	}
+
+	limit := input.Page.Size()
+	users, err := s.userRepo.Search(ctx, domain.UserSearchFilter{
+		Query:    input.Query,
+		Role:     input.Role,
+		Status:   input.Status,
+		BeforeID: beforeID,
+		Limit:    limit + 1,
+	})
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	s.audit(ctx, input.Actor, 0, domain.AuditUsersSearch, map[string]any{
+		"query":  input.Query,
+		"role":   input.Role,
+		"status": input.Status,
+		"cursor": input.Page.Cursor,
+	})
+
+	items := make([]domain.AdminUserResponse, 0, len(users))
+	for i := range users {
+		items = append(items, s.mapUser(&users[i]))
+	}
+
+	return newPage(items, limit, func(u domain.AdminUserResponse) string {
+		return pkg.EncodeCursor(u.ID)
+	}), nil
+}
+
+func (s *AdminServiceImpl) GetUser(ctx context.Context, input domain.AdminUserParams) (domain.AdminUserResponse, error) {
+	user, err := s.getUser(ctx, input.UserID)
+	if err != nil {
+		return domain.AdminUserResponse{}, err
+	}
+
+	s.audit(ctx, input.Actor, user.ID, domain.AuditUserView, nil)
+	return s.mapUser(user), nil
+}
+
+func (s *AdminServiceImpl) ListUserSessions(ctx context.Context, input domain.AdminUserParams) ([]domain.SessionResponse, error) {
+	user, err := s.getUser(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.tokenSvc.ListSessions(ctx, user.ID, "")
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+
+	s.audit(ctx, input.Actor, user.ID, domain.AuditUserSessionsView, nil)
+	return sessions, nil
+}
+
+func (s *AdminServiceImpl) ForceLogout(ctx context.Context, input domain.AdminUserParams) error {
+	user, err := s.getTarget(ctx, input.Actor, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.tokenSvc.RevokeAllUserSessions(ctx, user.ID); err != nil {
+		return pkg.OrInternalError(err)
+	}
+
+	s.audit(ctx, input.Actor, user.ID, domain.AuditUserLogout, nil)
+	return nil
+}
+
+func (s *AdminServiceImpl) Suspend(ctx context.Context, input domain.SuspendUserParams) (domain.AdminUserResponse, error) {
+	var empty domain.AdminUserResponse
+
+	if !input.Until.After(pkg.TimeNowUTC()) {
+		return empty, pkg.ErrInvalidData
+	}
+
+	user, err := s.getTarget(ctx, input.Actor, input.UserID)
+	if err != nil {
+		return empty, err
+	}
+	// A suspension would shorten a ban to its end.
+	if user.Status == domain.UserStatusBanned {
+		return empty, pkg.ErrConflict
+	}
+
+	until := input.Until.UTC()
+	if err := s.restrict(ctx, user, domain.UserStatusSuspended, &until); err != nil {
+		return empty, err
+	}
+
+	s.audit(ctx, input.Actor, user.ID, domain.AuditUserSuspend, map[string]any{
+		"reason": input.Reason,
+		"until":  until,
+	})
+	return s.mapUser(user), nil
+}
+
+func (s *AdminServiceImpl) Ban(ctx context.Context, input domain.ModerateUserParams) (domain.AdminUserResponse, error) {
+	var empty domain.AdminUserResponse
+
+	user, err := s.getTarget(ctx, input.Actor, input.UserID)
+	if err != nil {
+		return empty, err
+	}
+	if user.Status == domain.UserStatusBanned {
+		return empty, pkg.ErrConflict
+	}
+
+	if err := s.restrict(ctx, user, domain.UserStatusBanned, nil); err != nil {
+		return empty, err
+	}
+
+	s.audit(ctx, input.Actor, user.ID, domain.AuditUserBan, map[string]any{"reason": input.Reason})
+	return s.mapUser(user), nil
+}
+
+func (s *AdminServiceImpl) Unban(ctx context.Context, input domain.ModerateUserParams) (domain.AdminUserResponse, error) {
+	var empty domain.AdminUserResponse
+
+	user, err := s.getTarget(ctx, input.Actor, input.UserID)
+	if err != nil {
+		return empty, err
+	}
+	if user.EffectiveStatus(pkg.TimeNowUTC()) == domain.UserStatusActive {
+		return empty, pkg.ErrConflict
+	}
+
+	previous := user.Status
+	if err := s.userRepo.UpdateStatus(ctx, user.ID, domain.UserStatusActive, nil); err != nil {
+		return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	user.Status = domain.UserStatusActive
+	user.SuspendedUntil = nil
+
+	s.audit(ctx, input.Actor, user.ID, domain.AuditUserUnban, map[string]any{
+		"reason":   input.Reason,
+		"previous": previous,
+	})
+	return s.mapUser(user), nil
+}
+
+func (s *AdminServiceImpl) VerifyEmail(ctx context.Context, input domain.AdminUserParams) (domain.AdminUserResponse, error) {
+	var empty domain.AdminUserResponse
+
+	user, err := s.getTarget(ctx, input.Actor, input.UserID)
+	if err != nil {
+		return empty, err
+	}
+	if user.Verified {
+		return s.mapUser(user), nil
+	}
+
+	now := pkg.TimeNowUTC()
+	user.Verified = true
+	user.VerifiedAt = &now
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	s.audit(ctx, input.Actor, user.ID, domain.AuditUserVerifyEmail, map[string]any{"email": user.Email})
+	return s.mapUser(user), nil
+}
+
+func (s *AdminServiceImpl) ResetProfileImage(ctx context.Context, input domain.ResetProfileImageParams) (domain.AdminUserResponse, error) {
+	var empty domain.AdminUserResponse
+
+	user, err := s.getTarget(ctx, input.Actor, input.UserID)
+	if err != nil {
+		return empty, err
+	}
+
+	var image *string
+	switch input.Feature {
+	case domain.FeatureAvatar:
+		image = &user.Avatar
+	case domain.FeatureCover:
+		image = &user.CoverImage
+	default:
+		return empty, pkg.ErrInvalidData
+	}
+	if *image == "" {
+		return s.mapUser(user), nil
+	}
+
+	if err := s.userRepo.UpdateProfileImages(ctx, user.ID, "", input.Feature); err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+	oldKey := *image
+	*image = ""
+
+	if err := s.mediaSvc.DeleteFile(ctx, oldKey); err != nil {
+		pkg.Log().Errorw("[STORAGE ERROR]", "from", "admin_reset_image", "key", oldKey, "error", err)
+	}
+
+	s.audit(ctx, input.Actor, user.ID, domain.AuditUserResetImage, map[string]any{
+		"feature":    input.Feature,
+		"object_key": oldKey,
+	})
+	return s.mapUser(user), nil
+}
+
+func (s *AdminServiceImpl) ListAuditLogs(ctx context.Context, input domain.ListAuditLogsParams) (domain.Page, error) {
+	var empty domain.Page
+
+	beforeID, err := decodeIDCursor(input.Page.Cursor)
+	if err != nil {
+		return empty, err
+	}
+
+	limit := input.Page.Size()
+	entries, err := s.auditRepo.List(ctx, domain.AuditLogFilter{
+		ActorID:      input.ActorID,
+		TargetUserID: input.TargetUserID,
+		BeforeID:     beforeID,
+		Limit:        limit + 1,
+	})
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	items := make([]domain.AuditLogResponse, 0, len(entries))
+	for i := range entries {
+		items = append(items, entries[i].ToResponse())
+	}
+
+	return newPage(items, limit, func(e domain.AuditLogResponse) string {
+		return pkg.EncodeCursor(e.ID)
+	}), nil
+}
+
+// Internal helpers
+
+func (s *AdminServiceImpl) getUser(ctx context.Context, userID int64) (*domain.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	return user, nil
+}
+
+// getTarget loads the user an action changes. Staff may only act on users
+// below their own role, which also keeps them from acting on themselves.
+func (s *AdminServiceImpl) getTarget(ctx context.Context, actor domain.AdminActor, userID int64) (*domain.User, error) {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.Role.Outranks(user.Role) {
+		return nil, pkg.ErrForbidden
+	}
+	return user, nil
+}
+
+// restrict suspends or bans the user and signs them out of every device.
+func (s *AdminServiceImpl) restrict(ctx context.Context, user *domain.User, status domain.UserStatus, until *time.Time) error {
+	if err := s.userRepo.UpdateStatus(ctx, user.ID, status, until); err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	user.Status = status
+	user.SuspendedUntil = until
+
+	if err := s.tokenSvc.RevokeAllUserSessions(ctx, user.ID); err != nil {
+		return pkg.OrInternalError(err)
+	}
+	return nil
+}
+
+// audit records the action once it has taken effect. A failed write does not
+// undo the action; the entry is logged instead so it can be recovered.
+func (s *AdminServiceImpl) audit(ctx context.Context, actor domain.AdminActor, targetID int64, action domain.AuditAction, details map[string]any) {
+	entry := &domain.AuditLog{Action: action, ActorID: &actor.ID}
+	if targetID > 0 {
+		entry.TargetUserID = &targetID
+	}
+
+	raw, err := json.Marshal(details)
+	if err != nil || details == nil {
+		raw = []byte("{}")
+	}
+	entry.Details = raw
+
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		pkg.Log().Errorw("[AUDIT ERROR]", "from", "admin_audit",
+			"actor_id", actor.ID, "target_user_id", targetID, "action", action, "details", string(raw), "error", err)
+	}
+}
+
+func (s *AdminServiceImpl) mapUser(user *domain.User) domain.AdminUserResponse {
+	res := domain.AdminUserResponse{
+		UserResponse:   user.ToResponse(),
+		Status:         user.EffectiveStatus(pkg.TimeNowUTC()),
+		SuspendedUntil: user.SuspendedUntil,
+		DeleteAfter:    user.DeleteAfter,
+		VerifiedAt:     user.VerifiedAt,
+		UpdatedAt:      user.UpdatedAt,
+	}
+	if res.Status != domain.UserStatusSuspended {
+		res.SuspendedUntil = nil
+	}
+	if res.Avatar != "" {
+		res.Avatar = s.mediaSvc.GetPublicURL(res.Avatar)
+	}
+	if res.CoverImage != "" {
+		res.CoverImage = s.mediaSvc.GetPublicURL(res.CoverImage)
+	}
+	return res
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"air-social/internal/domain"
+	"air-social/internal/mocks"
+	"air-social/pkg"
+)
+
+type adminServiceSuite struct {
+	suite.Suite
+}
+
+func TestAdminServiceSuite(t *testing.T) {
+	suite.Run(t, new(adminServiceSuite))
+}
+
+type adminMocks struct {
+	user  *mocks.UserRepository
+	audit *mocks.AuditLogRepository
+	token *mocks.TokenService
+	media *mocks.MediaService
+}
+
+func (s *adminServiceSuite) newService() (*AdminServiceImpl, adminMocks) {
+	m := adminMocks{
+		user:  mocks.NewUserRepository(s.T()),
+		audit: mocks.NewAuditLogRepository(s.T()),
+		token: mocks.NewTokenService(s.T()),
+		media: mocks.NewMediaService(s.T()),
+	}
+	return NewAdminService(m.user, m.audit, m.token, m.media), m
+}
+
+var (
+	adminActor     = domain.AdminActor{ID: 1, Role: domain.UserRoleAdmin}
+	moderatorActor = domain.AdminActor{ID: 2, Role: domain.UserRoleModerator}
+)
+
+// audited matches the audit entry of action by actor on targetID.
+func audited(actor domain.AdminActor, targetID int64, action domain.AuditAction) any {
+	return mock.MatchedBy(func(e *domain.AuditLog) bool {
+		return e.Action == action && e.ActorID != nil && *e.ActorID == actor.ID &&
+			e.TargetUserID != nil && *e.TargetUserID == targetID
+	})
+}
+
+func (s *adminServiceSuite) TestSuspend() {
+	var userID int64 = 10
+	until := time.Now().Add(24 * time.Hour)
+
+	user := func(role domain.UserRole, status domain.UserStatus) *domain.User {
+		return &domain.User{ID: userID, Role: role, Status: status}
+	}
+
+	tests := []struct {
+		name      string
+		actor     domain.AdminActor
+		until     time.Time
+		setupMock func(m adminMocks)
+		wantErr   error
+	}{
+		{
+			name:    "until_in_past",
+			actor:   moderatorActor,
+			until:   time.Now().Add(-time.Minute),
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name:  "not_found",
+			actor: moderatorActor,
+			until: until,
+			setupMock: func(m adminMocks) {
+				m.user.EXPECT().GetByID(mock.Anything, userID).Return(nil, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name:  "moderator_cannot_suspend_moderator",
+			actor: moderatorActor,
+			until: until,
+			setupMock: func(m adminMocks) {
+				m.user.EXPECT().GetByID(mock.Anything, userID).
+					Return(user(domain.UserRoleModerator, domain.UserStatusActive), nil).Once()
+			},
+			wantErr: pkg.ErrForbidden,
+		},
+		{
+			name:  "banned",
+			actor: adminActor,
+			until: until,
+			setupMock: func(m adminMocks) {
+				m.user.EXPECT().GetByID(mock.Anything, userID).
+					Return(user(domain.UserRoleUser, domain.UserStatusBanned), nil).Once()
+			},
+			wantErr: pkg.ErrConflict,
+		},
+		{
+			name:  "success",
+			actor: moderatorActor,
+			until: until,
+			setupMock: func(m adminMocks) {
+				m.user.EXPECT().GetByID(mock.Anything, userID).
+					Return(user(domain.UserRoleUser, domain.UserStatusActive), nil).Once()
+				m.user.EXPECT().UpdateStatus(mock.Anything, userID, domain.UserStatusSuspended,
+					mock.MatchedBy(func(t *time.Time) bool { return t != nil && t.Equal(until) })).Return(nil).Once()
+				m.token.EXPECT().RevokeAllUserSessions(mock.Anything, userID).Return(nil).Once()
+				m.audit.EXPECT().Create(mock.Anything, audited(moderatorActor, userID, domain.AuditUserSuspend)).
+					Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			svc, m := s.newService()
+			if tc.setupMock != nil {
+				tc.setupMock(m)
+			}
+
+			got, err := svc.Suspend(context.Background(), domain.SuspendUserParams{
+				Actor:  tc.actor,
+				UserID: userID,
+				Until:  tc.until,
+				Reason: "spam",
+			})
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(s.T(), err, tc.wantErr)
+				return
+			}
+			assert.NoError(s.T(), err)
+			assert.Equal(s.T(), domain.UserStatusSuspended, got.Status)
+			assert.NotNil(s.T(), got.SuspendedUntil)
+		})
+	}
+}
+
+func (s *adminServiceSuite) TestBanAndUnban() {
+	var userID int64 = 10
+	expired := time.Now().Add(-time.Hour)
+
+	s.Run("ban_revokes_sessions", func() {
+		svc, m := s.newService()
+		m.user.EXPECT().GetByID(mock.Anything, userID).Return(&domain.User{ID: userID, Role: domain.UserRoleModerator}, nil).Once()
+		m.user.EXPECT().UpdateStatus(mock.Anything, userID, domain.UserStatusBanned, (*time.Time)(nil)).Return(nil).Once()
+		m.token.EXPECT().RevokeAllUserSessions(mock.Anything, userID).Return(nil).Once()
+		m.audit.EXPECT().Create(mock.Anything, mock.MatchedBy(func(e *domain.AuditLog) bool {
+			var details map[string]any
+			_ = json.Unmarshal(e.Details, &details)
+			return e.Action == domain.AuditUserBan && details["reason"] == "abuse"
+		})).Return(nil).Once()
+
+		got, err := svc.Ban(context.Background(), domain.ModerateUserParams{Actor: adminActor, UserID: userID, Reason: "abuse"})
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), domain.UserStatusBanned, got.Status)
+	})
+
+	s.Run("ban_already_banned", func() {
+		svc, m := s.newService()
+		m.user.EXPECT().GetByID(mock.Anything, userID).Return(&domain.User{ID: userID, Status: domain.UserStatusBanned}, nil).Once()
+
+		_, err := svc.Ban(context.Background(), domain.ModerateUserParams{Actor: adminActor, UserID: userID})
+
+		assert.ErrorIs(s.T(), err, pkg.ErrConflict)
+	})
+
+	s.Run("cannot_ban_self", func() {
+		svc, m := s.newService()
+		m.user.EXPECT().GetByID(mock.Anything, adminActor.ID).Return(&domain.User{ID: adminActor.ID, Role: domain.UserRoleAdmin}, nil).Once()
+
+		_, err := svc.Ban(context.Background(), domain.ModerateUserParams{Actor: adminActor, UserID: adminActor.ID})
+
+		assert.ErrorIs(s.T(), err, pkg.ErrForbidden)
+	})
+
+	s.Run("unban_expired_suspension", func() {
+		svc, m := s.newService()
+		m.user.EXPECT().GetByID(mock.Anything, userID).
+			Return(&domain.User{ID: userID, Status: domain.UserStatusSuspended, SuspendedUntil: &expired}, nil).Once()
+
+		_, err := svc.Unban(context.Background(), domain.ModerateUserParams{Actor: adminActor, UserID: userID})
+
+		assert.ErrorIs(s.T(), err, pkg.ErrConflict)
+	})
+
+	s.Run("unban", func() {
+		svc, m := s.newService()
+		m.user.EXPECT().GetByID(mock.Anything, userID).Return(&domain.User{ID: userID, Status: domain.UserStatusBanned}, nil).Once()
+		m.user.EXPECT().UpdateStatus(mock.Anything, userID, domain.UserStatusActive, (*time.Time)(nil)).Return(nil).Once()
+		m.audit.EXPECT().Create(mock.Anything, audited(adminActor, userID, domain.AuditUserUnban)).Return(nil).Once()
+
+		got, err := svc.Unban(context.Background(), domain.ModerateUserParams{Actor: adminActor, UserID: userID})
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), domain.UserStatusActive, got.Status)
+	})
+}
+
+func (s *adminServiceSuite) TestResetProfileImage() {
+	var userID int64 = 10
+
+	s.Run("removes_and_deletes_file", func() {
+		svc, m := s.newService()
+		user := &domain.User{ID: userID, Profile: domain.Profile{Avatar: "users/avatar/a.png", CoverImage: "users/cover/c.png"}}
+		m.user.EXPECT().GetByID(mock.Anything, userID).Return(user, nil).Once()
+		m.user.EXPECT().UpdateProfileImages(mock.Anything, userID, "", domain.FeatureAvatar).Return(nil).Once()
+		m.media.EXPECT().DeleteFile(mock.Anything, "users/avatar/a.png").Return(assert.AnError).Once()
+		m.media.EXPECT().GetPublicURL("users/cover/c.png").Return("http://cdn/c.png").Once()
+		m.audit.EXPECT().Create(mock.Anything, audited(moderatorActor, userID, domain.AuditUserResetImage)).Return(nil).Once()
+
+		got, err := svc.ResetProfileImage(context.Background(), domain.ResetProfileImageParams{
+			Actor: moderatorActor, UserID: userID, Feature: domain.FeatureAvatar,
+		})
+
+		assert.NoError(s.T(), err)
+		assert.Empty(s.T(), got.Avatar)
+		assert.Equal(s.T(), "http://cdn/c.png", got.CoverImage)
+	})
+
+	s.Run("nothing_to_reset", func() {
+		svc, m := s.newService()
+		m.user.EXPECT().GetByID(mock.Anything, userID).Return(&domain.User{ID: userID}, nil).Once()
+
+		_, err := svc.ResetProfileImage(context.Background(), domain.ResetProfileImageParams{
+			Actor: moderatorActor, UserID: userID, Feature: domain.FeatureCover,
+		})
+
+		assert.NoError(s.T(), err)
+	})
+}
+
+func (s *adminServiceSuite) TestSearchUsers() {
+	svc, m := s.newService()
+	users := []domain.User{{ID: 3}, {ID: 2}, {ID: 1}}
+
+	m.user.EXPECT().Search(mock.Anything, domain.UserSearchFilter{
+		Query:  "bob",
+		Status: domain.UserStatusBanned,
+		Limit:  3,
+	}).Return(users, nil).Once()
+	// A failed audit write is logged but does not fail the request.
+	m.audit.EXPECT().Create(mock.Anything, mock.MatchedBy(func(e *domain.AuditLog) bool {
+		return e.Action == domain.AuditUsersSearch && e.TargetUserID == nil
+	})).Return(assert.AnError).Once()
+
+	page, err := svc.SearchUsers(context.Background(), domain.AdminSearchUsersParams{
+		Actor:  adminActor,
+		Query:  "bob",
+		Status: domain.UserStatusBanned,
+		Page:   domain.PageParams{Limit: 2},
+	})
+
+	s.Require().NoError(err)
+	assert.True(s.T(), page.HasMore)
+	assert.Equal(s.T(), pkg.EncodeCursor(2), page.NextCursor)
+	assert.Len(s.T(), page.Items, 2)
+}
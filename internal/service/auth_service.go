@@ -2,46 +2,103 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
 	"errors"
+	"fmt"
+	mathrand "math/rand/v2"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 
+	"air-social/internal/config"
 	"air-social/internal/domain"
 	"air-social/internal/infrastructure/rabbitmq"
 	"air-social/pkg"
 )
 
+const (
+	oauthUsernameAttempts = 3
+	// The username leaves room for the number added when it is taken.
+	maxOAuthUsernameLen = 26
+	minOAuthUsernameLen = 3
+)
+
 type AuthService interface {
 	Register(ctx context.Context, input domain.RegisterParams) (domain.UserResponse, error)
 	Logout(ctx context.Context, input domain.LogoutParams) error
 	Login(ctx context.Context, input domain.LoginParams) (domain.LoginResponse, error)
+	// VerifyTwoFactor completes a login that Login answered with a challenge.
+	VerifyTwoFactor(ctx context.Context, input domain.VerifyTwoFactorParams) (domain.LoginResponse, error)
+	// StartOAuth begins a login with an external provider and returns where
+	// to send the user.
+	StartOAuth(ctx context.Context, provider string) (domain.OAuthAuthorizeResponse, error)
+	// OAuthLogin completes it with the code the provider sent back, signing
+	// up or linking the account on first use.
+	OAuthLogin(ctx context.Context, input domain.OAuthLoginParams) (domain.LoginResponse, error)
+	// RequestMagicLink emails a sign-in link to the user of the email, if
+	// any. It replaces the links sent before.
+	RequestMagicLink(ctx context.Context, input domain.MagicLinkParams) error
+	// MagicLinkLogin signs in with the token of the link, once.
+	MagicLinkLogin(ctx context.Context, input domain.MagicLinkLoginParams) (domain.LoginResponse, error)
 
 	ForgotPassword(ctx context.Context, email string) error
 	ResetPassword(ctx context.Context, input domain.ResetPasswordParams) error
 	IsResetPasswordTokenValid(ctx context.Context, token string) bool
 
-	RefreshToken(ctx context.Context, refreshToken string) (domain.TokenInfo, error)
+	RefreshToken(ctx context.Context, input domain.RefreshParams) (domain.TokenInfo, error)
 	VerifyEmail(ctx context.Context, emailToken string) error
+	// ResendVerification sends the user another verification email.
+	ResendVerification(ctx context.Context, userID int64) error
+	// RequestEmailChange asks the new address to confirm the change and tells
+	// the old one how to cancel it. It replaces the change asked before.
+	RequestEmailChange(ctx context.Context, input domain.ChangeEmailParams) error
+	ConfirmEmailChange(ctx context.Context, token string) error
+	CancelEmailChange(ctx context.Context, token string) error
+	UnlockAccount(ctx context.Context, unlockToken string) error
 }
 
 type AuthServiceImpl struct {
-	userSvc  UserService
-	tokenSvc TokenService
-	url      domain.URLFactory
-	cache    domain.CacheStorage
-	event    domain.EventPublisher
+	userSvc      UserService
+	tokenSvc     TokenService
+	twoFactorSvc TwoFactorService
+	providers    domain.OAuthProviders
+	url          domain.URLFactory
+	cache        domain.CacheStorage
+	event        domain.EventPublisher
+	attempts     domain.LoginAttemptStore
+	lockout      config.LockoutConfig
+	twoFactor    config.TwoFactorConfig
 }
 
-func NewAuthService(userSvc UserService, tokenSvc TokenService, url domain.URLFactory, event domain.EventPublisher, cache domain.CacheStorage) *AuthServiceImpl {
+func NewAuthService(
+	userSvc UserService,
+	tokenSvc TokenService,
+	twoFactorSvc TwoFactorService,
+	providers domain.OAuthProviders,
+	url domain.URLFactory,
+	event domain.EventPublisher,
+	cache domain.CacheStorage,
+	attempts domain.LoginAttemptStore,
+	lockout config.LockoutConfig,
+	twoFactor config.TwoFactorConfig,
+) *AuthServiceImpl {
 	return &AuthServiceImpl{
-		userSvc:  userSvc,
-		tokenSvc: tokenSvc,
-		url:      url,
-		event:    event,
-		cache:    cache,
+		userSvc:      userSvc,
+		tokenSvc:     tokenSvc,
+		twoFactorSvc: twoFactorSvc,
+		providers:    providers,
+		url:          url,
+		event:        event,
+		cache:        cache,
+		attempts:     attempts,
+		lockout:      lockout,
+		twoFactor:    twoFactor,
 	}
 }
 
@@ -73,34 +130,230 @@ func (s *AuthServiceImpl) Logout(ctx context.Context, input domain.LogoutParams)
 		err = s.tokenSvc.RevokeAllUserSessions(ctx, input.UserID)
 	} else {
 		err = s.tokenSvc.RevokeDeviceSession(ctx, input.UserID, input.DeviceID)
+		if err == nil {
+			err = s.tokenSvc.RevokeAccessToken(ctx, input.TokenID, input.TokenExpiresAt)
+		}
 	}
 	return pkg.OrInternalError(err)
 }
 
 func (s *AuthServiceImpl) Login(ctx context.Context, input domain.LoginParams) (domain.LoginResponse, error) {
 	var empty domain.LoginResponse
+	email := normalizeEmail(input.Email)
+
+	if err := s.checkLoginThrottle(ctx, email, input.IP); err != nil {
+		return empty, err
+	}
 
 	user, err := s.userSvc.GetByEmail(ctx, input.Email)
 	if err != nil {
 		if errors.Is(err, pkg.ErrNotFound) {
+			s.failLogin(ctx, nil, email, input.IP)
 			return empty, pkg.ErrInvalidCredentials
 		}
 		return empty, err
 	}
 
 	if !verifyPassword(input.Password, user.PasswordHash) {
+		s.failLogin(ctx, user, email, input.IP)
 		return empty, pkg.ErrInvalidCredentials
 	}
 
-	tokens, err := s.tokenSvc.CreateSession(ctx, user.ID, input.DeviceID)
+	// Checked only once the password matched, so the status of an account
+	// is not revealed to whoever guesses its email.
+	if user.EffectiveStatus(pkg.TimeNowUTC()) != domain.UserStatusActive {
+		return empty, pkg.ErrAccountDisabled
+	}
+
+	return s.completeLogin(ctx, user, email, domain.SessionClient{
+		DeviceID:   input.DeviceID,
+		DeviceName: input.DeviceName,
+		UserAgent:  input.UserAgent,
+		IP:         input.IP,
+	})
+}
+
+func (s *AuthServiceImpl) VerifyTwoFactor(ctx context.Context, input domain.VerifyTwoFactorParams) (domain.LoginResponse, error) {
+	var empty domain.LoginResponse
+
+	key := domain.GetTwoFactorChallengeKey(input.ChallengeToken)
+	var login domain.TwoFactorLogin
+	if err := s.cache.Get(ctx, key, &login); err != nil {
+		if errors.Is(err, pkg.ErrNotFound) {
+			return empty, pkg.ErrUnauthorized
+		}
+		return empty, pkg.OrInternalError(err)
+	}
+
+	if err := s.checkLoginThrottle(ctx, login.Email, login.IP); err != nil {
+		return empty, err
+	}
+
+	user, err := s.userSvc.GetByID(ctx, login.UserID)
 	if err != nil {
+		if errors.Is(err, pkg.ErrNotFound) {
+			return empty, pkg.ErrUnauthorized
+		}
 		return empty, pkg.OrInternalError(err)
 	}
 
-	userResponse := user.ToResponse()
-	s.userSvc.ResolveMediaURLs(&userResponse)
+	if err := s.twoFactorSvc.Verify(ctx, user.ID, input.Code); err != nil {
+		if errors.Is(err, pkg.ErrInvalidTwoFactorCode) {
+			s.failTwoFactorLogin(ctx, key, login)
+			s.failLogin(ctx, user, login.Email, login.IP)
+		}
+		return empty, pkg.OrInternalError(err, pkg.ErrInvalidTwoFactorCode)
+	}
 
-	return domain.LoginResponse{User: userResponse, Token: tokens}, nil
+	if err := s.cache.Delete(ctx, key); err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "two_factor_challenge", "error", err)
+	}
+
+	// The account may have been disabled while the challenge was open.
+	if user.EffectiveStatus(pkg.TimeNowUTC()) != domain.UserStatusActive {
+		return empty, pkg.ErrAccountDisabled
+	}
+
+	return s.startSession(ctx, user, login.Email, domain.SessionClient{
+		DeviceID:   login.DeviceID,
+		DeviceName: login.DeviceName,
+		UserAgent:  login.UserAgent,
+		IP:         login.IP,
+	})
+}
+
+func (s *AuthServiceImpl) StartOAuth(ctx context.Context, provider string) (domain.OAuthAuthorizeResponse, error) {
+	var empty domain.OAuthAuthorizeResponse
+
+	p, ok := s.providers[provider]
+	if !ok {
+		return empty, pkg.ErrNotFound
+	}
+
+	verifier, challenge, err := newPKCE()
+	if err != nil {
+		return empty, pkg.ErrInternal
+	}
+	state := domain.OAuthState{Provider: p.Name(), CodeVerifier: verifier, Nonce: uuid.NewString()}
+	stateToken := uuid.NewString()
+
+	if err := s.cache.Set(ctx, domain.GetOAuthStateKey(stateToken), state, domain.TenMinutesTime); err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	authURL, err := p.AuthCodeURL(ctx, stateToken, state.Nonce, challenge)
+	if err != nil {
+		pkg.Log().Errorw("[OAUTH ERROR]", "from", "oauth_start", "provider", provider, "error", err)
+		return empty, pkg.ErrProviderUnavailable
+	}
+
+	return domain.OAuthAuthorizeResponse{AuthorizationURL: authURL, State: stateToken}, nil
+}
+
+func (s *AuthServiceImpl) OAuthLogin(ctx context.Context, input domain.OAuthLoginParams) (domain.LoginResponse, error) {
+	var empty domain.LoginResponse
+
+	p, ok := s.providers[input.Provider]
+	if !ok {
+		return empty, pkg.ErrNotFound
+	}
+
+	key := domain.GetOAuthStateKey(input.State)
+	var state domain.OAuthState
+	if err := s.cache.Get(ctx, key, &state); err != nil {
+		if errors.Is(err, pkg.ErrNotFound) {
+			return empty, pkg.ErrUnauthorized
+		}
+		return empty, pkg.OrInternalError(err)
+	}
+	// The state works once, so a leaked callback URL cannot be replayed.
+	if err := s.cache.Delete(ctx, key); err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "oauth_state", "error", err)
+	}
+	if state.Provider != p.Name() {
+		return empty, pkg.ErrUnauthorized
+	}
+
+	claims, err := p.Exchange(ctx, input.Code, state.CodeVerifier)
+	if err != nil {
+		if errors.Is(err, pkg.ErrUnauthorized) {
+			return empty, pkg.ErrUnauthorized
+		}
+		pkg.Log().Errorw("[OAUTH ERROR]", "from", "oauth_exchange", "provider", input.Provider, "error", err)
+		return empty, pkg.ErrProviderUnavailable
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(state.Nonce)) != 1 {
+		return empty, pkg.ErrUnauthorized
+	}
+
+	user, err := s.oauthUser(ctx, p.Name(), claims)
+	if err != nil {
+		return empty, err
+	}
+
+	if user.EffectiveStatus(pkg.TimeNowUTC()) != domain.UserStatusActive {
+		return empty, pkg.ErrAccountDisabled
+	}
+
+	return s.completeLogin(ctx, user, normalizeEmail(user.Email), domain.SessionClient{
+		DeviceID:   input.DeviceID,
+		DeviceName: input.DeviceName,
+		UserAgent:  input.UserAgent,
+		IP:         input.IP,
+	})
+}
+
+func (s *AuthServiceImpl) RequestMagicLink(ctx context.Context, input domain.MagicLinkParams) error {
+	user, err := s.userSvc.GetByEmail(ctx, input.Email)
+	if err != nil {
+		return err
+	}
+	if user.EffectiveStatus(pkg.TimeNowUTC()) != domain.UserStatusActive {
+		return pkg.ErrAccountDisabled
+	}
+
+	s.sendEmailMagicLink(ctx, user.Username, domain.MagicLink{
+		Email:      user.Email,
+		DeviceID:   input.DeviceID,
+		DeviceName: input.DeviceName,
+	})
+	return nil
+}
+
+func (s *AuthServiceImpl) MagicLinkLogin(ctx context.Context, input domain.MagicLinkLoginParams) (domain.LoginResponse, error) {
+	var empty domain.LoginResponse
+
+	link, err := s.useMagicLink(ctx, input.Token)
+	if err != nil {
+		if errors.Is(err, pkg.ErrNotFound) {
+			return empty, pkg.ErrUnauthorized
+		}
+		return empty, pkg.OrInternalError(err)
+	}
+
+	user, err := s.userSvc.GetByEmail(ctx, link.Email)
+	if err != nil {
+		return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	if user.EffectiveStatus(pkg.TimeNowUTC()) != domain.UserStatusActive {
+		return empty, pkg.ErrAccountDisabled
+	}
+
+	// Following the link proves the address as well as the verification
+	// link would.
+	if !user.Verified {
+		if err := s.userSvc.VerifyEmail(ctx, user.Email); err != nil {
+			return empty, pkg.OrInternalError(err)
+		}
+		user.Verified = true
+	}
+
+	return s.completeLogin(ctx, user, normalizeEmail(user.Email), domain.SessionClient{
+		DeviceID:   link.DeviceID,
+		DeviceName: link.DeviceName,
+		UserAgent:  input.UserAgent,
+		IP:         input.IP,
+	})
 }
 
 func (s *AuthServiceImpl) ForgotPassword(ctx context.Context, email string) error {
@@ -149,10 +402,106 @@ func (s *AuthServiceImpl) VerifyEmail(ctx context.Context, emailToken string) er
 	return pkg.OrInternalError(err)
 }
 
-func (s *AuthServiceImpl) RefreshToken(ctx context.Context, refreshToken string) (domain.TokenInfo, error) {
+func (s *AuthServiceImpl) ResendVerification(ctx context.Context, userID int64) error {
+	user, err := s.userSvc.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.Verified {
+		return pkg.ErrAlreadyVerified
+	}
+
+	s.sendEmailVerification(ctx, user.Email, user.Username)
+	return nil
+}
+
+func (s *AuthServiceImpl) RequestEmailChange(ctx context.Context, input domain.ChangeEmailParams) error {
+	user, err := s.userSvc.GetByID(ctx, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	if !verifyPassword(input.CurrentPassword, user.PasswordHash) {
+		return pkg.ErrInvalidCredentials
+	}
+	if normalizeEmail(input.NewEmail) == normalizeEmail(user.Email) {
+		return pkg.ErrSameEmail
+	}
+
+	// The swap checks again, this only spares sending a link that cannot work.
+	_, err = s.userSvc.GetByEmail(ctx, input.NewEmail)
+	if err == nil {
+		return pkg.ErrEmailTaken
+	}
+	if !errors.Is(err, pkg.ErrNotFound) {
+		return err
+	}
+
+	change := domain.EmailChange{
+		OldEmail:     user.Email,
+		NewEmail:     input.NewEmail,
+		ConfirmToken: uuid.NewString(),
+		CancelToken:  uuid.NewString(),
+	}
+	ttl := domain.ThirtyMinutesTime
+	if err := s.storeEmailChange(ctx, user.ID, change, ttl); err != nil {
+		return pkg.OrInternalError(err)
+	}
+
+	s.sendEmailChange(ctx, user.Username, change, ttl)
+	return nil
+}
+
+func (s *AuthServiceImpl) ConfirmEmailChange(ctx context.Context, token string) error {
+	userID, change, err := s.getEmailChange(ctx, token)
+	if err != nil || subtle.ConstantTimeCompare([]byte(change.ConfirmToken), []byte(token)) != 1 {
+		return pkg.ErrBadRequest
+	}
+
+	if err := s.userSvc.ChangeEmail(ctx, userID, change.OldEmail, change.NewEmail); err != nil {
+		if errors.Is(err, pkg.ErrNotFound) {
+			return pkg.ErrBadRequest
+		}
+		return err
+	}
+
+	s.clearEmailChange(ctx, userID, change)
+	return nil
+}
+
+func (s *AuthServiceImpl) CancelEmailChange(ctx context.Context, token string) error {
+	userID, change, err := s.getEmailChange(ctx, token)
+	if err != nil || subtle.ConstantTimeCompare([]byte(change.CancelToken), []byte(token)) != 1 {
+		return pkg.ErrBadRequest
+	}
+
+	s.clearEmailChange(ctx, userID, change)
+	return nil
+}
+
+func (s *AuthServiceImpl) UnlockAccount(ctx context.Context, unlockToken string) error {
+	email, err := s.getAccountUnlock(ctx, unlockToken)
+	if err != nil {
+		return pkg.ErrBadRequest
+	}
+
+	if err := s.attempts.Reset(ctx, email, ""); err != nil {
+		return pkg.OrInternalError(err)
+	}
+
+	if err := s.cache.Delete(ctx, domain.GetAccountUnlockKey(unlockToken)); err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "account_unlock", "error", err)
+	}
+	return nil
+}
+
+func (s *AuthServiceImpl) RefreshToken(ctx context.Context, input domain.RefreshParams) (domain.TokenInfo, error) {
 	var empty domain.TokenInfo
 
-	tokens, err := s.tokenSvc.Refresh(ctx, refreshToken)
+	tokens, err := s.tokenSvc.Refresh(ctx, input.RefreshToken, domain.SessionClient{
+		UserAgent: input.UserAgent,
+		IP:        input.IP,
+	})
 	if err != nil {
 		return empty, pkg.OrInternalError(err, pkg.ErrUnauthorized)
 	}
@@ -161,6 +510,192 @@ func (s *AuthServiceImpl) RefreshToken(ctx context.Context, refreshToken string)
 
 // Internal helpers
 
+// completeLogin signs in a user whose first factor passed, or asks for the
+// second one when the account has 2FA on.
+func (s *AuthServiceImpl) completeLogin(ctx context.Context, user *domain.User, email string, client domain.SessionClient) (domain.LoginResponse, error) {
+	enabled, err := s.twoFactorSvc.IsEnabled(ctx, user.ID)
+	if err != nil {
+		return domain.LoginResponse{}, pkg.OrInternalError(err)
+	}
+	if enabled {
+		challenge, err := s.startTwoFactorLogin(ctx, user, email, client)
+		if err != nil {
+			return domain.LoginResponse{}, pkg.OrInternalError(err)
+		}
+		return domain.LoginResponse{TwoFactor: &challenge}, nil
+	}
+
+	return s.startSession(ctx, user, email, client)
+}
+
+// startSession signs the user in once every login step has passed.
+func (s *AuthServiceImpl) startSession(ctx context.Context, user *domain.User, email string, client domain.SessionClient) (domain.LoginResponse, error) {
+	// Signing in during the grace period keeps a deleted account.
+	if user.DeleteAfter != nil {
+		if err := s.userSvc.CancelDeletion(ctx, user.ID); err != nil {
+			// Purged while we were signing them in.
+			if errors.Is(err, pkg.ErrNotFound) {
+				return domain.LoginResponse{}, pkg.ErrInvalidCredentials
+			}
+			return domain.LoginResponse{}, pkg.OrInternalError(err)
+		}
+		user.DeleteAfter = nil
+	}
+
+	tokens, err := s.tokenSvc.CreateSession(ctx, user.ID, user.Role, client)
+	if err != nil {
+		return domain.LoginResponse{}, pkg.OrInternalError(err)
+	}
+
+	// Only the account is cleared: the IP count runs out with its window, or
+	// whoever owns one account could reset it between guesses at others.
+	if err := s.attempts.Reset(ctx, email, ""); err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "login_attempts_reset", "error", err)
+	}
+
+	userResponse := user.ToResponse()
+	s.userSvc.ResolveMediaURLs(&userResponse)
+
+	return domain.LoginResponse{User: userResponse, Token: tokens}, nil
+}
+
+// startTwoFactorLogin parks a login whose password matched until the second
+// step, under a random challenge token.
+func (s *AuthServiceImpl) startTwoFactorLogin(ctx context.Context, user *domain.User, email string, client domain.SessionClient) (domain.TwoFactorChallenge, error) {
+	token := uuid.NewString()
+	ttl := s.twoFactor.ChallengeTTL
+
+	login := domain.TwoFactorLogin{
+		UserID:     user.ID,
+		Email:      email,
+		DeviceID:   client.DeviceID,
+		DeviceName: client.DeviceName,
+		UserAgent:  client.UserAgent,
+		IP:         client.IP,
+		ExpiresAt:  pkg.TimeNowUTC().Add(ttl),
+	}
+	if err := s.cache.Set(ctx, domain.GetTwoFactorChallengeKey(token), login, ttl); err != nil {
+		return domain.TwoFactorChallenge{}, err
+	}
+
+	return domain.TwoFactorChallenge{ChallengeToken: token, ExpiresIn: int64(ttl.Seconds())}, nil
+}
+
+// failTwoFactorLogin counts a wrong code against the challenge, which ends
+// after MaxAttempts of them and never outlives its first expiry.
+func (s *AuthServiceImpl) failTwoFactorLogin(ctx context.Context, key string, login domain.TwoFactorLogin) {
+	login.Attempts++
+	ttl := time.Until(login.ExpiresAt)
+
+	var err error
+	if login.Attempts >= s.twoFactor.MaxAttempts || ttl <= 0 {
+		err = s.cache.Delete(ctx, key)
+	} else {
+		err = s.cache.Set(ctx, key, login, ttl)
+	}
+	if err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "two_factor_attempts", "error", err)
+	}
+}
+
+// oauthUser returns the account of the provider's user: the one linked to
+// them, else the one with their verified email, which is then linked, else a
+// new one.
+func (s *AuthServiceImpl) oauthUser(ctx context.Context, provider string, claims domain.OAuthClaims) (*domain.User, error) {
+	user, err := s.userSvc.GetByIdentity(ctx, provider, claims.Subject)
+	if err == nil {
+		return user, nil
+	}
+	if !errors.Is(err, pkg.ErrNotFound) {
+		return nil, err
+	}
+
+	// Only an address the provider vouches for may claim or open an account.
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, pkg.ErrEmailNotVerified
+	}
+	identity := domain.UserIdentity{Provider: provider, Subject: claims.Subject, Email: claims.Email}
+
+	user, err = s.userSvc.GetByEmail(ctx, claims.Email)
+	if err == nil {
+		// Whoever registered an address they never verified must not get
+		// the provider account of its real owner linked to theirs.
+		if !user.Verified {
+			return nil, pkg.ErrAccountNotLinked
+		}
+		identity.UserID = user.ID
+		if err := s.userSvc.LinkIdentity(ctx, identity); err != nil {
+			return nil, pkg.OrInternalError(err, pkg.ErrAlreadyExists)
+		}
+		return user, nil
+	}
+	if !errors.Is(err, pkg.ErrNotFound) {
+		return nil, err
+	}
+
+	return s.createOAuthUser(ctx, claims, identity)
+}
+
+// createOAuthUser signs up the provider's user with a random password, which
+// they can replace through ForgotPassword. A taken username gets a number.
+func (s *AuthServiceImpl) createOAuthUser(ctx context.Context, claims domain.OAuthClaims, identity domain.UserIdentity) (*domain.User, error) {
+	passwordHashed, err := hashPassword(uuid.NewString())
+	if err != nil {
+		return nil, pkg.ErrInternal
+	}
+
+	base := oauthUsername(claims)
+	for attempt := range oauthUsernameAttempts {
+		username := base
+		if attempt > 0 {
+			username = fmt.Sprintf("%s%04d", base, mathrand.IntN(10000))
+		}
+
+		user, err := s.userSvc.CreateUserWithIdentity(ctx, domain.CreateUserParams{
+			Email:          claims.Email,
+			Username:       username,
+			PasswordHashed: passwordHashed,
+			Verified:       true,
+		}, identity)
+		if errors.Is(err, pkg.ErrAlreadyExists) {
+			continue
+		}
+		return user, pkg.OrInternalError(err)
+	}
+	return nil, pkg.ErrConflict
+}
+
+// oauthUsername derives a username from the preferred username or email of
+// the user, keeping letters and digits.
+func oauthUsername(claims domain.OAuthClaims) string {
+	name := claims.PreferredUsername
+	if name == "" {
+		name, _, _ = strings.Cut(claims.Email, "@")
+	}
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) && b.Len() < maxOAuthUsernameLen {
+			b.WriteRune(r)
+		}
+	}
+	if b.Len() < minOAuthUsernameLen {
+		return "user"
+	}
+	return b.String()
+}
+
+// newPKCE returns a code verifier and its S256 challenge (RFC 7636).
+func newPKCE() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	verifier := base64.RawURLEncoding.EncodeToString(b)
+	sum := sha256.Sum256([]byte(verifier))
+	return verifier, base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
 // hashPassword generates a bcrypt hash of the password using the default cost.
 //
 // To circumvent bcrypt's 72-byte input truncation limit, the password is
@@ -179,6 +714,85 @@ func verifyPassword(plainPassword, hashPassword string) bool {
 	return err == nil
 }
 
+// normalizeEmail gives the login attempts of an address one identity however
+// it is typed.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// checkLoginThrottle refuses logins of locked accounts and of emails or IPs
+// still waiting out the delay of earlier failures. Logins go through when the
+// store fails, so an outage of Redis does not lock everybody out.
+func (s *AuthServiceImpl) checkLoginThrottle(ctx context.Context, email, ip string) error {
+	throttle, err := s.attempts.Throttle(ctx, email, ip)
+	if err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "login_throttle", "error", err)
+		return nil
+	}
+
+	if throttle.Locked > 0 {
+		return pkg.ErrAccountLocked
+	}
+	if throttle.Delayed > 0 {
+		return pkg.ErrTooManyRequests
+	}
+	return nil
+}
+
+// failLogin counts a failed login and throttles the email and IP it came
+// from. user is nil when no account has the email, which is throttled the
+// same way so the responses do not tell whether it exists.
+func (s *AuthServiceImpl) failLogin(ctx context.Context, user *domain.User, email, ip string) {
+	attempts, err := s.attempts.Fail(ctx, email, ip, s.lockout.Window)
+	if err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "login_attempts", "error", err)
+		return
+	}
+
+	if s.lockout.IPMaxFailures > 0 && attempts.IPFailures >= s.lockout.IPMaxFailures {
+		if err := s.attempts.Delay(ctx, "", ip, s.lockout.Duration); err != nil {
+			pkg.Log().Errorw("[CACHE ERROR]", "from", "login_ip_block", "error", err)
+		}
+	}
+
+	if s.lockout.MaxFailures > 0 && attempts.EmailFailures >= s.lockout.MaxFailures {
+		s.lockAccount(ctx, user, email)
+		return
+	}
+
+	if d := loginDelay(s.lockout, attempts.EmailFailures); d > 0 {
+		if err := s.attempts.Delay(ctx, email, "", d); err != nil {
+			pkg.Log().Errorw("[CACHE ERROR]", "from", "login_delay", "error", err)
+		}
+	}
+}
+
+// loginDelay is BaseDelay for the first failure past DelayAfter, doubling
+// with every further failure up to MaxDelay.
+func loginDelay(cfg config.LockoutConfig, failures int) time.Duration {
+	n := failures - cfg.DelayAfter
+	if n <= 0 || cfg.BaseDelay <= 0 {
+		return 0
+	}
+
+	d := cfg.BaseDelay
+	for i := 1; i < n && d < cfg.MaxDelay; i++ {
+		d *= 2
+	}
+	return min(d, cfg.MaxDelay)
+}
+
+func (s *AuthServiceImpl) lockAccount(ctx context.Context, user *domain.User, email string) {
+	if err := s.attempts.Lock(ctx, email, s.lockout.Duration); err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "login_lock", "error", err)
+		return
+	}
+
+	if user != nil {
+		s.sendEmailAccountLocked(ctx, user.Email, user.Username, email)
+	}
+}
+
 // sendEmailVerification sends an email verification event to the event publisher.
 func (s *AuthServiceImpl) sendEmailVerification(ctx context.Context, email, username string) {
 	id := uuid.NewString()
@@ -262,3 +876,191 @@ func (s *AuthServiceImpl) getEmailResetPassword(ctx context.Context, token strin
 	}
 	return email, nil
 }
+
+// sendEmailAccountLocked tells the owner of a locked account, with a link
+// that unlocks the account of key, the email its attempts are counted by.
+func (s *AuthServiceImpl) sendEmailAccountLocked(ctx context.Context, email, username, key string) {
+	id := uuid.NewString()
+	ttl := s.lockout.Duration
+
+	if err := s.storeAccountUnlock(ctx, id, key, ttl); err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "email_account_locked", "error", err)
+		return
+	}
+
+	data := domain.EventEmailData{
+		Email:  email,
+		Name:   username,
+		Link:   s.url.UnlockAccountLink(id),
+		Expiry: pkg.FormatTTLVerbose(ttl),
+	}
+
+	payload := domain.EventPayload{
+		EventID:   uuid.NewString(),
+		EventType: domain.EmailAccountLocked,
+		Timestamp: pkg.TimeNowUTC(),
+		Data:      data,
+	}
+
+	if err := s.event.Publish(ctx, rabbitmq.EmailAccountLockedQueueConfig.RoutingKey, payload); err != nil {
+		pkg.Log().Errorw("[EVENT QUEUE ERROR]", "from", "email_account_locked", "error", err)
+	}
+}
+
+// sendEmailMagicLink sends a sign-in link for link, after voiding the one
+// sent before so that only the newest works.
+func (s *AuthServiceImpl) sendEmailMagicLink(ctx context.Context, username string, link domain.MagicLink) {
+	id := uuid.NewString()
+	ttl := domain.FifteenMinutesTime
+
+	if err := s.storeMagicLink(ctx, id, link, ttl); err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "email_magic_link", "error", err)
+		return
+	}
+
+	data := domain.EventEmailData{
+		Email:  link.Email,
+		Name:   username,
+		Link:   s.url.MagicLoginLink(id),
+		Expiry: pkg.FormatTTLVerbose(ttl),
+	}
+	payload := domain.EventPayload{
+		EventID:   id,
+		EventType: domain.EmailMagicLink,
+		Timestamp: pkg.TimeNowUTC(),
+		Data:      data,
+	}
+
+	if err := s.event.Publish(ctx, rabbitmq.EmailMagicLinkQueueConfig.RoutingKey, payload); err != nil {
+		pkg.Log().Errorw("[EVENT QUEUE ERROR]", "from", "email_magic_link", "error", err)
+	}
+}
+
+func (s *AuthServiceImpl) storeMagicLink(ctx context.Context, token string, link domain.MagicLink, ttl time.Duration) error {
+	tokenKey := domain.GetMagicLinkTokenKey(normalizeEmail(link.Email))
+
+	var previous string
+	err := s.cache.Get(ctx, tokenKey, &previous)
+	if err != nil && !errors.Is(err, pkg.ErrNotFound) {
+		return err
+	}
+	if previous != "" {
+		if err := s.cache.Delete(ctx, domain.GetMagicLinkKey(previous)); err != nil {
+			return err
+		}
+	}
+
+	if err := s.cache.Set(ctx, domain.GetMagicLinkKey(token), link, ttl); err != nil {
+		return err
+	}
+	return s.cache.Set(ctx, tokenKey, token, ttl)
+}
+
+// useMagicLink returns the link of token and voids it. A link replaced by a
+// newer one is not found, even if its deletion failed.
+func (s *AuthServiceImpl) useMagicLink(ctx context.Context, token string) (domain.MagicLink, error) {
+	var link domain.MagicLink
+	key := domain.GetMagicLinkKey(token)
+	if err := s.cache.Get(ctx, key, &link); err != nil {
+		return link, err
+	}
+	if err := s.cache.Delete(ctx, key); err != nil {
+		return link, err
+	}
+
+	tokenKey := domain.GetMagicLinkTokenKey(normalizeEmail(link.Email))
+	var current string
+	if err := s.cache.Get(ctx, tokenKey, &current); err != nil {
+		return link, err
+	}
+	if subtle.ConstantTimeCompare([]byte(current), []byte(token)) != 1 {
+		return link, pkg.ErrNotFound
+	}
+	if err := s.cache.Delete(ctx, tokenKey); err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "magic_link", "error", err)
+	}
+	return link, nil
+}
+
+// sendEmailChange sends the confirm link of change to the new address and
+// the cancel link to the old one.
+func (s *AuthServiceImpl) sendEmailChange(ctx context.Context, username string, change domain.EmailChange, ttl time.Duration) {
+	events := []struct {
+		eventType  domain.EventType
+		routingKey string
+		data       domain.EventEmailData
+	}{
+		{domain.EmailChangeConfirm, rabbitmq.EmailChangeConfirmRoutingKey, domain.EventEmailData{
+			Email:  change.NewEmail,
+			Name:   username,
+			Link:   s.url.ConfirmEmailChangeLink(change.ConfirmToken),
+			Expiry: pkg.FormatTTLVerbose(ttl),
+		}},
+		{domain.EmailChangeNotice, rabbitmq.EmailChangeNoticeRoutingKey, domain.EventEmailData{
+			Email:  change.OldEmail,
+			Name:   username,
+			Link:   s.url.CancelEmailChangeLink(change.CancelToken),
+			Expiry: pkg.FormatTTLVerbose(ttl),
+		}},
+	}
+
+	for _, evt := range events {
+		payload := domain.EventPayload{
+			EventID:   uuid.NewString(),
+			EventType: evt.eventType,
+			Timestamp: pkg.TimeNowUTC(),
+			Data:      evt.data,
+		}
+		if err := s.event.Publish(ctx, evt.routingKey, payload); err != nil {
+			pkg.Log().Errorw("[EVENT QUEUE ERROR]", "from", "email_change", "event_type", evt.eventType, "error", err)
+		}
+	}
+}
+
+func (s *AuthServiceImpl) storeEmailChange(ctx context.Context, userID int64, change domain.EmailChange, ttl time.Duration) error {
+	for _, token := range []string{change.ConfirmToken, change.CancelToken} {
+		if err := s.cache.Set(ctx, domain.GetEmailChangeTokenKey(token), userID, ttl); err != nil {
+			return err
+		}
+	}
+	return s.cache.Set(ctx, domain.GetEmailChangeKey(userID), change, ttl)
+}
+
+// getEmailChange returns the pending change of the user token belongs to,
+// which may be a newer one than token was sent for.
+func (s *AuthServiceImpl) getEmailChange(ctx context.Context, token string) (int64, domain.EmailChange, error) {
+	var userID int64
+	var change domain.EmailChange
+	if err := s.cache.Get(ctx, domain.GetEmailChangeTokenKey(token), &userID); err != nil {
+		return 0, change, err
+	}
+	if err := s.cache.Get(ctx, domain.GetEmailChangeKey(userID), &change); err != nil {
+		return 0, change, err
+	}
+	return userID, change, nil
+}
+
+func (s *AuthServiceImpl) clearEmailChange(ctx context.Context, userID int64, change domain.EmailChange) {
+	keys := []string{
+		domain.GetEmailChangeKey(userID),
+		domain.GetEmailChangeTokenKey(change.ConfirmToken),
+		domain.GetEmailChangeTokenKey(change.CancelToken),
+	}
+	for _, key := range keys {
+		if err := s.cache.Delete(ctx, key); err != nil {
+			pkg.Log().Errorw("[CACHE ERROR]", "from", "email_change", "error", err)
+		}
+	}
+}
+
+func (s *AuthServiceImpl) storeAccountUnlock(ctx context.Context, token, email string, ttl time.Duration) error {
+	return s.cache.Set(ctx, domain.GetAccountUnlockKey(token), email, ttl)
+}
+
+func (s *AuthServiceImpl) getAccountUnlock(ctx context.Context, token string) (string, error) {
+	var email string
+	if err := s.cache.Get(ctx, domain.GetAccountUnlockKey(token), &email); err != nil {
+		return "", err
+	}
+	return email, nil
+}
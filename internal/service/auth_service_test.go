@@ -2,13 +2,19 @@ package service
 
 import (
 	"context"
+	"net/http"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"air-social/internal/config"
 	"air-social/internal/domain"
+	"air-social/internal/infrastructure/oidc"
+	"air-social/internal/infrastructure/oidc/oidctest"
 	"air-social/internal/mocks"
 	"air-social/pkg"
 )
@@ -80,7 +86,7 @@ func (s *authServiceSuite) TestRegister() {
 			mockEvent := mocks.NewEventPublisher(s.T())
 			mockCache := mocks.NewCacheStorage(s.T())
 
-			svc := NewAuthService(mockUser, mockToken, mockURL, mockEvent, mockCache)
+			svc := NewAuthService(mockUser, mockToken, nil, nil, mockURL, mockEvent, mockCache, nil, config.LockoutConfig{}, config.TwoFactorConfig{})
 
 			if tc.setupMock != nil {
 				tc.setupMock(mockUser, mockToken, mockURL, mockEvent, mockCache)
@@ -102,67 +108,227 @@ func (s *authServiceSuite) TestRegister() {
 func (s *authServiceSuite) TestLogin() {
 	password := "password123"
 	hashedPwd, _ := hashPassword(password)
+	ip := "10.0.0.1"
 
 	input := domain.LoginParams{
-		Email:    "test@example.com",
-		Password: password,
-		DeviceID: "device-1",
+		Email:      "Test@Example.com",
+		Password:   password,
+		DeviceID:   "device-1",
+		DeviceName: "Pixel",
+		UserAgent:  "agent",
+		IP:         ip,
 	}
+	email := "test@example.com"
+	client := domain.SessionClient{DeviceID: "device-1", DeviceName: "Pixel", UserAgent: "agent", IP: ip}
 
 	user := &domain.User{
 		ID:           1,
-		Email:        input.Email,
+		Email:        email,
 		Username:     "tester",
 		PasswordHash: hashedPwd,
 	}
 
 	userResp := user.ToResponse()
-
 	tokenInfo := domain.TokenInfo{
 		AccessToken:  "access",
 		RefreshToken: "refresh",
 	}
 
+	lockout := config.LockoutConfig{
+		MaxFailures:   5,
+		Duration:      domain.FifteenMinutesTime,
+		Window:        domain.FifteenMinutesTime,
+		DelayAfter:    2,
+		BaseDelay:     time.Second,
+		MaxDelay:      30 * time.Second,
+		IPMaxFailures: 30,
+	}
+
+	type loginMocks struct {
+		user      *mocks.UserService
+		token     *mocks.TokenService
+		attempts  *mocks.LoginAttemptStore
+		url       *mocks.URLFactory
+		event     *mocks.EventPublisher
+		cache     *mocks.CacheStorage
+		twoFactor *mocks.TwoFactorService
+	}
+
+	twoFactorCfg := config.TwoFactorConfig{ChallengeTTL: domain.FiveMinutesTime, MaxAttempts: 5}
+
+	otherHash, _ := hashPassword("other")
+	wrongUser := &domain.User{ID: 1, Email: email, Username: "tester", PasswordHash: otherHash}
+	bannedUser := &domain.User{ID: 1, Email: email, PasswordHash: hashedPwd, Status: domain.UserStatusBanned}
+	suspendedUntil := time.Now().Add(time.Hour)
+	suspendedUser := &domain.User{ID: 1, Email: email, PasswordHash: hashedPwd,
+		Status: domain.UserStatusSuspended, SuspendedUntil: &suspendedUntil}
+	// A login that keeps the account clears DeleteAfter, so each case gets its own.
+	deletedUser := func() *domain.User {
+		deleteAfter := time.Now().Add(time.Hour)
+		return &domain.User{ID: 1, Email: email, Username: "tester", PasswordHash: hashedPwd, DeleteAfter: &deleteAfter}
+	}
+
 	tests := []struct {
 		name      string
 		input     domain.LoginParams
-		setupMock func(u *mocks.UserService, t *mocks.TokenService)
+		setupMock func(m loginMocks)
 		want      domain.LoginResponse
+		check     func(got domain.LoginResponse)
 		wantErr   error
 	}{
+		{
+			name:  "locked",
+			input: input,
+			setupMock: func(m loginMocks) {
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{Locked: time.Minute}, nil).Once()
+			},
+			wantErr: pkg.ErrAccountLocked,
+		},
+		{
+			name:  "delayed",
+			input: input,
+			setupMock: func(m loginMocks) {
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{Delayed: time.Second}, nil).Once()
+			},
+			wantErr: pkg.ErrTooManyRequests,
+		},
 		{
 			name:  "user_not_found",
 			input: input,
-			setupMock: func(u *mocks.UserService, t *mocks.TokenService) {
-				u.EXPECT().GetByEmail(mock.Anything, input.Email).Return(nil, pkg.ErrNotFound).Once()
+			setupMock: func(m loginMocks) {
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, input.Email).Return(nil, pkg.ErrNotFound).Once()
+				m.attempts.EXPECT().Fail(mock.Anything, email, ip, lockout.Window).
+					Return(domain.LoginAttempts{EmailFailures: 1, IPFailures: 1}, nil).Once()
 			},
 			wantErr: pkg.ErrInvalidCredentials,
 		},
 		{
 			name:  "invalid_password",
 			input: input,
-			setupMock: func(u *mocks.UserService, t *mocks.TokenService) {
-				otherHash, _ := hashPassword("other")
-				u.EXPECT().GetByEmail(mock.Anything, input.Email).Return(&domain.User{PasswordHash: otherHash}, nil).Once()
+			setupMock: func(m loginMocks) {
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, input.Email).Return(wrongUser, nil).Once()
+				m.attempts.EXPECT().Fail(mock.Anything, email, ip, lockout.Window).
+					Return(domain.LoginAttempts{EmailFailures: 1, IPFailures: 1}, nil).Once()
+			},
+			wantErr: pkg.ErrInvalidCredentials,
+		},
+		{
+			name:  "invalid_password_delays",
+			input: input,
+			setupMock: func(m loginMocks) {
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, input.Email).Return(wrongUser, nil).Once()
+				m.attempts.EXPECT().Fail(mock.Anything, email, ip, lockout.Window).
+					Return(domain.LoginAttempts{EmailFailures: 4, IPFailures: 4}, nil).Once()
+				m.attempts.EXPECT().Delay(mock.Anything, email, "", 2*time.Second).Return(nil).Once()
+			},
+			wantErr: pkg.ErrInvalidCredentials,
+		},
+		{
+			name:  "invalid_password_locks",
+			input: input,
+			setupMock: func(m loginMocks) {
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, input.Email).Return(wrongUser, nil).Once()
+				m.attempts.EXPECT().Fail(mock.Anything, email, ip, lockout.Window).
+					Return(domain.LoginAttempts{EmailFailures: 5, IPFailures: 30}, nil).Once()
+				m.attempts.EXPECT().Delay(mock.Anything, "", ip, lockout.Duration).Return(nil).Once()
+				m.attempts.EXPECT().Lock(mock.Anything, email, lockout.Duration).Return(nil).Once()
+
+				// sendEmailAccountLocked flow
+				m.cache.EXPECT().Set(mock.Anything, mock.Anything, email, lockout.Duration).Return(nil).Once()
+				m.url.EXPECT().UnlockAccountLink(mock.Anything).Return("http://unlock.link").Once()
+				m.event.EXPECT().Publish(mock.Anything, mock.Anything, mock.MatchedBy(func(p domain.EventPayload) bool {
+					return p.EventType == domain.EmailAccountLocked
+				})).Return(nil).Once()
 			},
 			wantErr: pkg.ErrInvalidCredentials,
 		},
+		{
+			name:  "banned",
+			input: input,
+			setupMock: func(m loginMocks) {
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, input.Email).Return(bannedUser, nil).Once()
+			},
+			wantErr: pkg.ErrAccountDisabled,
+		},
+		{
+			name:  "suspended",
+			input: input,
+			setupMock: func(m loginMocks) {
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, input.Email).Return(suspendedUser, nil).Once()
+			},
+			wantErr: pkg.ErrAccountDisabled,
+		},
+		{
+			name:  "two_factor_check_error",
+			input: input,
+			setupMock: func(m loginMocks) {
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, input.Email).Return(user, nil).Once()
+				m.twoFactor.EXPECT().IsEnabled(mock.Anything, user.ID).Return(false, assert.AnError).Once()
+			},
+			wantErr: pkg.ErrInternal,
+		},
+		{
+			name:  "two_factor_challenge",
+			input: input,
+			setupMock: func(m loginMocks) {
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, input.Email).Return(user, nil).Once()
+				m.twoFactor.EXPECT().IsEnabled(mock.Anything, user.ID).Return(true, nil).Once()
+				m.cache.EXPECT().Set(mock.Anything, mock.Anything, mock.MatchedBy(func(l domain.TwoFactorLogin) bool {
+					return l.UserID == user.ID && l.Email == email && l.DeviceID == "device-1" && l.IP == ip
+				}), twoFactorCfg.ChallengeTTL).Return(nil).Once()
+			},
+			check: func(got domain.LoginResponse) {
+				s.Require().NotNil(got.TwoFactor)
+				s.NotEmpty(got.TwoFactor.ChallengeToken)
+				s.Equal(int64(300), got.TwoFactor.ExpiresIn)
+				s.Empty(got.Token.AccessToken)
+			},
+		},
 		{
 			name:  "token_creation_error",
 			input: input,
-			setupMock: func(u *mocks.UserService, t *mocks.TokenService) {
-				u.EXPECT().GetByEmail(mock.Anything, input.Email).Return(user, nil).Once()
-				t.EXPECT().CreateSession(mock.Anything, user.ID, input.DeviceID).Return(domain.TokenInfo{}, assert.AnError).Once()
+			setupMock: func(m loginMocks) {
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, input.Email).Return(user, nil).Once()
+				m.twoFactor.EXPECT().IsEnabled(mock.Anything, user.ID).Return(false, nil).Once()
+				m.token.EXPECT().CreateSession(mock.Anything, user.ID, user.Role, client).Return(domain.TokenInfo{}, assert.AnError).Once()
 			},
 			wantErr: pkg.ErrInternal,
 		},
+		{
+			name:  "throttle_store_down",
+			input: input,
+			setupMock: func(m loginMocks) {
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, assert.AnError).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, input.Email).Return(user, nil).Once()
+				m.twoFactor.EXPECT().IsEnabled(mock.Anything, user.ID).Return(false, nil).Once()
+				m.token.EXPECT().CreateSession(mock.Anything, user.ID, user.Role, client).Return(tokenInfo, nil).Once()
+				m.attempts.EXPECT().Reset(mock.Anything, email, "").Return(assert.AnError).Once()
+				m.user.EXPECT().ResolveMediaURLs(mock.Anything).Once()
+			},
+			want: domain.LoginResponse{
+				User:  userResp,
+				Token: tokenInfo,
+			},
+		},
 		{
 			name:  "success",
 			input: input,
-			setupMock: func(u *mocks.UserService, t *mocks.TokenService) {
-				u.EXPECT().GetByEmail(mock.Anything, input.Email).Return(user, nil).Once()
-				t.EXPECT().CreateSession(mock.Anything, user.ID, input.DeviceID).Return(tokenInfo, nil).Once()
-				u.EXPECT().ResolveMediaURLs(mock.Anything).Once()
+			setupMock: func(m loginMocks) {
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, input.Email).Return(user, nil).Once()
+				m.twoFactor.EXPECT().IsEnabled(mock.Anything, user.ID).Return(false, nil).Once()
+				m.token.EXPECT().CreateSession(mock.Anything, user.ID, user.Role, client).Return(tokenInfo, nil).Once()
+				m.attempts.EXPECT().Reset(mock.Anything, email, "").Return(nil).Once()
+				m.user.EXPECT().ResolveMediaURLs(mock.Anything).Once()
 			},
 			want: domain.LoginResponse{
 				User:  userResp,
@@ -170,22 +336,60 @@ func (s *authServiceSuite) TestLogin() {
 			},
 			wantErr: nil,
 		},
+		{
+			name:  "cancels_deletion",
+			input: input,
+			setupMock: func(m loginMocks) {
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, input.Email).Return(deletedUser(), nil).Once()
+				m.twoFactor.EXPECT().IsEnabled(mock.Anything, user.ID).Return(false, nil).Once()
+				m.user.EXPECT().CancelDeletion(mock.Anything, user.ID).Return(nil).Once()
+				m.token.EXPECT().CreateSession(mock.Anything, user.ID, user.Role, client).Return(tokenInfo, nil).Once()
+				m.attempts.EXPECT().Reset(mock.Anything, email, "").Return(nil).Once()
+				m.user.EXPECT().ResolveMediaURLs(mock.Anything).Once()
+			},
+			want: domain.LoginResponse{
+				User:  userResp,
+				Token: tokenInfo,
+			},
+		},
+		{
+			name:  "purged_meanwhile",
+			input: input,
+			setupMock: func(m loginMocks) {
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, input.Email).Return(deletedUser(), nil).Once()
+				m.twoFactor.EXPECT().IsEnabled(mock.Anything, user.ID).Return(false, nil).Once()
+				m.user.EXPECT().CancelDeletion(mock.Anything, user.ID).Return(pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrInvalidCredentials,
+		},
 	}
 
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
-			mockUser := mocks.NewUserService(s.T())
-			mockToken := mocks.NewTokenService(s.T())
-			svc := NewAuthService(mockUser, mockToken, nil, nil, nil)
+			m := loginMocks{
+				user:      mocks.NewUserService(s.T()),
+				token:     mocks.NewTokenService(s.T()),
+				attempts:  mocks.NewLoginAttemptStore(s.T()),
+				url:       mocks.NewURLFactory(s.T()),
+				event:     mocks.NewEventPublisher(s.T()),
+				cache:     mocks.NewCacheStorage(s.T()),
+				twoFactor: mocks.NewTwoFactorService(s.T()),
+			}
+			svc := NewAuthService(m.user, m.token, m.twoFactor, nil, m.url, m.event, m.cache, m.attempts, lockout, twoFactorCfg)
 
 			if tc.setupMock != nil {
-				tc.setupMock(mockUser, mockToken)
+				tc.setupMock(m)
 			}
 
 			got, err := svc.Login(context.Background(), tc.input)
 
 			if tc.wantErr != nil {
 				s.ErrorIs(err, tc.wantErr)
+			} else if tc.check != nil {
+				s.NoError(err)
+				tc.check(got)
 			} else {
 				s.NoError(err)
 				s.Equal(tc.want, got)
@@ -194,9 +398,454 @@ func (s *authServiceSuite) TestLogin() {
 	}
 }
 
+func (s *authServiceSuite) TestLoginDelay() {
+	cfg := config.LockoutConfig{DelayAfter: 2, BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+
+	s.Equal(time.Duration(0), loginDelay(cfg, 2))
+	s.Equal(time.Second, loginDelay(cfg, 3))
+	s.Equal(2*time.Second, loginDelay(cfg, 4))
+	s.Equal(4*time.Second, loginDelay(cfg, 5))
+	s.Equal(5*time.Second, loginDelay(cfg, 6))
+	s.Equal(5*time.Second, loginDelay(cfg, 60))
+}
+
+func (s *authServiceSuite) TestVerifyTwoFactor() {
+	token := "challenge-token"
+	key := domain.GetTwoFactorChallengeKey(token)
+	email := "test@example.com"
+	ip := "10.0.0.1"
+	input := domain.VerifyTwoFactorParams{ChallengeToken: token, Code: "123456"}
+
+	user := &domain.User{ID: 1, Email: email, Username: "tester"}
+	tokenInfo := domain.TokenInfo{AccessToken: "access", RefreshToken: "refresh"}
+	client := domain.SessionClient{DeviceID: "device-1", DeviceName: "Pixel", UserAgent: "agent", IP: ip}
+	cfg := config.TwoFactorConfig{ChallengeTTL: domain.FiveMinutesTime, MaxAttempts: 3}
+	lockout := config.LockoutConfig{Window: domain.FifteenMinutesTime}
+
+	login := func(attempts int) domain.TwoFactorLogin {
+		return domain.TwoFactorLogin{
+			UserID: user.ID, Email: email, DeviceID: "device-1", DeviceName: "Pixel", UserAgent: "agent", IP: ip,
+			Attempts: attempts, ExpiresAt: time.Now().Add(time.Minute),
+		}
+	}
+	cached := func(l domain.TwoFactorLogin) func(context.Context, string, any) error {
+		return func(_ context.Context, _ string, dst any) error {
+			*dst.(*domain.TwoFactorLogin) = l
+			return nil
+		}
+	}
+
+	type verifyMocks struct {
+		user      *mocks.UserService
+		token     *mocks.TokenService
+		twoFactor *mocks.TwoFactorService
+		cache     *mocks.CacheStorage
+		attempts  *mocks.LoginAttemptStore
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(m verifyMocks)
+		want      domain.LoginResponse
+		wantErr   error
+	}{
+		{
+			name: "unknown_challenge",
+			setupMock: func(m verifyMocks) {
+				m.cache.EXPECT().Get(mock.Anything, key, mock.Anything).Return(pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrUnauthorized,
+		},
+		{
+			name: "locked",
+			setupMock: func(m verifyMocks) {
+				m.cache.EXPECT().Get(mock.Anything, key, mock.Anything).RunAndReturn(cached(login(0))).Once()
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{Locked: time.Minute}, nil).Once()
+			},
+			wantErr: pkg.ErrAccountLocked,
+		},
+		{
+			name: "wrong_code",
+			setupMock: func(m verifyMocks) {
+				m.cache.EXPECT().Get(mock.Anything, key, mock.Anything).RunAndReturn(cached(login(0))).Once()
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+				m.twoFactor.EXPECT().Verify(mock.Anything, user.ID, input.Code).Return(pkg.ErrInvalidTwoFactorCode).Once()
+				m.cache.EXPECT().Set(mock.Anything, key, mock.MatchedBy(func(l domain.TwoFactorLogin) bool {
+					return l.Attempts == 1
+				}), mock.Anything).Return(nil).Once()
+				m.attempts.EXPECT().Fail(mock.Anything, email, ip, lockout.Window).
+					Return(domain.LoginAttempts{EmailFailures: 1, IPFailures: 1}, nil).Once()
+			},
+			wantErr: pkg.ErrInvalidTwoFactorCode,
+		},
+		{
+			name: "wrong_code_ends_challenge",
+			setupMock: func(m verifyMocks) {
+				m.cache.EXPECT().Get(mock.Anything, key, mock.Anything).RunAndReturn(cached(login(2))).Once()
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+				m.twoFactor.EXPECT().Verify(mock.Anything, user.ID, input.Code).Return(pkg.ErrInvalidTwoFactorCode).Once()
+				m.cache.EXPECT().Delete(mock.Anything, key).Return(nil).Once()
+				m.attempts.EXPECT().Fail(mock.Anything, email, ip, lockout.Window).
+					Return(domain.LoginAttempts{EmailFailures: 3, IPFailures: 3}, nil).Once()
+			},
+			wantErr: pkg.ErrInvalidTwoFactorCode,
+		},
+		{
+			name: "verify_error",
+			setupMock: func(m verifyMocks) {
+				m.cache.EXPECT().Get(mock.Anything, key, mock.Anything).RunAndReturn(cached(login(0))).Once()
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+				m.twoFactor.EXPECT().Verify(mock.Anything, user.ID, input.Code).Return(pkg.ErrInternal).Once()
+			},
+			wantErr: pkg.ErrInternal,
+		},
+		{
+			name: "success",
+			setupMock: func(m verifyMocks) {
+				m.cache.EXPECT().Get(mock.Anything, key, mock.Anything).RunAndReturn(cached(login(1))).Once()
+				m.attempts.EXPECT().Throttle(mock.Anything, email, ip).Return(domain.LoginThrottle{}, nil).Once()
+				m.user.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+				m.twoFactor.EXPECT().Verify(mock.Anything, user.ID, input.Code).Return(nil).Once()
+				m.cache.EXPECT().Delete(mock.Anything, key).Return(nil).Once()
+				m.token.EXPECT().CreateSession(mock.Anything, user.ID, user.Role, client).Return(tokenInfo, nil).Once()
+				m.attempts.EXPECT().Reset(mock.Anything, email, "").Return(nil).Once()
+				m.user.EXPECT().ResolveMediaURLs(mock.Anything).Once()
+			},
+			want: domain.LoginResponse{User: user.ToResponse(), Token: tokenInfo},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			m := verifyMocks{
+				user:      mocks.NewUserService(s.T()),
+				token:     mocks.NewTokenService(s.T()),
+				twoFactor: mocks.NewTwoFactorService(s.T()),
+				cache:     mocks.NewCacheStorage(s.T()),
+				attempts:  mocks.NewLoginAttemptStore(s.T()),
+			}
+			svc := NewAuthService(m.user, m.token, m.twoFactor, nil, nil, nil, m.cache, m.attempts, lockout, cfg)
+			tc.setupMock(m)
+
+			got, err := svc.VerifyTwoFactor(context.Background(), input)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+				s.Equal(tc.want, got)
+			}
+		})
+	}
+}
+
+func (s *authServiceSuite) TestUnlockAccount() {
+	token := "unlock-token"
+	email := "test@example.com"
+
+	s.Run("invalid_token", func() {
+		cache := mocks.NewCacheStorage(s.T())
+		svc := NewAuthService(nil, nil, nil, nil, nil, nil, cache, nil, config.LockoutConfig{}, config.TwoFactorConfig{})
+		cache.EXPECT().Get(mock.Anything, domain.GetAccountUnlockKey(token), mock.Anything).Return(pkg.ErrNotFound).Once()
+
+		s.ErrorIs(svc.UnlockAccount(context.Background(), token), pkg.ErrBadRequest)
+	})
+
+	s.Run("success", func() {
+		cache := mocks.NewCacheStorage(s.T())
+		attempts := mocks.NewLoginAttemptStore(s.T())
+		svc := NewAuthService(nil, nil, nil, nil, nil, nil, cache, attempts, config.LockoutConfig{}, config.TwoFactorConfig{})
+		cache.EXPECT().Get(mock.Anything, domain.GetAccountUnlockKey(token), mock.Anything).
+			RunAndReturn(func(_ context.Context, _ string, dst any) error {
+				*dst.(*string) = email
+				return nil
+			}).Once()
+		attempts.EXPECT().Reset(mock.Anything, email, "").Return(nil).Once()
+		cache.EXPECT().Delete(mock.Anything, domain.GetAccountUnlockKey(token)).Return(nil).Once()
+
+		s.NoError(svc.UnlockAccount(context.Background(), token))
+	})
+}
+
+func (s *authServiceSuite) TestResendVerification() {
+	user := &domain.User{ID: 1, Email: "test@example.com", Username: "tester"}
+	verifiedUser := &domain.User{ID: 1, Email: "test@example.com", Username: "tester", Verified: true}
+
+	tests := []struct {
+		name      string
+		setupMock func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage)
+		wantErr   error
+	}{
+		{
+			name: "user_not_found",
+			setupMock: func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage) {
+				u.EXPECT().GetByID(mock.Anything, user.ID).Return(nil, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name: "already_verified",
+			setupMock: func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage) {
+				u.EXPECT().GetByID(mock.Anything, user.ID).Return(verifiedUser, nil).Once()
+			},
+			wantErr: pkg.ErrAlreadyVerified,
+		},
+		{
+			name: "success",
+			setupMock: func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage) {
+				u.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+
+				// sendEmailVerification flow
+				c.EXPECT().Set(mock.Anything, mock.Anything, user.Email, domain.ThirtyMinutesTime).Return(nil).Once()
+				url.EXPECT().VerifyEmailLink(mock.Anything).Return("http://verify.link").Once()
+				e.EXPECT().Publish(mock.Anything, mock.Anything, mock.MatchedBy(func(p domain.EventPayload) bool {
+					return p.EventType == domain.EmailVerify
+				})).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockUser := mocks.NewUserService(s.T())
+			mockURL := mocks.NewURLFactory(s.T())
+			mockEvent := mocks.NewEventPublisher(s.T())
+			mockCache := mocks.NewCacheStorage(s.T())
+			svc := NewAuthService(mockUser, nil, nil, nil, mockURL, mockEvent, mockCache, nil, config.LockoutConfig{}, config.TwoFactorConfig{})
+
+			tc.setupMock(mockUser, mockURL, mockEvent, mockCache)
+
+			err := svc.ResendVerification(context.Background(), user.ID)
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *authServiceSuite) TestRequestEmailChange() {
+	hashedPwd, _ := hashPassword("password123")
+	user := &domain.User{ID: 1, Email: "old@example.com", Username: "tester", PasswordHash: hashedPwd}
+	input := domain.ChangeEmailParams{UserID: 1, NewEmail: "new@example.com", CurrentPassword: "password123"}
+
+	tests := []struct {
+		name      string
+		input     domain.ChangeEmailParams
+		setupMock func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage)
+		wantErr   error
+	}{
+		{
+			name:  "wrong_password",
+			input: domain.ChangeEmailParams{UserID: 1, NewEmail: "new@example.com", CurrentPassword: "wrong"},
+			setupMock: func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage) {
+				u.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+			},
+			wantErr: pkg.ErrInvalidCredentials,
+		},
+		{
+			name:  "same_email",
+			input: domain.ChangeEmailParams{UserID: 1, NewEmail: "Old@Example.com", CurrentPassword: "password123"},
+			setupMock: func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage) {
+				u.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+			},
+			wantErr: pkg.ErrSameEmail,
+		},
+		{
+			name:  "email_taken",
+			input: input,
+			setupMock: func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage) {
+				u.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+				u.EXPECT().GetByEmail(mock.Anything, input.NewEmail).Return(&domain.User{ID: 2}, nil).Once()
+			},
+			wantErr: pkg.ErrEmailTaken,
+		},
+		{
+			name:  "cache_error",
+			input: input,
+			setupMock: func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage) {
+				u.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+				u.EXPECT().GetByEmail(mock.Anything, input.NewEmail).Return(nil, pkg.ErrNotFound).Once()
+				c.EXPECT().Set(mock.Anything, mock.Anything, user.ID, domain.ThirtyMinutesTime).Return(assert.AnError).Once()
+			},
+			wantErr: pkg.ErrInternal,
+		},
+		{
+			name:  "success",
+			input: input,
+			setupMock: func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage) {
+				var change domain.EmailChange
+				u.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+				u.EXPECT().GetByEmail(mock.Anything, input.NewEmail).Return(nil, pkg.ErrNotFound).Once()
+				c.EXPECT().Set(mock.Anything, mock.Anything, user.ID, domain.ThirtyMinutesTime).Return(nil).Twice()
+				c.EXPECT().Set(mock.Anything, domain.GetEmailChangeKey(user.ID), mock.Anything, domain.ThirtyMinutesTime).
+					RunAndReturn(func(_ context.Context, _ string, v any, _ time.Duration) error {
+						change = v.(domain.EmailChange)
+						s.Equal("old@example.com", change.OldEmail)
+						s.Equal("new@example.com", change.NewEmail)
+						s.NotEqual(change.ConfirmToken, change.CancelToken)
+						return nil
+					}).Once()
+				url.EXPECT().ConfirmEmailChangeLink(mock.Anything).Return("http://confirm.link").Once()
+				url.EXPECT().CancelEmailChangeLink(mock.Anything).Return("http://cancel.link").Once()
+				e.EXPECT().Publish(mock.Anything, "email.change.confirm", mock.MatchedBy(func(p domain.EventPayload) bool {
+					data := p.Data.(domain.EventEmailData)
+					return p.EventType == domain.EmailChangeConfirm && data.Email == "new@example.com" && data.Link == "http://confirm.link"
+				})).Return(nil).Once()
+				e.EXPECT().Publish(mock.Anything, "email.change.notice", mock.MatchedBy(func(p domain.EventPayload) bool {
+					data := p.Data.(domain.EventEmailData)
+					return p.EventType == domain.EmailChangeNotice && data.Email == "old@example.com" && data.Link == "http://cancel.link"
+				})).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockUser := mocks.NewUserService(s.T())
+			mockURL := mocks.NewURLFactory(s.T())
+			mockEvent := mocks.NewEventPublisher(s.T())
+			mockCache := mocks.NewCacheStorage(s.T())
+			svc := NewAuthService(mockUser, nil, nil, nil, mockURL, mockEvent, mockCache, nil, config.LockoutConfig{}, config.TwoFactorConfig{})
+
+			tc.setupMock(mockUser, mockURL, mockEvent, mockCache)
+
+			err := svc.RequestEmailChange(context.Background(), tc.input)
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *authServiceSuite) TestConfirmAndCancelEmailChange() {
+	change := domain.EmailChange{
+		OldEmail:     "old@example.com",
+		NewEmail:     "new@example.com",
+		ConfirmToken: "confirm",
+		CancelToken:  "cancel",
+	}
+
+	// pending makes token belong to user 1, whose pending change is change.
+	pending := func(c *mocks.CacheStorage, token string) {
+		c.EXPECT().Get(mock.Anything, domain.GetEmailChangeTokenKey(token), mock.Anything).
+			Run(func(ctx context.Context, key string, dest any) {
+				*dest.(*int64) = 1
+			}).Return(nil).Once()
+		c.EXPECT().Get(mock.Anything, domain.GetEmailChangeKey(1), mock.Anything).
+			Run(func(ctx context.Context, key string, dest any) {
+				*dest.(*domain.EmailChange) = change
+			}).Return(nil).Once()
+	}
+	cleared := func(c *mocks.CacheStorage) {
+		c.EXPECT().Delete(mock.Anything, domain.GetEmailChangeKey(1)).Return(nil).Once()
+		c.EXPECT().Delete(mock.Anything, domain.GetEmailChangeTokenKey("confirm")).Return(nil).Once()
+		c.EXPECT().Delete(mock.Anything, domain.GetEmailChangeTokenKey("cancel")).Return(nil).Once()
+	}
+
+	tests := []struct {
+		name      string
+		cancel    bool
+		token     string
+		setupMock func(u *mocks.UserService, c *mocks.CacheStorage)
+		wantErr   error
+	}{
+		{
+			name:  "confirm_unknown_token",
+			token: "confirm",
+			setupMock: func(u *mocks.UserService, c *mocks.CacheStorage) {
+				c.EXPECT().Get(mock.Anything, domain.GetEmailChangeTokenKey("confirm"), mock.Anything).Return(pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrBadRequest,
+		},
+		{
+			name:  "confirm_with_replaced_token",
+			token: "older",
+			setupMock: func(u *mocks.UserService, c *mocks.CacheStorage) {
+				pending(c, "older")
+			},
+			wantErr: pkg.ErrBadRequest,
+		},
+		{
+			name:  "confirm_with_cancel_token",
+			token: "cancel",
+			setupMock: func(u *mocks.UserService, c *mocks.CacheStorage) {
+				pending(c, "cancel")
+			},
+			wantErr: pkg.ErrBadRequest,
+		},
+		{
+			name:  "confirm_email_taken",
+			token: "confirm",
+			setupMock: func(u *mocks.UserService, c *mocks.CacheStorage) {
+				pending(c, "confirm")
+				u.EXPECT().ChangeEmail(mock.Anything, int64(1), change.OldEmail, change.NewEmail).Return(pkg.ErrEmailTaken).Once()
+			},
+			wantErr: pkg.ErrEmailTaken,
+		},
+		{
+			name:  "confirm",
+			token: "confirm",
+			setupMock: func(u *mocks.UserService, c *mocks.CacheStorage) {
+				pending(c, "confirm")
+				u.EXPECT().ChangeEmail(mock.Anything, int64(1), change.OldEmail, change.NewEmail).Return(nil).Once()
+				cleared(c)
+			},
+		},
+		{
+			name:   "cancel_with_confirm_token",
+			cancel: true,
+			token:  "confirm",
+			setupMock: func(u *mocks.UserService, c *mocks.CacheStorage) {
+				pending(c, "confirm")
+			},
+			wantErr: pkg.ErrBadRequest,
+		},
+		{
+			name:   "cancel",
+			cancel: true,
+			token:  "cancel",
+			setupMock: func(u *mocks.UserService, c *mocks.CacheStorage) {
+				pending(c, "cancel")
+				cleared(c)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockUser := mocks.NewUserService(s.T())
+			mockCache := mocks.NewCacheStorage(s.T())
+			svc := NewAuthService(mockUser, nil, nil, nil, nil, nil, mockCache, nil, config.LockoutConfig{}, config.TwoFactorConfig{})
+
+			tc.setupMock(mockUser, mockCache)
+
+			var err error
+			if tc.cancel {
+				err = svc.CancelEmailChange(context.Background(), tc.token)
+			} else {
+				err = svc.ConfirmEmailChange(context.Background(), tc.token)
+			}
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
 func (s *authServiceSuite) TestLogout() {
 	var userID int64 = 1
 	deviceID := "device-1"
+	tokenID := "jti-1"
+	expiresAt := time.Now().Add(10 * time.Minute)
 
 	tests := []struct {
 		name      string
@@ -218,12 +867,15 @@ func (s *authServiceSuite) TestLogout() {
 		{
 			name: "logout_single_device",
 			input: domain.LogoutParams{
-				UserID:       userID,
-				DeviceID:     deviceID,
-				IsAllDevices: false,
+				UserID:         userID,
+				DeviceID:       deviceID,
+				IsAllDevices:   false,
+				TokenID:        tokenID,
+				TokenExpiresAt: expiresAt,
 			},
 			setupMock: func(t *mocks.TokenService) {
 				t.EXPECT().RevokeDeviceSession(mock.Anything, userID, deviceID).Return(nil).Once()
+				t.EXPECT().RevokeAccessToken(mock.Anything, tokenID, expiresAt).Return(nil).Once()
 			},
 			wantErr: nil,
 		},
@@ -243,7 +895,7 @@ func (s *authServiceSuite) TestLogout() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			mockToken := mocks.NewTokenService(s.T())
-			svc := NewAuthService(nil, mockToken, nil, nil, nil)
+			svc := NewAuthService(nil, mockToken, nil, nil, nil, nil, nil, nil, config.LockoutConfig{}, config.TwoFactorConfig{})
 
 			if tc.setupMock != nil {
 				tc.setupMock(mockToken)
@@ -298,7 +950,7 @@ func (s *authServiceSuite) TestForgotPassword() {
 			mockEvent := mocks.NewEventPublisher(s.T())
 			mockCache := mocks.NewCacheStorage(s.T())
 
-			svc := NewAuthService(mockUser, nil, mockURL, mockEvent, mockCache)
+			svc := NewAuthService(mockUser, nil, nil, nil, mockURL, mockEvent, mockCache, nil, config.LockoutConfig{}, config.TwoFactorConfig{})
 
 			if tc.setupMock != nil {
 				tc.setupMock(mockUser, mockURL, mockEvent, mockCache)
@@ -357,7 +1009,7 @@ func (s *authServiceSuite) TestResetPassword() {
 			mockUser := mocks.NewUserService(s.T())
 			mockCache := mocks.NewCacheStorage(s.T())
 
-			svc := NewAuthService(mockUser, nil, nil, nil, mockCache)
+			svc := NewAuthService(mockUser, nil, nil, nil, nil, nil, mockCache, nil, config.LockoutConfig{}, config.TwoFactorConfig{})
 
 			if tc.setupMock != nil {
 				tc.setupMock(mockUser, mockCache)
@@ -412,7 +1064,7 @@ func (s *authServiceSuite) TestVerifyEmail() {
 			mockUser := mocks.NewUserService(s.T())
 			mockCache := mocks.NewCacheStorage(s.T())
 
-			svc := NewAuthService(mockUser, nil, nil, nil, mockCache)
+			svc := NewAuthService(mockUser, nil, nil, nil, nil, nil, mockCache, nil, config.LockoutConfig{}, config.TwoFactorConfig{})
 
 			if tc.setupMock != nil {
 				tc.setupMock(mockUser, mockCache)
@@ -432,6 +1084,7 @@ func (s *authServiceSuite) TestVerifyEmail() {
 func (s *authServiceSuite) TestRefreshToken() {
 	token := "refresh-token"
 	tokenInfo := domain.TokenInfo{AccessToken: "new-access"}
+	client := domain.SessionClient{UserAgent: "agent", IP: "10.0.0.1"}
 
 	tests := []struct {
 		name      string
@@ -443,7 +1096,7 @@ func (s *authServiceSuite) TestRefreshToken() {
 			name:  "error",
 			token: token,
 			setupMock: func(t *mocks.TokenService) {
-				t.EXPECT().Refresh(mock.Anything, token).Return(domain.TokenInfo{}, pkg.ErrUnauthorized).Once()
+				t.EXPECT().Refresh(mock.Anything, token, client).Return(domain.TokenInfo{}, pkg.ErrUnauthorized).Once()
 			},
 			wantErr: pkg.ErrUnauthorized,
 		},
@@ -451,7 +1104,7 @@ func (s *authServiceSuite) TestRefreshToken() {
 			name:  "success",
 			token: token,
 			setupMock: func(t *mocks.TokenService) {
-				t.EXPECT().Refresh(mock.Anything, token).Return(tokenInfo, nil).Once()
+				t.EXPECT().Refresh(mock.Anything, token, client).Return(tokenInfo, nil).Once()
 			},
 			wantErr: nil,
 		},
@@ -460,13 +1113,17 @@ func (s *authServiceSuite) TestRefreshToken() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			mockToken := mocks.NewTokenService(s.T())
-			svc := NewAuthService(nil, mockToken, nil, nil, nil)
+			svc := NewAuthService(nil, mockToken, nil, nil, nil, nil, nil, nil, config.LockoutConfig{}, config.TwoFactorConfig{})
 
 			if tc.setupMock != nil {
 				tc.setupMock(mockToken)
 			}
 
-			got, err := svc.RefreshToken(context.Background(), tc.token)
+			got, err := svc.RefreshToken(context.Background(), domain.RefreshParams{
+				RefreshToken: tc.token,
+				UserAgent:    client.UserAgent,
+				IP:           client.IP,
+			})
 
 			if tc.wantErr != nil {
 				s.ErrorIs(err, tc.wantErr)
@@ -513,7 +1170,7 @@ func (s *authServiceSuite) TestIsResetPasswordTokenValid() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			mockCache := mocks.NewCacheStorage(s.T())
-			svc := NewAuthService(nil, nil, nil, nil, mockCache)
+			svc := NewAuthService(nil, nil, nil, nil, nil, nil, mockCache, nil, config.LockoutConfig{}, config.TwoFactorConfig{})
 
 			if tc.setupMock != nil {
 				tc.setupMock(mockCache)
@@ -524,3 +1181,479 @@ func (s *authServiceSuite) TestIsResetPasswordTokenValid() {
 		})
 	}
 }
+
+// oauthProvider returns a client of a stand-in provider that signs in user.
+func (s *authServiceSuite) oauthProvider(user oidctest.User) (*oidctest.Server, domain.OAuthProviders) {
+	server, err := oidctest.NewServer()
+	s.Require().NoError(err)
+	s.T().Cleanup(server.Close)
+	server.SetUser(user)
+
+	return server, domain.OAuthProviders{"test": oidc.NewProvider(server.ProviderConfig("test"), http.DefaultClient)}
+}
+
+func (s *authServiceSuite) TestStartOAuth() {
+	s.Run("unknown_provider", func() {
+		svc := NewAuthService(nil, nil, nil, domain.OAuthProviders{}, nil, nil, nil, nil, config.LockoutConfig{}, config.TwoFactorConfig{})
+
+		_, err := svc.StartOAuth(context.Background(), "test")
+		s.ErrorIs(err, pkg.ErrNotFound)
+	})
+
+	s.Run("provider_down", func() {
+		server, providers := s.oauthProvider(oidctest.User{})
+		server.Close()
+		cache := mocks.NewCacheStorage(s.T())
+		cache.EXPECT().Set(mock.Anything, mock.Anything, mock.Anything, domain.TenMinutesTime).Return(nil).Once()
+		svc := NewAuthService(nil, nil, nil, providers, nil, nil, cache, nil, config.LockoutConfig{}, config.TwoFactorConfig{})
+
+		_, err := svc.StartOAuth(context.Background(), "test")
+		s.ErrorIs(err, pkg.ErrProviderUnavailable)
+	})
+
+	s.Run("success", func() {
+		server, providers := s.oauthProvider(oidctest.User{Subject: "sub-1"})
+		cache := mocks.NewCacheStorage(s.T())
+		var state domain.OAuthState
+		cache.EXPECT().Set(mock.Anything, mock.Anything, mock.Anything, domain.TenMinutesTime).
+			RunAndReturn(func(_ context.Context, _ string, v any, _ time.Duration) error {
+				state = v.(domain.OAuthState)
+				return nil
+			}).Once()
+		svc := NewAuthService(nil, nil, nil, providers, nil, nil, cache, nil, config.LockoutConfig{}, config.TwoFactorConfig{})
+
+		got, err := svc.StartOAuth(context.Background(), "test")
+
+		s.Require().NoError(err)
+		s.Equal("test", state.Provider)
+		s.NotEmpty(state.CodeVerifier)
+		s.NotEmpty(state.Nonce)
+		code, gotState, err := server.Authorize(got.AuthorizationURL)
+		s.Require().NoError(err)
+		s.NotEmpty(code)
+		s.Equal(got.State, gotState)
+	})
+}
+
+func (s *authServiceSuite) TestOAuthLogin() {
+	ip := "10.0.0.1"
+	client := domain.SessionClient{DeviceID: "device-1", DeviceName: "Pixel", UserAgent: "agent", IP: ip}
+	tokenInfo := domain.TokenInfo{AccessToken: "access", RefreshToken: "refresh"}
+	twoFactorCfg := config.TwoFactorConfig{ChallengeTTL: domain.FiveMinutesTime, MaxAttempts: 5}
+
+	providerUser := oidctest.User{Subject: "sub-1", Email: "test@example.com", EmailVerified: true, PreferredUsername: "Tester"}
+	user := &domain.User{ID: 1, Email: "test@example.com", Username: "tester", Verified: true}
+	unverifiedUser := &domain.User{ID: 1, Email: "test@example.com", Username: "tester"}
+	bannedUser := &domain.User{ID: 1, Email: "test@example.com", Status: domain.UserStatusBanned}
+
+	type oauthMocks struct {
+		user      *mocks.UserService
+		token     *mocks.TokenService
+		attempts  *mocks.LoginAttemptStore
+		twoFactor *mocks.TwoFactorService
+	}
+
+	signIn := func(m oauthMocks, u *domain.User) {
+		m.twoFactor.EXPECT().IsEnabled(mock.Anything, u.ID).Return(false, nil).Once()
+		m.token.EXPECT().CreateSession(mock.Anything, u.ID, u.Role, client).Return(tokenInfo, nil).Once()
+		m.attempts.EXPECT().Reset(mock.Anything, u.Email, "").Return(nil).Once()
+		m.user.EXPECT().ResolveMediaURLs(mock.Anything).Once()
+	}
+	signedIn := domain.LoginResponse{User: user.ToResponse(), Token: tokenInfo}
+
+	tests := []struct {
+		name         string
+		providerUser oidctest.User
+		// state changes the login cached by StartOAuth before the callback.
+		state     func(st *domain.OAuthState)
+		code      string
+		setupMock func(m oauthMocks)
+		want      domain.LoginResponse
+		check     func(got domain.LoginResponse)
+		wantErr   error
+	}{
+		{
+			name:         "state_for_other_provider",
+			providerUser: providerUser,
+			state:        func(st *domain.OAuthState) { st.Provider = "other" },
+			wantErr:      pkg.ErrUnauthorized,
+		},
+		{
+			name:         "nonce_mismatch",
+			providerUser: providerUser,
+			state:        func(st *domain.OAuthState) { st.Nonce = "other" },
+			wantErr:      pkg.ErrUnauthorized,
+		},
+		{
+			name:         "wrong_verifier",
+			providerUser: providerUser,
+			state:        func(st *domain.OAuthState) { st.CodeVerifier = "other" },
+			wantErr:      pkg.ErrUnauthorized,
+		},
+		{
+			name:         "invalid_code",
+			providerUser: providerUser,
+			code:         "invalid",
+			wantErr:      pkg.ErrUnauthorized,
+		},
+		{
+			name:         "linked_identity",
+			providerUser: providerUser,
+			setupMock: func(m oauthMocks) {
+				m.user.EXPECT().GetByIdentity(mock.Anything, "test", "sub-1").Return(user, nil).Once()
+				signIn(m, user)
+			},
+			want: signedIn,
+		},
+		{
+			name:         "linked_identity_banned",
+			providerUser: providerUser,
+			setupMock: func(m oauthMocks) {
+				m.user.EXPECT().GetByIdentity(mock.Anything, "test", "sub-1").Return(bannedUser, nil).Once()
+			},
+			wantErr: pkg.ErrAccountDisabled,
+		},
+		{
+			name:         "provider_email_unverified",
+			providerUser: oidctest.User{Subject: "sub-1", Email: "test@example.com"},
+			setupMock: func(m oauthMocks) {
+				m.user.EXPECT().GetByIdentity(mock.Anything, "test", "sub-1").Return(nil, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrEmailNotVerified,
+		},
+		{
+			name:         "links_verified_account",
+			providerUser: providerUser,
+			setupMock: func(m oauthMocks) {
+				m.user.EXPECT().GetByIdentity(mock.Anything, "test", "sub-1").Return(nil, pkg.ErrNotFound).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, "test@example.com").Return(user, nil).Once()
+				m.user.EXPECT().LinkIdentity(mock.Anything, domain.UserIdentity{
+					UserID: user.ID, Provider: "test", Subject: "sub-1", Email: "test@example.com",
+				}).Return(nil).Once()
+				signIn(m, user)
+			},
+			want: signedIn,
+		},
+		{
+			name:         "unverified_account_not_linked",
+			providerUser: providerUser,
+			setupMock: func(m oauthMocks) {
+				m.user.EXPECT().GetByIdentity(mock.Anything, "test", "sub-1").Return(nil, pkg.ErrNotFound).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, "test@example.com").Return(unverifiedUser, nil).Once()
+			},
+			wantErr: pkg.ErrAccountNotLinked,
+		},
+		{
+			name:         "creates_account",
+			providerUser: providerUser,
+			setupMock: func(m oauthMocks) {
+				m.user.EXPECT().GetByIdentity(mock.Anything, "test", "sub-1").Return(nil, pkg.ErrNotFound).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, "test@example.com").Return(nil, pkg.ErrNotFound).Once()
+				identity := domain.UserIdentity{Provider: "test", Subject: "sub-1", Email: "test@example.com"}
+				m.user.EXPECT().CreateUserWithIdentity(mock.Anything, mock.MatchedBy(func(p domain.CreateUserParams) bool {
+					return p.Username == "tester"
+				}), identity).Return(nil, pkg.ErrAlreadyExists).Once()
+				m.user.EXPECT().CreateUserWithIdentity(mock.Anything, mock.MatchedBy(func(p domain.CreateUserParams) bool {
+					return regexp.MustCompile(`^tester\d{4}$`).MatchString(p.Username) &&
+						p.Email == "test@example.com" && p.Verified && p.PasswordHashed != ""
+				}), identity).Return(user, nil).Once()
+				signIn(m, user)
+			},
+			want: signedIn,
+		},
+		{
+			name:         "two_factor_challenge",
+			providerUser: providerUser,
+			setupMock: func(m oauthMocks) {
+				m.user.EXPECT().GetByIdentity(mock.Anything, "test", "sub-1").Return(user, nil).Once()
+				m.twoFactor.EXPECT().IsEnabled(mock.Anything, user.ID).Return(true, nil).Once()
+			},
+			check: func(got domain.LoginResponse) {
+				s.Require().NotNil(got.TwoFactor)
+				s.NotEmpty(got.TwoFactor.ChallengeToken)
+				s.Empty(got.Token.AccessToken)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			server, providers := s.oauthProvider(tc.providerUser)
+			m := oauthMocks{
+				user:      mocks.NewUserService(s.T()),
+				token:     mocks.NewTokenService(s.T()),
+				attempts:  mocks.NewLoginAttemptStore(s.T()),
+				twoFactor: mocks.NewTwoFactorService(s.T()),
+			}
+			cache := mocks.NewCacheStorage(s.T())
+			svc := NewAuthService(m.user, m.token, m.twoFactor, providers, nil, nil, cache, m.attempts, config.LockoutConfig{}, twoFactorCfg)
+
+			var state domain.OAuthState
+			cache.EXPECT().Set(mock.Anything, mock.Anything, mock.Anything, domain.TenMinutesTime).
+				RunAndReturn(func(_ context.Context, _ string, v any, _ time.Duration) error {
+					state = v.(domain.OAuthState)
+					return nil
+				}).Once()
+			start, err := svc.StartOAuth(context.Background(), "test")
+			s.Require().NoError(err)
+			code, _, err := server.Authorize(start.AuthorizationURL)
+			s.Require().NoError(err)
+
+			if tc.state != nil {
+				tc.state(&state)
+			}
+			if tc.code != "" {
+				code = tc.code
+			}
+			key := domain.GetOAuthStateKey(start.State)
+			cache.EXPECT().Get(mock.Anything, key, mock.Anything).
+				RunAndReturn(func(_ context.Context, _ string, dst any) error {
+					*dst.(*domain.OAuthState) = state
+					return nil
+				}).Once()
+			cache.EXPECT().Delete(mock.Anything, key).Return(nil).Once()
+			if tc.check != nil {
+				cache.EXPECT().Set(mock.Anything, mock.Anything, mock.Anything, twoFactorCfg.ChallengeTTL).Return(nil).Once()
+			}
+			if tc.setupMock != nil {
+				tc.setupMock(m)
+			}
+
+			got, err := svc.OAuthLogin(context.Background(), domain.OAuthLoginParams{
+				Provider:   "test",
+				Code:       code,
+				State:      start.State,
+				DeviceID:   client.DeviceID,
+				DeviceName: client.DeviceName,
+				UserAgent:  client.UserAgent,
+				IP:         client.IP,
+			})
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else if tc.check != nil {
+				s.NoError(err)
+				tc.check(got)
+			} else {
+				s.NoError(err)
+				s.Equal(tc.want, got)
+			}
+		})
+	}
+
+	s.Run("state_not_found", func() {
+		_, providers := s.oauthProvider(providerUser)
+		cache := mocks.NewCacheStorage(s.T())
+		cache.EXPECT().Get(mock.Anything, domain.GetOAuthStateKey("state"), mock.Anything).Return(pkg.ErrNotFound).Once()
+		svc := NewAuthService(nil, nil, nil, providers, nil, nil, cache, nil, config.LockoutConfig{}, twoFactorCfg)
+
+		_, err := svc.OAuthLogin(context.Background(), domain.OAuthLoginParams{Provider: "test", Code: "code", State: "state"})
+		s.ErrorIs(err, pkg.ErrUnauthorized)
+	})
+}
+
+func (s *authServiceSuite) TestRequestMagicLink() {
+	input := domain.MagicLinkParams{Email: "Test@Example.com", DeviceID: "device-1", DeviceName: "Pixel"}
+	user := &domain.User{ID: 1, Email: "Test@Example.com", Username: "tester"}
+	bannedUser := &domain.User{ID: 1, Email: "Test@Example.com", Status: domain.UserStatusBanned}
+	tokenKey := domain.GetMagicLinkTokenKey("test@example.com")
+	link := domain.MagicLink{Email: user.Email, DeviceID: "device-1", DeviceName: "Pixel"}
+
+	tests := []struct {
+		name      string
+		setupMock func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage)
+		wantErr   error
+	}{
+		{
+			name: "user_not_found",
+			setupMock: func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage) {
+				u.EXPECT().GetByEmail(mock.Anything, input.Email).Return(nil, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name: "banned",
+			setupMock: func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage) {
+				u.EXPECT().GetByEmail(mock.Anything, input.Email).Return(bannedUser, nil).Once()
+			},
+			wantErr: pkg.ErrAccountDisabled,
+		},
+		{
+			name: "first_link",
+			setupMock: func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage) {
+				u.EXPECT().GetByEmail(mock.Anything, input.Email).Return(user, nil).Once()
+				c.EXPECT().Get(mock.Anything, tokenKey, mock.Anything).Return(pkg.ErrNotFound).Once()
+				c.EXPECT().Set(mock.Anything, mock.Anything, link, domain.FifteenMinutesTime).Return(nil).Once()
+				c.EXPECT().Set(mock.Anything, tokenKey, mock.Anything, domain.FifteenMinutesTime).Return(nil).Once()
+				url.EXPECT().MagicLoginLink(mock.Anything).Return("http://login.link").Once()
+				e.EXPECT().Publish(mock.Anything, "email.magic_link", mock.MatchedBy(func(p domain.EventPayload) bool {
+					return p.EventType == domain.EmailMagicLink
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "replaces_previous_link",
+			setupMock: func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage) {
+				var token string
+				u.EXPECT().GetByEmail(mock.Anything, input.Email).Return(user, nil).Once()
+				c.EXPECT().Get(mock.Anything, tokenKey, mock.Anything).
+					Run(func(ctx context.Context, key string, dest any) {
+						*dest.(*string) = "old"
+					}).Return(nil).Once()
+				c.EXPECT().Delete(mock.Anything, domain.GetMagicLinkKey("old")).Return(nil).Once()
+				c.EXPECT().Set(mock.Anything, mock.Anything, link, domain.FifteenMinutesTime).
+					RunAndReturn(func(_ context.Context, key string, _ any, _ time.Duration) error {
+						token = key[len(domain.WorkerEmailMagicLink):]
+						return nil
+					}).Once()
+				c.EXPECT().Set(mock.Anything, tokenKey, mock.Anything, domain.FifteenMinutesTime).
+					RunAndReturn(func(_ context.Context, _ string, v any, _ time.Duration) error {
+						s.Equal(token, v)
+						return nil
+					}).Once()
+				url.EXPECT().MagicLoginLink(mock.Anything).Return("http://login.link").Once()
+				e.EXPECT().Publish(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "cache_error_sends_nothing",
+			setupMock: func(u *mocks.UserService, url *mocks.URLFactory, e *mocks.EventPublisher, c *mocks.CacheStorage) {
+				u.EXPECT().GetByEmail(mock.Anything, input.Email).Return(user, nil).Once()
+				c.EXPECT().Get(mock.Anything, tokenKey, mock.Anything).Return(assert.AnError).Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockUser := mocks.NewUserService(s.T())
+			mockURL := mocks.NewURLFactory(s.T())
+			mockEvent := mocks.NewEventPublisher(s.T())
+			mockCache := mocks.NewCacheStorage(s.T())
+			svc := NewAuthService(mockUser, nil, nil, nil, mockURL, mockEvent, mockCache, nil, config.LockoutConfig{}, config.TwoFactorConfig{})
+
+			tc.setupMock(mockUser, mockURL, mockEvent, mockCache)
+
+			err := svc.RequestMagicLink(context.Background(), input)
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *authServiceSuite) TestMagicLinkLogin() {
+	ip := "10.0.0.1"
+	input := domain.MagicLinkLoginParams{Token: "token", UserAgent: "agent", IP: ip}
+	client := domain.SessionClient{DeviceID: "device-1", DeviceName: "Pixel", UserAgent: "agent", IP: ip}
+	link := domain.MagicLink{Email: "Test@Example.com", DeviceID: "device-1", DeviceName: "Pixel"}
+	linkKey := domain.GetMagicLinkKey("token")
+	tokenKey := domain.GetMagicLinkTokenKey("test@example.com")
+	tokenInfo := domain.TokenInfo{AccessToken: "access", RefreshToken: "refresh"}
+
+	user := &domain.User{ID: 1, Email: "Test@Example.com", Username: "tester", Verified: true}
+	unverifiedUser := &domain.User{ID: 1, Email: "Test@Example.com", Username: "tester"}
+	bannedUser := &domain.User{ID: 1, Email: "Test@Example.com", Status: domain.UserStatusBanned}
+
+	type magicMocks struct {
+		user      *mocks.UserService
+		token     *mocks.TokenService
+		attempts  *mocks.LoginAttemptStore
+		cache     *mocks.CacheStorage
+		twoFactor *mocks.TwoFactorService
+	}
+
+	useLink := func(m magicMocks, current string) {
+		m.cache.EXPECT().Get(mock.Anything, linkKey, mock.Anything).
+			Run(func(ctx context.Context, key string, dest any) {
+				*dest.(*domain.MagicLink) = link
+			}).Return(nil).Once()
+		m.cache.EXPECT().Delete(mock.Anything, linkKey).Return(nil).Once()
+		m.cache.EXPECT().Get(mock.Anything, tokenKey, mock.Anything).
+			Run(func(ctx context.Context, key string, dest any) {
+				*dest.(*string) = current
+			}).Return(nil).Once()
+	}
+	signIn := func(m magicMocks) {
+		m.cache.EXPECT().Delete(mock.Anything, tokenKey).Return(nil).Once()
+		m.twoFactor.EXPECT().IsEnabled(mock.Anything, user.ID).Return(false, nil).Once()
+		m.token.EXPECT().CreateSession(mock.Anything, user.ID, user.Role, client).Return(tokenInfo, nil).Once()
+		m.attempts.EXPECT().Reset(mock.Anything, "test@example.com", "").Return(nil).Once()
+		m.user.EXPECT().ResolveMediaURLs(mock.Anything).Once()
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(m magicMocks)
+		want      domain.LoginResponse
+		wantErr   error
+	}{
+		{
+			name: "unknown_or_used_link",
+			setupMock: func(m magicMocks) {
+				m.cache.EXPECT().Get(mock.Anything, linkKey, mock.Anything).Return(pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrUnauthorized,
+		},
+		{
+			name: "replaced_link",
+			setupMock: func(m magicMocks) {
+				useLink(m, "newer")
+			},
+			wantErr: pkg.ErrUnauthorized,
+		},
+		{
+			name: "banned",
+			setupMock: func(m magicMocks) {
+				useLink(m, "token")
+				m.cache.EXPECT().Delete(mock.Anything, tokenKey).Return(nil).Once()
+				m.user.EXPECT().GetByEmail(mock.Anything, link.Email).Return(bannedUser, nil).Once()
+			},
+			wantErr: pkg.ErrAccountDisabled,
+		},
+		{
+			name: "verifies_email",
+			setupMock: func(m magicMocks) {
+				useLink(m, "token")
+				m.user.EXPECT().GetByEmail(mock.Anything, link.Email).Return(unverifiedUser, nil).Once()
+				m.user.EXPECT().VerifyEmail(mock.Anything, link.Email).Return(nil).Once()
+				signIn(m)
+			},
+			want: domain.LoginResponse{User: user.ToResponse(), Token: tokenInfo},
+		},
+		{
+			name: "success",
+			setupMock: func(m magicMocks) {
+				useLink(m, "token")
+				m.user.EXPECT().GetByEmail(mock.Anything, link.Email).Return(user, nil).Once()
+				signIn(m)
+			},
+			want: domain.LoginResponse{User: user.ToResponse(), Token: tokenInfo},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			m := magicMocks{
+				user:      mocks.NewUserService(s.T()),
+				token:     mocks.NewTokenService(s.T()),
+				attempts:  mocks.NewLoginAttemptStore(s.T()),
+				cache:     mocks.NewCacheStorage(s.T()),
+				twoFactor: mocks.NewTwoFactorService(s.T()),
+			}
+			svc := NewAuthService(m.user, m.token, m.twoFactor, nil, nil, nil, m.cache, m.attempts, config.LockoutConfig{}, config.TwoFactorConfig{})
+
+			tc.setupMock(m)
+
+			got, err := svc.MagicLinkLogin(context.Background(), input)
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+				s.Equal(tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,686 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+type ChatService interface {
+	GetOrCreateDirect(ctx context.Context, userID, otherID int64) (domain.ConversationResponse, error)
+	GetConversation(ctx context.Context, userID, conversationID int64) (domain.ConversationResponse, error)
+	ListConversations(ctx context.Context, input domain.ListConversationsParams) (domain.Page, error)
+	SendMessage(ctx context.Context, input domain.SendMessageParams) (domain.MessageResponse, error)
+	ListMessages(ctx context.Context, input domain.ListMessagesParams) (domain.Page, error)
+	CreateGroup(ctx context.Context, input domain.CreateGroupChatParams) (domain.ConversationResponse, error)
+	UpdateGroup(ctx context.Context, input domain.UpdateGroupChatParams) (domain.ConversationResponse, error)
+	AddMembers(ctx context.Context, input domain.AddMembersParams) (domain.ConversationResponse, error)
+	RemoveMember(ctx context.Context, input domain.RemoveMemberParams) error
+	UpdateMemberRole(ctx context.Context, input domain.UpdateMemberRoleParams) error
+	Leave(ctx context.Context, userID, conversationID int64) error
+	MarkDelivered(ctx context.Context, input domain.ReceiptParams) error
+	MarkRead(ctx context.Context, input domain.ReceiptParams) error
+	Typing(ctx context.Context, input domain.TypingParams) error
+}
+
+type ChatServiceImpl struct {
+	convRepo domain.ConversationRepository
+	msgRepo  domain.MessageRepository
+	userSvc  UserService
+	mediaSvc MediaService
+	realtime domain.RealtimeSender
+}
+
+func NewChatService(
+	convRepo domain.ConversationRepository,
+	msgRepo domain.MessageRepository,
+	userSvc UserService,
+	mediaSvc MediaService,
+	realtime domain.RealtimeSender,
+) *ChatServiceImpl {
+	return &ChatServiceImpl{
+		convRepo: convRepo,
+		msgRepo:  msgRepo,
+		userSvc:  userSvc,
+		mediaSvc: mediaSvc,
+		realtime: realtime,
+	}
+}
+
+func (s *ChatServiceImpl) GetOrCreateDirect(ctx context.Context, userID, otherID int64) (domain.ConversationResponse, error) {
+	var empty domain.ConversationResponse
+
+	if userID == otherID {
+		return empty, pkg.ErrInvalidData
+	}
+	if _, err := s.userSvc.GetByID(ctx, otherID); err != nil {
+		return empty, err
+	}
+
+	conv, err := s.convRepo.GetOrCreateDirect(ctx, userID, otherID)
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	return s.mapConversation(ctx, userID, conv)
+}
+
+func (s *ChatServiceImpl) GetConversation(ctx context.Context, userID, conversationID int64) (domain.ConversationResponse, error) {
+	var empty domain.ConversationResponse
+
+	if err := s.checkMember(ctx, conversationID, userID); err != nil {
+		return empty, err
+	}
+
+	conv, err := s.convRepo.GetByID(ctx, conversationID)
+	if err != nil {
+		return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	return s.mapConversation(ctx, userID, conv)
+}
+
+// ListConversations returns the inbox of a user, most recently active first.
+// The cursor is the (last_message_id, id) pair of the last conversation, so a
+// conversation that receives a message while the client pages moves to the
+// top and is not returned again further down.
+func (s *ChatServiceImpl) ListConversations(ctx context.Context, input domain.ListConversationsParams) (domain.Page, error) {
+	var empty domain.Page
+
+	filter := domain.ConversationListFilter{UserID: input.UserID}
+	keys, err := pkg.DecodeCursor(input.Page.Cursor, 2)
+	if err != nil {
+		return empty, err
+	}
+	if keys != nil {
+		filter.BeforeLastMessageID, filter.BeforeID = keys[0], keys[1]
+	}
+
+	limit := input.Page.Size()
+	filter.Limit = limit + 1
+
+	convs, err := s.convRepo.ListByMember(ctx, filter)
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	page := newPage(convs, limit, func(c domain.Conversation) string {
+		return pkg.EncodeCursor(c.LastMessageID, c.ID)
+	})
+
+	items, err := s.mapConversations(ctx, input.UserID, page.Items.([]domain.Conversation))
+	if err != nil {
+		return empty, err
+	}
+	page.Items = items
+	return page, nil
+}
+
+func (s *ChatServiceImpl) SendMessage(ctx context.Context, input domain.SendMessageParams) (domain.MessageResponse, error) {
+	var empty domain.MessageResponse
+
+	content := strings.TrimSpace(input.Content)
+	if content == "" && input.Attachment == nil {
+		return empty, pkg.ErrInvalidData
+	}
+
+	if err := s.checkMember(ctx, input.ConversationID, input.UserID); err != nil {
+		return empty, err
+	}
+
+	msg := &domain.Message{
+		ConversationID: input.ConversationID,
+		SenderID:       input.UserID,
+		Type:           domain.MessageText,
+		Content:        content,
+	}
+
+	if input.Attachment != nil {
+		key, err := s.confirmAttachment(ctx, input.UserID, *input.Attachment)
+		if err != nil {
+			return empty, err
+		}
+		msg.ObjectKey = &key
+		msg.Feature = input.Attachment.Feature
+		msg.Type = messageTypeOf(input.Attachment.Feature)
+	}
+
+	if err := s.msgRepo.Create(ctx, msg); err != nil {
+		return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	res := s.mapMessage(msg)
+	s.broadcast(ctx, msg.ConversationID, nil, domain.RealtimeMessage{Type: domain.RealtimeMessageNew, Data: res})
+	return res, nil
+}
+
+// ListMessages pages through the history newest first, using the sequence
+// number as cursor. With AfterSeq set it returns the messages a client missed,
+// oldest first; the client continues from the last returned Seq while HasMore
+// is true.
+func (s *ChatServiceImpl) ListMessages(ctx context.Context, input domain.ListMessagesParams) (domain.Page, error) {
+	var empty domain.Page
+
+	if err := s.checkMember(ctx, input.ConversationID, input.UserID); err != nil {
+		return empty, err
+	}
+
+	filter := domain.MessageListFilter{
+		ConversationID: input.ConversationID,
+		AfterSeq:       input.AfterSeq,
+	}
+	if input.AfterSeq == nil {
+		beforeSeq, err := decodeIDCursor(input.Page.Cursor)
+		if err != nil {
+			return empty, err
+		}
+		filter.BeforeSeq = beforeSeq
+	}
+
+	limit := input.Page.Size()
+	filter.Limit = limit + 1
+
+	msgs, err := s.msgRepo.List(ctx, filter)
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	items := make([]domain.MessageResponse, 0, len(msgs))
+	for i := range msgs {
+		items = append(items, s.mapMessage(&msgs[i]))
+	}
+
+	return newPage(items, limit, func(m domain.MessageResponse) string {
+		if input.AfterSeq != nil {
+			return ""
+		}
+		return pkg.EncodeCursor(m.Seq)
+	}), nil
+}
+
+// CreateGroup creates a group owned by the caller. Its history starts with a
+// "created" system message followed by one "added" message per member.
+func (s *ChatServiceImpl) CreateGroup(ctx context.Context, input domain.CreateGroupChatParams) (domain.ConversationResponse, error) {
+	var empty domain.ConversationResponse
+
+	title := strings.TrimSpace(input.Title)
+	memberIDs := uniqueIDs(input.MemberIDs, input.UserID)
+	if title == "" || len(memberIDs) == 0 || len(memberIDs)+1 > domain.MaxGroupMembers {
+		return empty, pkg.ErrInvalidData
+	}
+
+	creator, err := s.userSvc.GetByID(ctx, input.UserID)
+	if err != nil {
+		return empty, err
+	}
+
+	conv := &domain.Conversation{Type: domain.ConversationGroup, Title: title}
+	members := []domain.ConversationMember{{UserID: creator.ID, Role: domain.RoleOwner}}
+	events := []*domain.Message{
+		newSystemMessage(0, creator.ID, domain.EventGroupCreated, nil,
+			fmt.Sprintf("%s created the group %q", creator.Username, title)),
+	}
+
+	for _, id := range memberIDs {
+		user, err := s.userSvc.GetByID(ctx, id)
+		if err != nil {
+			return empty, err
+		}
+		members = append(members, domain.ConversationMember{UserID: user.ID, Role: domain.RoleMember})
+		events = append(events, newSystemMessage(0, creator.ID, domain.EventMemberAdded, &user.ID,
+			fmt.Sprintf("%s added %s", creator.Username, user.Username)))
+	}
+
+	if err := s.convRepo.CreateGroup(ctx, conv, members, events); err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	s.broadcastEvents(ctx, conv.ID, nil, events)
+	return s.mapConversation(ctx, input.UserID, conv)
+}
+
+// UpdateGroup renames the group and/or replaces its avatar. An empty Avatar
+// removes it. Owners and admins only.
+func (s *ChatServiceImpl) UpdateGroup(ctx context.Context, input domain.UpdateGroupChatParams) (domain.ConversationResponse, error) {
+	var empty domain.ConversationResponse
+
+	conv, actor, err := s.groupMember(ctx, input.ConversationID, input.UserID)
+	if err != nil {
+		return empty, err
+	}
+	if !actor.Role.CanManage() {
+		return empty, pkg.ErrForbidden
+	}
+
+	var events []*domain.Message
+	oldAvatar := conv.Avatar
+
+	if input.Title != nil {
+		title := strings.TrimSpace(*input.Title)
+		if title == "" {
+			return empty, pkg.ErrInvalidData
+		}
+		if title != conv.Title {
+			conv.Title = title
+			events = append(events, newSystemMessage(conv.ID, actor.UserID, domain.EventGroupRenamed, nil,
+				fmt.Sprintf("%s renamed the group to %q", actor.User.Username, title)))
+		}
+	}
+
+	if input.Avatar != nil && *input.Avatar != conv.Avatar {
+		avatar := *input.Avatar
+		content := fmt.Sprintf("%s removed the group photo", actor.User.Username)
+		if avatar != "" {
+			avatar, err = s.confirmAttachment(ctx, input.UserID, domain.MessageAttachmentItem{
+				ObjectKey: avatar,
+				Feature:   domain.FeatureAvatar,
+			})
+			if err != nil {
+				return empty, err
+			}
+			content = fmt.Sprintf("%s changed the group photo", actor.User.Username)
+		}
+		conv.Avatar = avatar
+		events = append(events, newSystemMessage(conv.ID, actor.UserID, domain.EventAvatarChanged, nil, content))
+	}
+
+	if len(events) > 0 {
+		if err := s.convRepo.Update(ctx, conv, events); err != nil {
+			return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+		}
+		if oldAvatar != "" && oldAvatar != conv.Avatar {
+			if err := s.mediaSvc.DeleteFile(ctx, oldAvatar); err != nil {
+				pkg.Log().Errorw("[STORAGE ERROR]", "from", "group_avatar_delete", "key", oldAvatar, "error", err)
+			}
+		}
+		s.broadcastEvents(ctx, conv.ID, nil, events)
+	}
+
+	return s.mapConversation(ctx, input.UserID, conv)
+}
+
+// AddMembers adds users to the group as plain members. Users that already
+// belong to it are skipped. Owners and admins only.
+func (s *ChatServiceImpl) AddMembers(ctx context.Context, input domain.AddMembersParams) (domain.ConversationResponse, error) {
+	var empty domain.ConversationResponse
+
+	conv, actor, err := s.groupMember(ctx, input.ConversationID, input.UserID)
+	if err != nil {
+		return empty, err
+	}
+	if !actor.Role.CanManage() {
+		return empty, pkg.ErrForbidden
+	}
+
+	current, err := s.convRepo.ListMemberIDs(ctx, conv.ID)
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+	newIDs := uniqueIDs(input.UserIDs, current...)
+	if len(current)+len(newIDs) > domain.MaxGroupMembers {
+		return empty, pkg.ErrInvalidData
+	}
+
+	if len(newIDs) > 0 {
+		events := make([]*domain.Message, 0, len(newIDs))
+		for _, id := range newIDs {
+			user, err := s.userSvc.GetByID(ctx, id)
+			if err != nil {
+				return empty, err
+			}
+			events = append(events, newSystemMessage(conv.ID, actor.UserID, domain.EventMemberAdded, &user.ID,
+				fmt.Sprintf("%s added %s", actor.User.Username, user.Username)))
+		}
+
+		if err := s.convRepo.AddMembers(ctx, conv.ID, newIDs, events); err != nil {
+			return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+		}
+		s.broadcastEvents(ctx, conv.ID, nil, events)
+	}
+
+	return s.mapConversation(ctx, input.UserID, conv)
+}
+
+// RemoveMember removes another member from the group. The caller must
+// outrank the member: the owner removes anyone, admins remove members.
+func (s *ChatServiceImpl) RemoveMember(ctx context.Context, input domain.RemoveMemberParams) error {
+	if input.MemberID == input.UserID {
+		return pkg.ErrInvalidData
+	}
+
+	conv, actor, err := s.groupMember(ctx, input.ConversationID, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	target, err := s.convRepo.GetMember(ctx, conv.ID, input.MemberID)
+	if err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	if !actor.Role.Outranks(target.Role) {
+		return pkg.ErrForbidden
+	}
+
+	event := newSystemMessage(conv.ID, actor.UserID, domain.EventMemberRemoved, &target.UserID,
+		fmt.Sprintf("%s removed %s", actor.User.Username, target.User.Username))
+	if err := s.convRepo.RemoveMember(ctx, conv.ID, target.UserID, event); err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	s.broadcastEvents(ctx, conv.ID, []int64{target.UserID}, []*domain.Message{event})
+	return nil
+}
+
+// UpdateMemberRole promotes a member to admin or demotes an admin. Owner only.
+func (s *ChatServiceImpl) UpdateMemberRole(ctx context.Context, input domain.UpdateMemberRoleParams) error {
+	if input.MemberID == input.UserID || input.Role == domain.RoleOwner {
+		return pkg.ErrInvalidData
+	}
+
+	conv, actor, err := s.groupMember(ctx, input.ConversationID, input.UserID)
+	if err != nil {
+		return err
+	}
+	if actor.Role != domain.RoleOwner {
+		return pkg.ErrForbidden
+	}
+
+	target, err := s.convRepo.GetMember(ctx, conv.ID, input.MemberID)
+	if err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	if target.Role == input.Role {
+		return nil
+	}
+
+	event := newSystemMessage(conv.ID, actor.UserID, domain.EventRoleChanged, &target.UserID,
+		fmt.Sprintf("%s made %s %s", actor.User.Username, target.User.Username, input.Role))
+	if err := s.convRepo.UpdateMemberRole(ctx, conv.ID, target.UserID, input.Role, event); err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	s.broadcastEvents(ctx, conv.ID, nil, []*domain.Message{event})
+	return nil
+}
+
+// Leave removes the caller from a group. If the owner leaves, ownership
+// passes to the longest standing admin, or else member.
+func (s *ChatServiceImpl) Leave(ctx context.Context, userID, conversationID int64) error {
+	conv, actor, err := s.groupMember(ctx, conversationID, userID)
+	if err != nil {
+		return err
+	}
+
+	event := newSystemMessage(conv.ID, userID, domain.EventMemberLeft, nil,
+		fmt.Sprintf("%s left", actor.User.Username))
+	if err := s.convRepo.RemoveMember(ctx, conv.ID, userID, event); err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	s.broadcastEvents(ctx, conv.ID, []int64{userID}, []*domain.Message{event})
+	return nil
+}
+
+// MarkDelivered records that the user's devices received the messages up to
+// input.Seq and tells the members, so senders can show them as delivered.
+func (s *ChatServiceImpl) MarkDelivered(ctx context.Context, input domain.ReceiptParams) error {
+	return s.markReceipt(ctx, input, domain.RealtimeMessageDelivered, s.convRepo.MarkDelivered)
+}
+
+// MarkRead records that the user read the messages up to input.Seq. The
+// event also reaches the user's other devices so their unread counts follow.
+func (s *ChatServiceImpl) MarkRead(ctx context.Context, input domain.ReceiptParams) error {
+	return s.markReceipt(ctx, input, domain.RealtimeMessageRead, s.convRepo.MarkRead)
+}
+
+// Typing relays a typing indicator to the other members. Nothing is stored;
+// clients are expected to repeat it every few seconds while typing and to
+// treat it as stopped when it is not refreshed.
+func (s *ChatServiceImpl) Typing(ctx context.Context, input domain.TypingParams) error {
+	if err := s.checkMember(ctx, input.ConversationID, input.UserID); err != nil {
+		return err
+	}
+
+	memberIDs, err := s.convRepo.ListMemberIDs(ctx, input.ConversationID)
+	if err != nil {
+		return pkg.OrInternalError(err)
+	}
+	others := uniqueIDs(memberIDs, input.UserID)
+	if len(others) == 0 {
+		return nil
+	}
+
+	msg := domain.RealtimeMessage{
+		Type: domain.RealtimeTyping,
+		Data: domain.TypingData{ConversationID: input.ConversationID, UserID: input.UserID, Typing: input.Typing},
+	}
+	if err := s.realtime.SendToUsers(ctx, others, msg); err != nil {
+		pkg.Log().Errorw("[REALTIME ERROR]", "from", string(msg.Type), "conversation_id", input.ConversationID, "error", err)
+	}
+	return nil
+}
+
+// Internal helpers
+
+// markReceipt advances a receipt position with mark and broadcasts the new
+// position. Stale or repeated acks are accepted silently without a broadcast.
+func (s *ChatServiceImpl) markReceipt(
+	ctx context.Context,
+	input domain.ReceiptParams,
+	msgType domain.RealtimeType,
+	mark func(ctx context.Context, conversationID, userID, seq int64) (int64, error),
+) error {
+	if input.Seq <= 0 {
+		return pkg.ErrInvalidData
+	}
+	if err := s.checkMember(ctx, input.ConversationID, input.UserID); err != nil {
+		return err
+	}
+
+	seq, err := mark(ctx, input.ConversationID, input.UserID, input.Seq)
+	if err != nil {
+		return pkg.OrInternalError(err)
+	}
+	if seq == 0 {
+		return nil
+	}
+
+	s.broadcast(ctx, input.ConversationID, nil, domain.RealtimeMessage{
+		Type: msgType,
+		Data: domain.ReceiptData{ConversationID: input.ConversationID, UserID: input.UserID, Seq: seq},
+	})
+	return nil
+}
+
+// checkMember hides conversations the user is not part of behind ErrNotFound.
+func (s *ChatServiceImpl) checkMember(ctx context.Context, conversationID, userID int64) error {
+	if _, err := s.convRepo.GetMember(ctx, conversationID, userID); err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	return nil
+}
+
+// groupMember loads a group together with the caller's membership. Direct
+// conversations have no roles, so group operations on them are invalid.
+func (s *ChatServiceImpl) groupMember(
+	ctx context.Context,
+	conversationID, userID int64,
+) (*domain.Conversation, *domain.ConversationMember, error) {
+	member, err := s.convRepo.GetMember(ctx, conversationID, userID)
+	if err != nil {
+		return nil, nil, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	conv, err := s.convRepo.GetByID(ctx, conversationID)
+	if err != nil {
+		return nil, nil, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	if conv.Type != domain.ConversationGroup {
+		return nil, nil, pkg.ErrInvalidData
+	}
+	return conv, member, nil
+}
+
+// confirmAttachment accepts only objects uploaded by the sender to the
+// messages domain, like confirmMedia does for posts.
+func (s *ChatServiceImpl) confirmAttachment(ctx context.Context, userID int64, item domain.MessageAttachmentItem) (string, error) {
+	prefix := fmt.Sprintf("%s/%d/%s/", domain.DomainMessage, userID, item.Feature)
+	if !strings.HasPrefix(item.ObjectKey, prefix) {
+		return "", pkg.ErrInvalidData
+	}
+
+	key, err := s.mediaSvc.ConfirmUpload(ctx, domain.ConfirmFileParams{
+		UserID:    userID,
+		ObjectKey: item.ObjectKey,
+		Domain:    domain.DomainMessage,
+		Feature:   item.Feature,
+	})
+	if err != nil {
+		return "", pkg.OrInternalError(err, pkg.ErrBadRequest, pkg.ErrForbidden, pkg.ErrNotFound)
+	}
+	return key, nil
+}
+
+// broadcast pushes msgs to every member of the conversation and to extraIDs,
+// e.g. a member who was just removed. Delivery is best effort; clients that
+// miss it resync through ListMessages.
+func (s *ChatServiceImpl) broadcast(ctx context.Context, conversationID int64, extraIDs []int64, msgs ...domain.RealtimeMessage) {
+	memberIDs, err := s.convRepo.ListMemberIDs(ctx, conversationID)
+	if err != nil {
+		pkg.Log().Errorw("[REALTIME ERROR]", "from", "chat_broadcast", "conversation_id", conversationID, "error", err)
+		return
+	}
+	memberIDs = append(memberIDs, extraIDs...)
+
+	for _, msg := range msgs {
+		if err := s.realtime.SendToUsers(ctx, memberIDs, msg); err != nil {
+			pkg.Log().Errorw("[REALTIME ERROR]", "from", string(msg.Type), "conversation_id", conversationID, "error", err)
+		}
+	}
+}
+
+func (s *ChatServiceImpl) broadcastEvents(ctx context.Context, conversationID int64, extraIDs []int64, events []*domain.Message) {
+	msgs := make([]domain.RealtimeMessage, 0, len(events))
+	for _, ev := range events {
+		msgs = append(msgs, domain.RealtimeMessage{Type: domain.RealtimeMessageNew, Data: s.mapMessage(ev)})
+	}
+	s.broadcast(ctx, conversationID, extraIDs, msgs...)
+}
+
+func (s *ChatServiceImpl) mapConversation(ctx context.Context, userID int64, conv *domain.Conversation) (domain.ConversationResponse, error) {
+	items, err := s.mapConversations(ctx, userID, []domain.Conversation{*conv})
+	if err != nil {
+		return domain.ConversationResponse{}, err
+	}
+	return items[0], nil
+}
+
+// mapConversations builds the responses as seen by userID. The unread count
+// is the distance between the newest message and the user's read position,
+// so the inbox needs no per-conversation count query.
+func (s *ChatServiceImpl) mapConversations(
+	ctx context.Context,
+	userID int64,
+	convs []domain.Conversation,
+) ([]domain.ConversationResponse, error) {
+	items := make([]domain.ConversationResponse, 0, len(convs))
+	if len(convs) == 0 {
+		return items, nil
+	}
+
+	ids := make([]int64, 0, len(convs))
+	var lastIDs []int64
+	index := make(map[int64]int, len(convs))
+	for i := range convs {
+		ids = append(ids, convs[i].ID)
+		if convs[i].LastMessageID > 0 {
+			lastIDs = append(lastIDs, convs[i].LastMessageID)
+		}
+		index[convs[i].ID] = i
+		items = append(items, convs[i].ToResponse())
+		items[i].Avatar = s.mediaSvc.GetPublicURL(convs[i].Avatar)
+	}
+
+	members, err := s.convRepo.ListMembers(ctx, ids)
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+	for _, m := range members {
+		user := m.User
+		user.Avatar = s.mediaSvc.GetPublicURL(user.Avatar)
+		i := index[m.ConversationID]
+		items[i].Members = append(items[i].Members, domain.ConversationMemberResponse{
+			UserSummary:      user,
+			Role:             m.Role,
+			LastReadSeq:      m.LastReadSeq,
+			LastDeliveredSeq: m.LastDeliveredSeq,
+		})
+		if m.UserID == userID {
+			items[i].UnreadCount = max(items[i].LastSeq-m.LastReadSeq, 0)
+		}
+	}
+
+	last, err := s.msgRepo.ListByIDs(ctx, lastIDs)
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+	for i := range last {
+		res := s.mapMessage(&last[i])
+		items[index[res.ConversationID]].LastMessage = &res
+	}
+
+	return items, nil
+}
+
+func (s *ChatServiceImpl) mapMessage(msg *domain.Message) domain.MessageResponse {
+	res := msg.ToResponse()
+	if res.Attachment != nil {
+		res.Attachment.URL = s.mediaSvc.GetPublicURL(res.Attachment.ObjectKey)
+	}
+	return res
+}
+
+func messageTypeOf(feature domain.UploadFeature) domain.MessageType {
+	if feature == domain.FeatureVoiceChat {
+		return domain.MessageVoice
+	}
+	return domain.MessageImage
+}
+
+func newSystemMessage(
+	conversationID, actorID int64,
+	event domain.SystemEvent,
+	targetID *int64,
+	content string,
+) *domain.Message {
+	return &domain.Message{
+		ConversationID: conversationID,
+		SenderID:       actorID,
+		Type:           domain.MessageSystem,
+		Content:        content,
+		Event:          event,
+		TargetID:       targetID,
+	}
+}
+
+// uniqueIDs returns ids without duplicates and without any of exclude,
+// keeping their order.
+func uniqueIDs(ids []int64, exclude ...int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids)+len(exclude))
+	for _, id := range exclude {
+		seen[id] = struct{}{}
+	}
+
+	out := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+	}
+	return out
+}
@@ -0,0 +1,730 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"air-social/internal/domain"
+	"air-social/internal/mocks"
+	"air-social/pkg"
+)
+
+type chatServiceSuite struct {
+	suite.Suite
+}
+
+func TestChatServiceSuite(t *testing.T) {
+	suite.Run(t, new(chatServiceSuite))
+}
+
+type chatMocks struct {
+	conv     *mocks.ConversationRepository
+	msg      *mocks.MessageRepository
+	user     *mocks.UserService
+	media    *mocks.MediaService
+	realtime *mocks.RealtimeSender
+}
+
+func (s *chatServiceSuite) newService() (*ChatServiceImpl, chatMocks) {
+	m := chatMocks{
+		conv:     mocks.NewConversationRepository(s.T()),
+		msg:      mocks.NewMessageRepository(s.T()),
+		user:     mocks.NewUserService(s.T()),
+		media:    mocks.NewMediaService(s.T()),
+		realtime: mocks.NewRealtimeSender(s.T()),
+	}
+	return NewChatService(m.conv, m.msg, m.user, m.media, m.realtime), m
+}
+
+func (s *chatServiceSuite) TestGetOrCreateDirect() {
+	var (
+		userID  int64 = 1
+		otherID int64 = 2
+		convID  int64 = 10
+	)
+
+	tests := []struct {
+		name      string
+		otherID   int64
+		setupMock func(m chatMocks)
+		wantErr   error
+	}{
+		{
+			name:    "self",
+			otherID: userID,
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name:    "other_not_found",
+			otherID: otherID,
+			setupMock: func(m chatMocks) {
+				m.user.EXPECT().GetByID(mock.Anything, otherID).Return(nil, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name:    "success",
+			otherID: otherID,
+			setupMock: func(m chatMocks) {
+				m.user.EXPECT().GetByID(mock.Anything, otherID).Return(&domain.User{ID: otherID}, nil).Once()
+				m.conv.EXPECT().GetOrCreateDirect(mock.Anything, userID, otherID).
+					Return(&domain.Conversation{ID: convID, Type: domain.ConversationDirect, LastSeq: 7}, nil).Once()
+				m.conv.EXPECT().ListMembers(mock.Anything, []int64{convID}).Return([]domain.ConversationMember{
+					{ConversationID: convID, UserID: userID, LastReadSeq: 4, User: domain.UserSummary{ID: userID}},
+					{ConversationID: convID, UserID: otherID, User: domain.UserSummary{ID: otherID, Avatar: "a.jpg"}},
+				}, nil).Once()
+				m.msg.EXPECT().ListByIDs(mock.Anything, []int64(nil)).Return(nil, nil).Once()
+				m.media.EXPECT().GetPublicURL("").Return("").Twice()
+				m.media.EXPECT().GetPublicURL("a.jpg").Return("url").Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			svc, m := s.newService()
+			if tc.setupMock != nil {
+				tc.setupMock(m)
+			}
+
+			res, err := svc.GetOrCreateDirect(context.Background(), userID, tc.otherID)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+				return
+			}
+			s.NoError(err)
+			s.Equal(convID, res.ID)
+			s.Len(res.Members, 2)
+			s.Equal("url", res.Members[1].Avatar)
+			s.Equal(int64(3), res.UnreadCount)
+			s.Nil(res.LastMessage)
+		})
+	}
+}
+
+func (s *chatServiceSuite) TestListConversations() {
+	var userID int64 = 1
+
+	svc, m := s.newService()
+	m.conv.EXPECT().ListByMember(mock.Anything, domain.ConversationListFilter{
+		UserID:              userID,
+		BeforeLastMessageID: 50,
+		BeforeID:            7,
+		Limit:               2,
+	}).Return([]domain.Conversation{
+		{ID: 3, LastMessageID: 40},
+		{ID: 2, LastMessageID: 30},
+	}, nil).Once()
+	m.conv.EXPECT().ListMembers(mock.Anything, []int64{3}).Return(nil, nil).Once()
+	m.media.EXPECT().GetPublicURL("").Return("").Once()
+	m.msg.EXPECT().ListByIDs(mock.Anything, []int64{40}).
+		Return([]domain.Message{{ID: 40, ConversationID: 3, Seq: 9, Content: "hi"}}, nil).Once()
+
+	page, err := svc.ListConversations(context.Background(), domain.ListConversationsParams{
+		UserID: userID,
+		Page:   domain.PageParams{Cursor: pkg.EncodeCursor(50, 7), Limit: 1},
+	})
+
+	s.Require().NoError(err)
+	s.True(page.HasMore)
+	s.Equal(pkg.EncodeCursor(40, 3), page.NextCursor)
+	items := page.Items.([]domain.ConversationResponse)
+	s.Require().Len(items, 1)
+	s.Require().NotNil(items[0].LastMessage)
+	s.Equal(int64(9), items[0].LastMessage.Seq)
+}
+
+func (s *chatServiceSuite) TestSendMessage() {
+	var (
+		userID int64 = 1
+		convID int64 = 10
+	)
+
+	voiceKey := "messages/1/voice_chat/1_a.ogg"
+	member := &domain.ConversationMember{ConversationID: convID, UserID: userID}
+
+	tests := []struct {
+		name      string
+		input     domain.SendMessageParams
+		setupMock func(m chatMocks)
+		wantType  domain.MessageType
+		wantErr   error
+	}{
+		{
+			name:    "empty",
+			input:   domain.SendMessageParams{UserID: userID, ConversationID: convID, Content: "  "},
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name:  "not_member",
+			input: domain.SendMessageParams{UserID: userID, ConversationID: convID, Content: "hi"},
+			setupMock: func(m chatMocks) {
+				m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(nil, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name: "foreign_attachment",
+			input: domain.SendMessageParams{
+				UserID:         userID,
+				ConversationID: convID,
+				Attachment:     &domain.MessageAttachmentItem{ObjectKey: "messages/2/voice_chat/x.ogg", Feature: domain.FeatureVoiceChat},
+			},
+			setupMock: func(m chatMocks) {
+				m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(member, nil).Once()
+			},
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name:  "text",
+			input: domain.SendMessageParams{UserID: userID, ConversationID: convID, Content: " hi "},
+			setupMock: func(m chatMocks) {
+				m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(member, nil).Once()
+				m.msg.EXPECT().Create(mock.Anything, mock.MatchedBy(func(msg *domain.Message) bool {
+					return msg.Content == "hi" && msg.Type == domain.MessageText && msg.ObjectKey == nil
+				})).Run(func(_ context.Context, msg *domain.Message) { msg.ID, msg.Seq = 100, 5 }).Return(nil).Once()
+				m.conv.EXPECT().ListMemberIDs(mock.Anything, convID).Return([]int64{1, 2}, nil).Once()
+				m.realtime.EXPECT().SendToUsers(mock.Anything, []int64{1, 2}, mock.MatchedBy(func(rm domain.RealtimeMessage) bool {
+					res, ok := rm.Data.(domain.MessageResponse)
+					return rm.Type == domain.RealtimeMessageNew && ok && res.Seq == 5
+				})).Return(nil).Once()
+			},
+			wantType: domain.MessageText,
+		},
+		{
+			name: "voice_realtime_failure_ignored",
+			input: domain.SendMessageParams{
+				UserID:         userID,
+				ConversationID: convID,
+				Attachment:     &domain.MessageAttachmentItem{ObjectKey: voiceKey, Feature: domain.FeatureVoiceChat},
+			},
+			setupMock: func(m chatMocks) {
+				m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(member, nil).Once()
+				m.media.EXPECT().ConfirmUpload(mock.Anything, domain.ConfirmFileParams{
+					UserID:    userID,
+					ObjectKey: voiceKey,
+					Domain:    domain.DomainMessage,
+					Feature:   domain.FeatureVoiceChat,
+				}).Return(voiceKey, nil).Once()
+				m.msg.EXPECT().Create(mock.Anything, mock.MatchedBy(func(msg *domain.Message) bool {
+					return msg.Type == domain.MessageVoice && msg.ObjectKey != nil && *msg.ObjectKey == voiceKey
+				})).Return(nil).Once()
+				m.media.EXPECT().GetPublicURL(voiceKey).Return("url").Once()
+				m.conv.EXPECT().ListMemberIDs(mock.Anything, convID).Return([]int64{1, 2}, nil).Once()
+				m.realtime.EXPECT().SendToUsers(mock.Anything, []int64{1, 2}, mock.Anything).Return(assert.AnError).Once()
+			},
+			wantType: domain.MessageVoice,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			svc, m := s.newService()
+			if tc.setupMock != nil {
+				tc.setupMock(m)
+			}
+
+			res, err := svc.SendMessage(context.Background(), tc.input)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+				return
+			}
+			s.NoError(err)
+			s.Equal(tc.wantType, res.Type)
+		})
+	}
+}
+
+func (s *chatServiceSuite) TestListMessages() {
+	var (
+		userID int64 = 1
+		convID int64 = 10
+	)
+
+	member := &domain.ConversationMember{ConversationID: convID, UserID: userID}
+
+	s.Run("history", func() {
+		svc, m := s.newService()
+		m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(member, nil).Once()
+		m.msg.EXPECT().List(mock.Anything, domain.MessageListFilter{ConversationID: convID, BeforeSeq: 9, Limit: 3}).
+			Return([]domain.Message{{Seq: 8}, {Seq: 7}, {Seq: 6}}, nil).Once()
+
+		page, err := svc.ListMessages(context.Background(), domain.ListMessagesParams{
+			UserID:         userID,
+			ConversationID: convID,
+			Page:           domain.PageParams{Cursor: pkg.EncodeCursor(9), Limit: 2},
+		})
+
+		s.Require().NoError(err)
+		s.True(page.HasMore)
+		s.Equal(pkg.EncodeCursor(7), page.NextCursor)
+	})
+
+	s.Run("resync_after_seq", func() {
+		svc, m := s.newService()
+		afterSeq := int64(4)
+		m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(member, nil).Once()
+		m.msg.EXPECT().List(mock.Anything, domain.MessageListFilter{ConversationID: convID, AfterSeq: &afterSeq, Limit: 3}).
+			Return([]domain.Message{{Seq: 5}, {Seq: 6}, {Seq: 7}}, nil).Once()
+
+		page, err := svc.ListMessages(context.Background(), domain.ListMessagesParams{
+			UserID:         userID,
+			ConversationID: convID,
+			AfterSeq:       &afterSeq,
+			Page:           domain.PageParams{Cursor: "ignored", Limit: 2},
+		})
+
+		s.Require().NoError(err)
+		s.True(page.HasMore)
+		s.Empty(page.NextCursor)
+		items := page.Items.([]domain.MessageResponse)
+		s.Equal([]int64{5, 6}, []int64{items[0].Seq, items[1].Seq})
+	})
+
+	s.Run("not_member", func() {
+		svc, m := s.newService()
+		m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(nil, pkg.ErrNotFound).Once()
+
+		_, err := svc.ListMessages(context.Background(), domain.ListMessagesParams{UserID: userID, ConversationID: convID})
+
+		s.ErrorIs(err, pkg.ErrNotFound)
+	})
+}
+
+func (s *chatServiceSuite) TestCreateGroup() {
+	var userID int64 = 1
+
+	tests := []struct {
+		name      string
+		input     domain.CreateGroupChatParams
+		setupMock func(m chatMocks)
+		wantErr   error
+	}{
+		{
+			name:    "only_self",
+			input:   domain.CreateGroupChatParams{UserID: userID, Title: "Trip", MemberIDs: []int64{userID}},
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name:    "blank_title",
+			input:   domain.CreateGroupChatParams{UserID: userID, Title: "  ", MemberIDs: []int64{2}},
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name:  "member_not_found",
+			input: domain.CreateGroupChatParams{UserID: userID, Title: "Trip", MemberIDs: []int64{2}},
+			setupMock: func(m chatMocks) {
+				m.user.EXPECT().GetByID(mock.Anything, userID).Return(&domain.User{ID: userID, Username: "alice"}, nil).Once()
+				m.user.EXPECT().GetByID(mock.Anything, int64(2)).Return(nil, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name:  "success",
+			input: domain.CreateGroupChatParams{UserID: userID, Title: " Trip ", MemberIDs: []int64{2, 3, 2, userID}},
+			setupMock: func(m chatMocks) {
+				m.user.EXPECT().GetByID(mock.Anything, userID).Return(&domain.User{ID: userID, Username: "alice"}, nil).Once()
+				m.user.EXPECT().GetByID(mock.Anything, int64(2)).Return(&domain.User{ID: 2, Username: "bob"}, nil).Once()
+				m.user.EXPECT().GetByID(mock.Anything, int64(3)).Return(&domain.User{ID: 3, Username: "carol"}, nil).Once()
+				m.conv.EXPECT().CreateGroup(mock.Anything,
+					mock.MatchedBy(func(c *domain.Conversation) bool { return c.Title == "Trip" }),
+					[]domain.ConversationMember{
+						{UserID: userID, Role: domain.RoleOwner},
+						{UserID: 2, Role: domain.RoleMember},
+						{UserID: 3, Role: domain.RoleMember},
+					},
+					mock.MatchedBy(func(events []*domain.Message) bool {
+						return len(events) == 3 &&
+							events[0].Event == domain.EventGroupCreated &&
+							events[1].Event == domain.EventMemberAdded && *events[1].TargetID == 2 &&
+							events[2].Content == "alice added carol"
+					}),
+				).Run(func(_ context.Context, c *domain.Conversation, _ []domain.ConversationMember, events []*domain.Message) {
+					c.ID = 10
+					for i, ev := range events {
+						ev.ConversationID, ev.Seq = 10, int64(i+1)
+					}
+				}).Return(nil).Once()
+				m.conv.EXPECT().ListMemberIDs(mock.Anything, int64(10)).Return([]int64{1, 2, 3}, nil).Once()
+				m.realtime.EXPECT().SendToUsers(mock.Anything, []int64{1, 2, 3}, mock.Anything).Return(nil).Times(3)
+				m.conv.EXPECT().ListMembers(mock.Anything, []int64{10}).Return(nil, nil).Once()
+				m.msg.EXPECT().ListByIDs(mock.Anything, []int64(nil)).Return(nil, nil).Once()
+				m.media.EXPECT().GetPublicURL("").Return("").Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			svc, m := s.newService()
+			if tc.setupMock != nil {
+				tc.setupMock(m)
+			}
+
+			res, err := svc.CreateGroup(context.Background(), tc.input)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+				return
+			}
+			s.NoError(err)
+			s.Equal(int64(10), res.ID)
+			s.Equal("Trip", res.Title)
+		})
+	}
+}
+
+func (s *chatServiceSuite) TestUpdateGroup() {
+	var (
+		userID int64 = 1
+		convID int64 = 10
+	)
+
+	title := "New"
+	avatarKey := "messages/1/avatar/1_a.jpg"
+	group := func() *domain.Conversation {
+		return &domain.Conversation{ID: convID, Type: domain.ConversationGroup, Title: "Old", Avatar: "messages/1/avatar/old.jpg"}
+	}
+	admin := &domain.ConversationMember{ConversationID: convID, UserID: userID, Role: domain.RoleAdmin, User: domain.UserSummary{Username: "alice"}}
+
+	tests := []struct {
+		name      string
+		input     domain.UpdateGroupChatParams
+		setupMock func(m chatMocks)
+		wantErr   error
+	}{
+		{
+			name:  "direct_conversation",
+			input: domain.UpdateGroupChatParams{UserID: userID, ConversationID: convID, Title: &title},
+			setupMock: func(m chatMocks) {
+				m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(admin, nil).Once()
+				m.conv.EXPECT().GetByID(mock.Anything, convID).Return(&domain.Conversation{ID: convID, Type: domain.ConversationDirect}, nil).Once()
+			},
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name:  "plain_member",
+			input: domain.UpdateGroupChatParams{UserID: userID, ConversationID: convID, Title: &title},
+			setupMock: func(m chatMocks) {
+				m.conv.EXPECT().GetMember(mock.Anything, convID, userID).
+					Return(&domain.ConversationMember{UserID: userID, Role: domain.RoleMember}, nil).Once()
+				m.conv.EXPECT().GetByID(mock.Anything, convID).Return(group(), nil).Once()
+			},
+			wantErr: pkg.ErrForbidden,
+		},
+		{
+			name:  "rename_and_avatar",
+			input: domain.UpdateGroupChatParams{UserID: userID, ConversationID: convID, Title: &title, Avatar: &avatarKey},
+			setupMock: func(m chatMocks) {
+				m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(admin, nil).Once()
+				m.conv.EXPECT().GetByID(mock.Anything, convID).Return(group(), nil).Once()
+				m.media.EXPECT().ConfirmUpload(mock.Anything, domain.ConfirmFileParams{
+					UserID:    userID,
+					ObjectKey: avatarKey,
+					Domain:    domain.DomainMessage,
+					Feature:   domain.FeatureAvatar,
+				}).Return(avatarKey, nil).Once()
+				m.conv.EXPECT().Update(mock.Anything,
+					mock.MatchedBy(func(c *domain.Conversation) bool { return c.Title == title && c.Avatar == avatarKey }),
+					mock.MatchedBy(func(events []*domain.Message) bool {
+						return len(events) == 2 && events[0].Event == domain.EventGroupRenamed &&
+							events[1].Event == domain.EventAvatarChanged
+					}),
+				).Return(nil).Once()
+				m.media.EXPECT().DeleteFile(mock.Anything, "messages/1/avatar/old.jpg").Return(assert.AnError).Once()
+				m.conv.EXPECT().ListMemberIDs(mock.Anything, convID).Return([]int64{1, 2}, nil).Once()
+				m.realtime.EXPECT().SendToUsers(mock.Anything, []int64{1, 2}, mock.Anything).Return(nil).Twice()
+				m.conv.EXPECT().ListMembers(mock.Anything, []int64{convID}).Return(nil, nil).Once()
+				m.msg.EXPECT().ListByIDs(mock.Anything, []int64(nil)).Return(nil, nil).Once()
+				m.media.EXPECT().GetPublicURL(avatarKey).Return("url").Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			svc, m := s.newService()
+			if tc.setupMock != nil {
+				tc.setupMock(m)
+			}
+
+			res, err := svc.UpdateGroup(context.Background(), tc.input)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+				return
+			}
+			s.NoError(err)
+			s.Equal(title, res.Title)
+			s.Equal("url", res.Avatar)
+		})
+	}
+}
+
+func (s *chatServiceSuite) TestAddMembers() {
+	var (
+		userID int64 = 1
+		convID int64 = 10
+	)
+
+	svc, m := s.newService()
+	m.conv.EXPECT().GetMember(mock.Anything, convID, userID).
+		Return(&domain.ConversationMember{UserID: userID, Role: domain.RoleOwner, User: domain.UserSummary{Username: "alice"}}, nil).Once()
+	m.conv.EXPECT().GetByID(mock.Anything, convID).Return(&domain.Conversation{ID: convID, Type: domain.ConversationGroup}, nil).Once()
+	m.conv.EXPECT().ListMemberIDs(mock.Anything, convID).Return([]int64{1, 2}, nil).Once()
+	m.user.EXPECT().GetByID(mock.Anything, int64(3)).Return(&domain.User{ID: 3, Username: "carol"}, nil).Once()
+	m.conv.EXPECT().AddMembers(mock.Anything, convID, []int64{3}, mock.MatchedBy(func(events []*domain.Message) bool {
+		return len(events) == 1 && events[0].Content == "alice added carol"
+	})).Return(nil).Once()
+	m.conv.EXPECT().ListMemberIDs(mock.Anything, convID).Return([]int64{1, 2, 3}, nil).Once()
+	m.realtime.EXPECT().SendToUsers(mock.Anything, []int64{1, 2, 3}, mock.Anything).Return(nil).Once()
+	m.conv.EXPECT().ListMembers(mock.Anything, []int64{convID}).Return(nil, nil).Once()
+	m.msg.EXPECT().ListByIDs(mock.Anything, []int64(nil)).Return(nil, nil).Once()
+	m.media.EXPECT().GetPublicURL("").Return("").Once()
+
+	_, err := svc.AddMembers(context.Background(), domain.AddMembersParams{
+		UserID:         userID,
+		ConversationID: convID,
+		UserIDs:        []int64{2, 3},
+	})
+
+	s.NoError(err)
+}
+
+func (s *chatServiceSuite) TestRemoveMember() {
+	var (
+		userID   int64 = 1
+		memberID int64 = 2
+		convID   int64 = 10
+	)
+
+	group := &domain.Conversation{ID: convID, Type: domain.ConversationGroup}
+	actor := func(role domain.MemberRole) *domain.ConversationMember {
+		return &domain.ConversationMember{UserID: userID, Role: role, User: domain.UserSummary{Username: "alice"}}
+	}
+	target := func(role domain.MemberRole) *domain.ConversationMember {
+		return &domain.ConversationMember{UserID: memberID, Role: role, User: domain.UserSummary{Username: "bob"}}
+	}
+
+	tests := []struct {
+		name      string
+		memberID  int64
+		setupMock func(m chatMocks)
+		wantErr   error
+	}{
+		{
+			name:     "self",
+			memberID: userID,
+			wantErr:  pkg.ErrInvalidData,
+		},
+		{
+			name:     "admin_removes_admin",
+			memberID: memberID,
+			setupMock: func(m chatMocks) {
+				m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(actor(domain.RoleAdmin), nil).Once()
+				m.conv.EXPECT().GetByID(mock.Anything, convID).Return(group, nil).Once()
+				m.conv.EXPECT().GetMember(mock.Anything, convID, memberID).Return(target(domain.RoleAdmin), nil).Once()
+			},
+			wantErr: pkg.ErrForbidden,
+		},
+		{
+			name:     "owner_removes_admin",
+			memberID: memberID,
+			setupMock: func(m chatMocks) {
+				m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(actor(domain.RoleOwner), nil).Once()
+				m.conv.EXPECT().GetByID(mock.Anything, convID).Return(group, nil).Once()
+				m.conv.EXPECT().GetMember(mock.Anything, convID, memberID).Return(target(domain.RoleAdmin), nil).Once()
+				m.conv.EXPECT().RemoveMember(mock.Anything, convID, memberID, mock.MatchedBy(func(ev *domain.Message) bool {
+					return ev.Event == domain.EventMemberRemoved && ev.Content == "alice removed bob"
+				})).Return(nil).Once()
+				m.conv.EXPECT().ListMemberIDs(mock.Anything, convID).Return([]int64{1}, nil).Once()
+				m.realtime.EXPECT().SendToUsers(mock.Anything, []int64{1, memberID}, mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			svc, m := s.newService()
+			if tc.setupMock != nil {
+				tc.setupMock(m)
+			}
+
+			err := svc.RemoveMember(context.Background(), domain.RemoveMemberParams{
+				UserID:         userID,
+				ConversationID: convID,
+				MemberID:       tc.memberID,
+			})
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+				return
+			}
+			s.NoError(err)
+		})
+	}
+}
+
+func (s *chatServiceSuite) TestUpdateMemberRole() {
+	var (
+		userID   int64 = 1
+		memberID int64 = 2
+		convID   int64 = 10
+	)
+
+	group := &domain.Conversation{ID: convID, Type: domain.ConversationGroup}
+	input := domain.UpdateMemberRoleParams{UserID: userID, ConversationID: convID, MemberID: memberID, Role: domain.RoleAdmin}
+
+	s.Run("not_owner", func() {
+		svc, m := s.newService()
+		m.conv.EXPECT().GetMember(mock.Anything, convID, userID).
+			Return(&domain.ConversationMember{UserID: userID, Role: domain.RoleAdmin}, nil).Once()
+		m.conv.EXPECT().GetByID(mock.Anything, convID).Return(group, nil).Once()
+
+		s.ErrorIs(svc.UpdateMemberRole(context.Background(), input), pkg.ErrForbidden)
+	})
+
+	s.Run("promote", func() {
+		svc, m := s.newService()
+		m.conv.EXPECT().GetMember(mock.Anything, convID, userID).
+			Return(&domain.ConversationMember{UserID: userID, Role: domain.RoleOwner, User: domain.UserSummary{Username: "alice"}}, nil).Once()
+		m.conv.EXPECT().GetByID(mock.Anything, convID).Return(group, nil).Once()
+		m.conv.EXPECT().GetMember(mock.Anything, convID, memberID).
+			Return(&domain.ConversationMember{UserID: memberID, Role: domain.RoleMember, User: domain.UserSummary{Username: "bob"}}, nil).Once()
+		m.conv.EXPECT().UpdateMemberRole(mock.Anything, convID, memberID, domain.RoleAdmin, mock.MatchedBy(func(ev *domain.Message) bool {
+			return ev.Event == domain.EventRoleChanged && *ev.TargetID == memberID
+		})).Return(nil).Once()
+		m.conv.EXPECT().ListMemberIDs(mock.Anything, convID).Return([]int64{1, 2}, nil).Once()
+		m.realtime.EXPECT().SendToUsers(mock.Anything, []int64{1, 2}, mock.Anything).Return(nil).Once()
+
+		s.NoError(svc.UpdateMemberRole(context.Background(), input))
+	})
+}
+
+func (s *chatServiceSuite) TestLeave() {
+	var (
+		userID int64 = 1
+		convID int64 = 10
+	)
+
+	svc, m := s.newService()
+	m.conv.EXPECT().GetMember(mock.Anything, convID, userID).
+		Return(&domain.ConversationMember{UserID: userID, Role: domain.RoleOwner, User: domain.UserSummary{Username: "alice"}}, nil).Once()
+	m.conv.EXPECT().GetByID(mock.Anything, convID).Return(&domain.Conversation{ID: convID, Type: domain.ConversationGroup}, nil).Once()
+	m.conv.EXPECT().RemoveMember(mock.Anything, convID, userID, mock.MatchedBy(func(ev *domain.Message) bool {
+		return ev.Event == domain.EventMemberLeft && ev.Content == "alice left"
+	})).Return(nil).Once()
+	m.conv.EXPECT().ListMemberIDs(mock.Anything, convID).Return([]int64{2}, nil).Once()
+	m.realtime.EXPECT().SendToUsers(mock.Anything, []int64{2, userID}, mock.Anything).Return(nil).Once()
+
+	s.NoError(svc.Leave(context.Background(), userID, convID))
+}
+
+func (s *chatServiceSuite) TestMarkRead() {
+	var (
+		userID int64 = 1
+		convID int64 = 10
+	)
+
+	member := &domain.ConversationMember{ConversationID: convID, UserID: userID}
+	input := domain.ReceiptParams{UserID: userID, ConversationID: convID, Seq: 9}
+
+	tests := []struct {
+		name      string
+		input     domain.ReceiptParams
+		setupMock func(m chatMocks)
+		wantErr   error
+	}{
+		{
+			name:    "invalid_seq",
+			input:   domain.ReceiptParams{UserID: userID, ConversationID: convID},
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name:  "not_member",
+			input: input,
+			setupMock: func(m chatMocks) {
+				m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(nil, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name:  "stale_ack",
+			input: input,
+			setupMock: func(m chatMocks) {
+				m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(member, nil).Once()
+				m.conv.EXPECT().MarkRead(mock.Anything, convID, userID, int64(9)).Return(0, nil).Once()
+			},
+		},
+		{
+			name:  "advanced_capped",
+			input: input,
+			setupMock: func(m chatMocks) {
+				m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(member, nil).Once()
+				m.conv.EXPECT().MarkRead(mock.Anything, convID, userID, int64(9)).Return(8, nil).Once()
+				m.conv.EXPECT().ListMemberIDs(mock.Anything, convID).Return([]int64{1, 2}, nil).Once()
+				m.realtime.EXPECT().SendToUsers(mock.Anything, []int64{1, 2}, domain.RealtimeMessage{
+					Type: domain.RealtimeMessageRead,
+					Data: domain.ReceiptData{ConversationID: convID, UserID: userID, Seq: 8},
+				}).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			svc, m := s.newService()
+			if tc.setupMock != nil {
+				tc.setupMock(m)
+			}
+
+			err := svc.MarkRead(context.Background(), tc.input)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+				return
+			}
+			s.NoError(err)
+		})
+	}
+}
+
+func (s *chatServiceSuite) TestMarkDelivered() {
+	var (
+		userID int64 = 2
+		convID int64 = 10
+	)
+
+	svc, m := s.newService()
+	m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(&domain.ConversationMember{UserID: userID}, nil).Once()
+	m.conv.EXPECT().MarkDelivered(mock.Anything, convID, userID, int64(5)).Return(5, nil).Once()
+	m.conv.EXPECT().ListMemberIDs(mock.Anything, convID).Return([]int64{1, 2}, nil).Once()
+	m.realtime.EXPECT().SendToUsers(mock.Anything, []int64{1, 2}, mock.MatchedBy(func(rm domain.RealtimeMessage) bool {
+		return rm.Type == domain.RealtimeMessageDelivered
+	})).Return(nil).Once()
+
+	s.NoError(svc.MarkDelivered(context.Background(), domain.ReceiptParams{UserID: userID, ConversationID: convID, Seq: 5}))
+}
+
+func (s *chatServiceSuite) TestTyping() {
+	var (
+		userID int64 = 1
+		convID int64 = 10
+	)
+
+	svc, m := s.newService()
+	m.conv.EXPECT().GetMember(mock.Anything, convID, userID).Return(&domain.ConversationMember{UserID: userID}, nil).Once()
+	m.conv.EXPECT().ListMemberIDs(mock.Anything, convID).Return([]int64{1, 2, 3}, nil).Once()
+	m.realtime.EXPECT().SendToUsers(mock.Anything, []int64{2, 3}, domain.RealtimeMessage{
+		Type: domain.RealtimeTyping,
+		Data: domain.TypingData{ConversationID: convID, UserID: userID, Typing: true},
+	}).Return(nil).Once()
+
+	s.NoError(svc.Typing(context.Background(), domain.TypingParams{UserID: userID, ConversationID: convID, Typing: true}))
+}
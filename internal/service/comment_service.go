@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+type CommentService interface {
+	Create(ctx context.Context, input domain.CreateCommentParams) (domain.CommentResponse, error)
+	Update(ctx context.Context, input domain.UpdateCommentParams) (domain.CommentResponse, error)
+	Delete(ctx context.Context, userID, commentID int64) error
+	ListByPost(ctx context.Context, input domain.ListCommentsParams) (domain.Page, error)
+	ListReplies(ctx context.Context, input domain.ListRepliesParams) (domain.Page, error)
+}
+
+type CommentServiceImpl struct {
+	commentRepo domain.CommentRepository
+	postSvc     PostService
+	reactionSvc ReactionService
+	mediaSvc    MediaService
+}
+
+func NewCommentService(
+	commentRepo domain.CommentRepository,
+	postSvc PostService,
+	reactionSvc ReactionService,
+	mediaSvc MediaService,
+) *CommentServiceImpl {
+	return &CommentServiceImpl{
+		commentRepo: commentRepo,
+		postSvc:     postSvc,
+		reactionSvc: reactionSvc,
+		mediaSvc:    mediaSvc,
+	}
+}
+
+func (s *CommentServiceImpl) Create(ctx context.Context, input domain.CreateCommentParams) (domain.CommentResponse, error) {
+	var empty domain.CommentResponse
+
+	content := strings.TrimSpace(input.Content)
+	if content == "" {
+		return empty, pkg.ErrInvalidData
+	}
+
+	// Only posts the user can read may be commented on.
+	if _, err := s.postSvc.GetByID(ctx, input.UserID, input.PostID); err != nil {
+		return empty, err
+	}
+
+	if input.ParentID != nil {
+		parent, err := s.commentRepo.GetByID(ctx, *input.ParentID)
+		if err != nil {
+			return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+		}
+		if parent.PostID != input.PostID {
+			return empty, pkg.ErrInvalidData
+		}
+	}
+
+	comment := &domain.Comment{
+		PostID:   input.PostID,
+		AuthorID: input.UserID,
+		ParentID: input.ParentID,
+		Content:  content,
+	}
+
+	if err := s.commentRepo.Create(ctx, comment); err != nil {
+		// The parent may have been deleted in the meantime.
+		return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	return s.mapToResponse(comment), nil
+}
+
+func (s *CommentServiceImpl) Update(ctx context.Context, input domain.UpdateCommentParams) (domain.CommentResponse, error) {
+	var empty domain.CommentResponse
+
+	content := strings.TrimSpace(input.Content)
+	if content == "" {
+		return empty, pkg.ErrInvalidData
+	}
+
+	comment, err := s.commentRepo.GetByID(ctx, input.CommentID)
+	if err != nil {
+		return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	if comment.AuthorID != input.UserID {
+		return empty, pkg.ErrForbidden
+	}
+
+	comment.Content = content
+	if err := s.commentRepo.Update(ctx, comment); err != nil {
+		return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	items := []domain.CommentResponse{s.mapToResponse(comment)}
+	if err := s.attachReactions(ctx, input.UserID, items); err != nil {
+		return empty, err
+	}
+	return items[0], nil
+}
+
+// Delete removes a comment and its replies. Besides the author, the owner of
+// the post may moderate comments under it.
+func (s *CommentServiceImpl) Delete(ctx context.Context, userID, commentID int64) error {
+	comment, err := s.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	if comment.AuthorID != userID {
+		post, err := s.postSvc.GetByID(ctx, userID, comment.PostID)
+		if err != nil {
+			return pkg.OrInternalError(err, pkg.ErrNotFound)
+		}
+		if post.AuthorID != userID {
+			return pkg.ErrForbidden
+		}
+	}
+
+	if _, err := s.commentRepo.Delete(ctx, comment); err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	return nil
+}
+
+func (s *CommentServiceImpl) ListByPost(ctx context.Context, input domain.ListCommentsParams) (domain.Page, error) {
+	if _, err := s.postSvc.GetByID(ctx, input.ViewerID, input.PostID); err != nil {
+		return domain.Page{}, err
+	}
+
+	filter := domain.CommentListFilter{PostID: input.PostID, Sort: input.Sort}
+	return s.list(ctx, input.ViewerID, filter, input.Page)
+}
+
+func (s *CommentServiceImpl) ListReplies(ctx context.Context, input domain.ListRepliesParams) (domain.Page, error) {
+	parent, err := s.commentRepo.GetByID(ctx, input.CommentID)
+	if err != nil {
+		return domain.Page{}, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	if _, err := s.postSvc.GetByID(ctx, input.ViewerID, parent.PostID); err != nil {
+		return domain.Page{}, err
+	}
+
+	filter := domain.CommentListFilter{PostID: parent.PostID, ParentID: parent.ID, Sort: input.Sort}
+	return s.list(ctx, input.ViewerID, filter, input.Page)
+}
+
+// Internal helpers
+
+// list pages through one level of the comment tree. Newest order uses the
+// comment ID as cursor; top order ranks by reply_count (reactions are not
+// considered) and uses the (reply_count, id) pair. That key changes while a
+// client pages, so a comment gaining or losing replies between two requests
+// can be skipped or returned twice under top order.
+func (s *CommentServiceImpl) list(
+	ctx context.Context,
+	viewerID int64,
+	filter domain.CommentListFilter,
+	page domain.PageParams,
+) (domain.Page, error) {
+	var empty domain.Page
+
+	if filter.Sort == "" {
+		filter.Sort = domain.CommentSortNewest
+	}
+
+	switch filter.Sort {
+	case domain.CommentSortTop:
+		keys, err := pkg.DecodeCursor(page.Cursor, 2)
+		if err != nil {
+			return empty, err
+		}
+		if keys != nil {
+			filter.BeforeReplyCount, filter.BeforeID = keys[0], keys[1]
+		}
+	default:
+		beforeID, err := decodeIDCursor(page.Cursor)
+		if err != nil {
+			return empty, err
+		}
+		filter.BeforeID = beforeID
+	}
+
+	limit := page.Size()
+	filter.Limit = limit + 1
+
+	comments, err := s.commentRepo.List(ctx, filter)
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	items := make([]domain.CommentResponse, 0, len(comments))
+	for i := range comments {
+		items = append(items, s.mapToResponse(&comments[i]))
+	}
+
+	result := newPage(items, limit, func(c domain.CommentResponse) string {
+		if filter.Sort == domain.CommentSortTop {
+			return pkg.EncodeCursor(int64(c.ReplyCount), c.ID)
+		}
+		return pkg.EncodeCursor(c.ID)
+	})
+	if err := s.attachReactions(ctx, viewerID, result.Items.([]domain.CommentResponse)); err != nil {
+		return empty, err
+	}
+	return result, nil
+}
+
+func (s *CommentServiceImpl) attachReactions(ctx context.Context, viewerID int64, items []domain.CommentResponse) error {
+	return attachReactions(ctx, s.reactionSvc, viewerID, domain.ReactionTargetComment, items,
+		func(c *domain.CommentResponse) (int64, *domain.ReactionSummary) { return c.ID, &c.Reactions })
+}
+
+func (s *CommentServiceImpl) mapToResponse(comment *domain.Comment) domain.CommentResponse {
+	res := comment.ToResponse()
+	res.Author.Avatar = s.mediaSvc.GetPublicURL(res.Author.Avatar)
+	return res
+}
@@ -0,0 +1,413 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"air-social/internal/domain"
+	"air-social/internal/mocks"
+	"air-social/pkg"
+)
+
+type commentServiceSuite struct {
+	suite.Suite
+}
+
+func TestCommentServiceSuite(t *testing.T) {
+	suite.Run(t, new(commentServiceSuite))
+}
+
+func (s *commentServiceSuite) TestCreate() {
+	var (
+		userID   int64 = 1
+		postID   int64 = 10
+		parentID int64 = 100
+	)
+
+	tests := []struct {
+		name      string
+		input     domain.CreateCommentParams
+		setupMock func(repo *mocks.CommentRepository, post *mocks.PostService, media *mocks.MediaService)
+		wantErr   error
+	}{
+		{
+			name:    "empty_content",
+			input:   domain.CreateCommentParams{UserID: userID, PostID: postID, Content: "  "},
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name:  "post_hidden",
+			input: domain.CreateCommentParams{UserID: userID, PostID: postID, Content: "hi"},
+			setupMock: func(repo *mocks.CommentRepository, post *mocks.PostService, media *mocks.MediaService) {
+				post.EXPECT().GetByID(mock.Anything, userID, postID).Return(domain.PostResponse{}, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name:  "parent_not_found",
+			input: domain.CreateCommentParams{UserID: userID, PostID: postID, ParentID: &parentID, Content: "hi"},
+			setupMock: func(repo *mocks.CommentRepository, post *mocks.PostService, media *mocks.MediaService) {
+				post.EXPECT().GetByID(mock.Anything, userID, postID).Return(domain.PostResponse{ID: postID}, nil).Once()
+				repo.EXPECT().GetByID(mock.Anything, parentID).Return(nil, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name:  "parent_on_other_post",
+			input: domain.CreateCommentParams{UserID: userID, PostID: postID, ParentID: &parentID, Content: "hi"},
+			setupMock: func(repo *mocks.CommentRepository, post *mocks.PostService, media *mocks.MediaService) {
+				post.EXPECT().GetByID(mock.Anything, userID, postID).Return(domain.PostResponse{ID: postID}, nil).Once()
+				repo.EXPECT().GetByID(mock.Anything, parentID).Return(&domain.Comment{ID: parentID, PostID: 99}, nil).Once()
+			},
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name:  "repo_error",
+			input: domain.CreateCommentParams{UserID: userID, PostID: postID, Content: "hi"},
+			setupMock: func(repo *mocks.CommentRepository, post *mocks.PostService, media *mocks.MediaService) {
+				post.EXPECT().GetByID(mock.Anything, userID, postID).Return(domain.PostResponse{ID: postID}, nil).Once()
+				repo.EXPECT().Create(mock.Anything, mock.Anything).Return(assert.AnError).Once()
+			},
+			wantErr: pkg.ErrInternal,
+		},
+		{
+			name:  "success_reply",
+			input: domain.CreateCommentParams{UserID: userID, PostID: postID, ParentID: &parentID, Content: " hi "},
+			setupMock: func(repo *mocks.CommentRepository, post *mocks.PostService, media *mocks.MediaService) {
+				post.EXPECT().GetByID(mock.Anything, userID, postID).Return(domain.PostResponse{ID: postID}, nil).Once()
+				repo.EXPECT().GetByID(mock.Anything, parentID).Return(&domain.Comment{ID: parentID, PostID: postID}, nil).Once()
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(c *domain.Comment) bool {
+					return c.AuthorID == userID && c.PostID == postID && *c.ParentID == parentID && c.Content == "hi"
+				})).RunAndReturn(func(_ context.Context, c *domain.Comment) error {
+					c.ID, c.Depth = 101, 1
+					c.Author = domain.UserSummary{ID: userID, Avatar: "avatar/1.jpg"}
+					return nil
+				}).Once()
+				media.EXPECT().GetPublicURL("avatar/1.jpg").Return("http://cdn/avatar/1.jpg").Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockRepo := mocks.NewCommentRepository(s.T())
+			mockPost := mocks.NewPostService(s.T())
+			mockMedia := mocks.NewMediaService(s.T())
+			svc := NewCommentService(mockRepo, mockPost, mocks.NewReactionService(s.T()), mockMedia)
+
+			if tc.setupMock != nil {
+				tc.setupMock(mockRepo, mockPost, mockMedia)
+			}
+
+			got, err := svc.Create(context.Background(), tc.input)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+				s.Empty(got)
+			} else {
+				s.NoError(err)
+				s.Equal(int64(101), got.ID)
+				s.Equal(1, got.Depth)
+				s.Equal("hi", got.Content)
+				s.Equal("http://cdn/avatar/1.jpg", got.Author.Avatar)
+			}
+		})
+	}
+}
+
+func (s *commentServiceSuite) TestUpdate() {
+	var (
+		userID    int64 = 1
+		commentID int64 = 100
+	)
+
+	tests := []struct {
+		name      string
+		input     domain.UpdateCommentParams
+		setupMock func(repo *mocks.CommentRepository, reaction *mocks.ReactionService, media *mocks.MediaService)
+		wantErr   error
+	}{
+		{
+			name:    "empty_content",
+			input:   domain.UpdateCommentParams{UserID: userID, CommentID: commentID},
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name:  "not_found",
+			input: domain.UpdateCommentParams{UserID: userID, CommentID: commentID, Content: "edit"},
+			setupMock: func(repo *mocks.CommentRepository, reaction *mocks.ReactionService, media *mocks.MediaService) {
+				repo.EXPECT().GetByID(mock.Anything, commentID).Return(nil, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name:  "not_author",
+			input: domain.UpdateCommentParams{UserID: userID, CommentID: commentID, Content: "edit"},
+			setupMock: func(repo *mocks.CommentRepository, reaction *mocks.ReactionService, media *mocks.MediaService) {
+				repo.EXPECT().GetByID(mock.Anything, commentID).Return(&domain.Comment{ID: commentID, AuthorID: 2}, nil).Once()
+			},
+			wantErr: pkg.ErrForbidden,
+		},
+		{
+			name:  "success",
+			input: domain.UpdateCommentParams{UserID: userID, CommentID: commentID, Content: "edit"},
+			setupMock: func(repo *mocks.CommentRepository, reaction *mocks.ReactionService, media *mocks.MediaService) {
+				createdAt := time.Now().Add(-time.Hour)
+				repo.EXPECT().GetByID(mock.Anything, commentID).Return(&domain.Comment{
+					ID: commentID, AuthorID: userID, Content: "old", CreatedAt: createdAt, UpdatedAt: createdAt,
+				}, nil).Once()
+				repo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(c *domain.Comment) bool {
+					return c.Content == "edit"
+				})).RunAndReturn(func(_ context.Context, c *domain.Comment) error {
+					c.UpdatedAt = time.Now()
+					return nil
+				}).Once()
+				media.EXPECT().GetPublicURL("").Return("").Once()
+				reaction.EXPECT().Summaries(mock.Anything, userID, domain.ReactionTargetComment, []int64{commentID}).
+					Return(map[int64]domain.ReactionSummary{commentID: domain.NewReactionSummary(domain.ReactionCounts{domain.ReactionLike: 2}, "")}, nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockRepo := mocks.NewCommentRepository(s.T())
+			mockReaction := mocks.NewReactionService(s.T())
+			mockMedia := mocks.NewMediaService(s.T())
+			svc := NewCommentService(mockRepo, mocks.NewPostService(s.T()), mockReaction, mockMedia)
+
+			if tc.setupMock != nil {
+				tc.setupMock(mockRepo, mockReaction, mockMedia)
+			}
+
+			got, err := svc.Update(context.Background(), tc.input)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+				s.Empty(got)
+			} else {
+				s.NoError(err)
+				s.Equal("edit", got.Content)
+				s.True(got.Edited)
+				s.Equal(int64(2), got.Reactions.Total)
+			}
+		})
+	}
+}
+
+func (s *commentServiceSuite) TestDelete() {
+	var (
+		userID    int64 = 1
+		postID    int64 = 10
+		commentID int64 = 100
+	)
+
+	tests := []struct {
+		name      string
+		setupMock func(repo *mocks.CommentRepository, post *mocks.PostService)
+		wantErr   error
+	}{
+		{
+			name: "not_found",
+			setupMock: func(repo *mocks.CommentRepository, post *mocks.PostService) {
+				repo.EXPECT().GetByID(mock.Anything, commentID).Return(nil, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name: "by_author",
+			setupMock: func(repo *mocks.CommentRepository, post *mocks.PostService) {
+				c := &domain.Comment{ID: commentID, PostID: postID, AuthorID: userID}
+				repo.EXPECT().GetByID(mock.Anything, commentID).Return(c, nil).Once()
+				repo.EXPECT().Delete(mock.Anything, c).Return(3, nil).Once()
+			},
+		},
+		{
+			name: "by_post_owner",
+			setupMock: func(repo *mocks.CommentRepository, post *mocks.PostService) {
+				c := &domain.Comment{ID: commentID, PostID: postID, AuthorID: 2}
+				repo.EXPECT().GetByID(mock.Anything, commentID).Return(c, nil).Once()
+				post.EXPECT().GetByID(mock.Anything, userID, postID).Return(domain.PostResponse{ID: postID, AuthorID: userID}, nil).Once()
+				repo.EXPECT().Delete(mock.Anything, c).Return(1, nil).Once()
+			},
+		},
+		{
+			name: "by_stranger",
+			setupMock: func(repo *mocks.CommentRepository, post *mocks.PostService) {
+				c := &domain.Comment{ID: commentID, PostID: postID, AuthorID: 2}
+				repo.EXPECT().GetByID(mock.Anything, commentID).Return(c, nil).Once()
+				post.EXPECT().GetByID(mock.Anything, userID, postID).Return(domain.PostResponse{ID: postID, AuthorID: 3}, nil).Once()
+			},
+			wantErr: pkg.ErrForbidden,
+		},
+		{
+			name: "repo_error",
+			setupMock: func(repo *mocks.CommentRepository, post *mocks.PostService) {
+				c := &domain.Comment{ID: commentID, PostID: postID, AuthorID: userID}
+				repo.EXPECT().GetByID(mock.Anything, commentID).Return(c, nil).Once()
+				repo.EXPECT().Delete(mock.Anything, c).Return(0, assert.AnError).Once()
+			},
+			wantErr: pkg.ErrInternal,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockRepo := mocks.NewCommentRepository(s.T())
+			mockPost := mocks.NewPostService(s.T())
+			svc := NewCommentService(mockRepo, mockPost, mocks.NewReactionService(s.T()), mocks.NewMediaService(s.T()))
+
+			if tc.setupMock != nil {
+				tc.setupMock(mockRepo, mockPost)
+			}
+
+			err := svc.Delete(context.Background(), userID, commentID)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *commentServiceSuite) TestListByPost() {
+	var (
+		userID int64 = 1
+		postID int64 = 10
+	)
+
+	s.Run("invalid_top_cursor", func() {
+		mockPost := mocks.NewPostService(s.T())
+		svc := NewCommentService(mocks.NewCommentRepository(s.T()), mockPost, mocks.NewReactionService(s.T()), mocks.NewMediaService(s.T()))
+
+		mockPost.EXPECT().GetByID(mock.Anything, userID, postID).Return(domain.PostResponse{ID: postID}, nil).Once()
+
+		_, err := svc.ListByPost(context.Background(), domain.ListCommentsParams{
+			ViewerID: userID,
+			PostID:   postID,
+			Sort:     domain.CommentSortTop,
+			Page:     domain.PageParams{Cursor: pkg.EncodeCursor(5)},
+		})
+		s.ErrorIs(err, pkg.ErrBadRequest)
+	})
+
+	s.Run("top_has_more", func() {
+		mockRepo := mocks.NewCommentRepository(s.T())
+		mockPost := mocks.NewPostService(s.T())
+		mockReaction := mocks.NewReactionService(s.T())
+		mockMedia := mocks.NewMediaService(s.T())
+		svc := NewCommentService(mockRepo, mockPost, mockReaction, mockMedia)
+
+		mockPost.EXPECT().GetByID(mock.Anything, userID, postID).Return(domain.PostResponse{ID: postID}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything, domain.CommentListFilter{
+			PostID:           postID,
+			Sort:             domain.CommentSortTop,
+			BeforeID:         50,
+			BeforeReplyCount: 4,
+			Limit:            3,
+		}).Return([]domain.Comment{
+			{ID: 40, ReplyCount: 4},
+			{ID: 45, ReplyCount: 2},
+			{ID: 30, ReplyCount: 2},
+		}, nil).Once()
+		mockMedia.EXPECT().GetPublicURL("").Return("").Times(3)
+		// The look-ahead row is dropped before reactions are loaded.
+		mockReaction.EXPECT().Summaries(mock.Anything, userID, domain.ReactionTargetComment, []int64{40, 45}).
+			Return(map[int64]domain.ReactionSummary{}, nil).Once()
+
+		page, err := svc.ListByPost(context.Background(), domain.ListCommentsParams{
+			ViewerID: userID,
+			PostID:   postID,
+			Sort:     domain.CommentSortTop,
+			Page:     domain.PageParams{Cursor: pkg.EncodeCursor(4, 50), Limit: 2},
+		})
+		s.NoError(err)
+		s.True(page.HasMore)
+		s.Equal(pkg.EncodeCursor(2, 45), page.NextCursor)
+		s.Len(page.Items.([]domain.CommentResponse), 2)
+	})
+
+	s.Run("newest_by_default", func() {
+		mockRepo := mocks.NewCommentRepository(s.T())
+		mockPost := mocks.NewPostService(s.T())
+		svc := NewCommentService(mockRepo, mockPost, mocks.NewReactionService(s.T()), mocks.NewMediaService(s.T()))
+
+		mockPost.EXPECT().GetByID(mock.Anything, userID, postID).Return(domain.PostResponse{ID: postID}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything, domain.CommentListFilter{
+			PostID: postID,
+			Sort:   domain.CommentSortNewest,
+			Limit:  domain.DefaultPageLimit + 1,
+		}).Return(nil, nil).Once()
+
+		page, err := svc.ListByPost(context.Background(), domain.ListCommentsParams{ViewerID: userID, PostID: postID})
+		s.NoError(err)
+		s.False(page.HasMore)
+		s.Empty(page.Items)
+	})
+}
+
+func (s *commentServiceSuite) TestListReplies() {
+	var (
+		userID    int64 = 1
+		postID    int64 = 10
+		commentID int64 = 100
+	)
+
+	s.Run("parent_not_found", func() {
+		mockRepo := mocks.NewCommentRepository(s.T())
+		svc := NewCommentService(mockRepo, mocks.NewPostService(s.T()), mocks.NewReactionService(s.T()), mocks.NewMediaService(s.T()))
+
+		mockRepo.EXPECT().GetByID(mock.Anything, commentID).Return(nil, pkg.ErrNotFound).Once()
+
+		_, err := svc.ListReplies(context.Background(), domain.ListRepliesParams{ViewerID: userID, CommentID: commentID})
+		s.ErrorIs(err, pkg.ErrNotFound)
+	})
+
+	s.Run("post_hidden", func() {
+		mockRepo := mocks.NewCommentRepository(s.T())
+		mockPost := mocks.NewPostService(s.T())
+		svc := NewCommentService(mockRepo, mockPost, mocks.NewReactionService(s.T()), mocks.NewMediaService(s.T()))
+
+		mockRepo.EXPECT().GetByID(mock.Anything, commentID).Return(&domain.Comment{ID: commentID, PostID: postID}, nil).Once()
+		mockPost.EXPECT().GetByID(mock.Anything, userID, postID).Return(domain.PostResponse{}, pkg.ErrNotFound).Once()
+
+		_, err := svc.ListReplies(context.Background(), domain.ListRepliesParams{ViewerID: userID, CommentID: commentID})
+		s.ErrorIs(err, pkg.ErrNotFound)
+	})
+
+	s.Run("success", func() {
+		mockRepo := mocks.NewCommentRepository(s.T())
+		mockPost := mocks.NewPostService(s.T())
+		mockReaction := mocks.NewReactionService(s.T())
+		mockMedia := mocks.NewMediaService(s.T())
+		svc := NewCommentService(mockRepo, mockPost, mockReaction, mockMedia)
+
+		mockRepo.EXPECT().GetByID(mock.Anything, commentID).Return(&domain.Comment{ID: commentID, PostID: postID}, nil).Once()
+		mockPost.EXPECT().GetByID(mock.Anything, userID, postID).Return(domain.PostResponse{ID: postID}, nil).Once()
+		mockRepo.EXPECT().List(mock.Anything, domain.CommentListFilter{
+			PostID:   postID,
+			ParentID: commentID,
+			Sort:     domain.CommentSortNewest,
+			Limit:    domain.DefaultPageLimit + 1,
+		}).Return([]domain.Comment{{ID: 101, ParentID: &commentID}}, nil).Once()
+		mockMedia.EXPECT().GetPublicURL("").Return("").Once()
+		viewerReaction := domain.ReactionLove
+		mockReaction.EXPECT().Summaries(mock.Anything, userID, domain.ReactionTargetComment, []int64{101}).
+			Return(map[int64]domain.ReactionSummary{101: domain.NewReactionSummary(domain.ReactionCounts{viewerReaction: 1}, viewerReaction)}, nil).Once()
+
+		page, err := svc.ListReplies(context.Background(), domain.ListRepliesParams{ViewerID: userID, CommentID: commentID})
+		s.NoError(err)
+
+		items := page.Items.([]domain.CommentResponse)
+		s.Len(items, 1)
+		s.Equal(commentID, *items[0].ParentID)
+		s.Equal(&viewerReaction, items[0].Reactions.ViewerReaction)
+	})
+}
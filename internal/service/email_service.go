@@ -33,6 +33,10 @@ func NewEmailService(sender domain.EmailSender) *EmailServiceImpl {
 func (e *EmailServiceImpl) registerHandlers() {
 	e.handlers[domain.EmailVerify] = e.verifyEmail
 	e.handlers[domain.EmailResetPassword] = e.resetPassword
+	e.handlers[domain.EmailAccountLocked] = e.accountLocked
+	e.handlers[domain.EmailMagicLink] = e.magicLink
+	e.handlers[domain.EmailChangeConfirm] = e.emailChange
+	e.handlers[domain.EmailChangeNotice] = e.emailChangeNotice
 }
 
 func (e *EmailServiceImpl) Handle(ctx context.Context, evt domain.EventPayload) error {
@@ -51,6 +55,22 @@ func (e *EmailServiceImpl) resetPassword(evt domain.EventPayload) error {
 	return e.handleStandardEmail(evt, templates.ResetPasswordPath)
 }
 
+func (e *EmailServiceImpl) accountLocked(evt domain.EventPayload) error {
+	return e.handleStandardEmail(evt, templates.AccountLockedPath)
+}
+
+func (e *EmailServiceImpl) magicLink(evt domain.EventPayload) error {
+	return e.handleStandardEmail(evt, templates.MagicLinkPath)
+}
+
+func (e *EmailServiceImpl) emailChange(evt domain.EventPayload) error {
+	return e.handleStandardEmail(evt, templates.EmailChangePath)
+}
+
+func (e *EmailServiceImpl) emailChangeNotice(evt domain.EventPayload) error {
+	return e.handleStandardEmail(evt, templates.EmailNoticePath)
+}
+
 func (e *EmailServiceImpl) handleStandardEmail(evt domain.EventPayload, templateFile string) error {
 	var payload domain.EventEmailData
 	if err := parsePayloadData(evt, &payload); err != nil {
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"air-social/internal/config"
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+type FeedService interface {
+	// Handle consumes post.created events and fans the post out to follower
+	// or group member timelines.
+	Handle(ctx context.Context, evt domain.EventPayload) error
+	GetHomeFeed(ctx context.Context, input domain.ListFeedParams) (domain.Page, error)
+}
+
+type FeedServiceImpl struct {
+	feedStore   domain.FeedStore
+	followSvc   FollowService
+	groupSvc    GroupService
+	postRepo    domain.PostRepository
+	userSvc     UserService
+	reactionSvc ReactionService
+	mediaSvc    MediaService
+	feedCfg     config.FeedConfig
+}
+
+func NewFeedService(
+	feedStore domain.FeedStore,
+	followSvc FollowService,
+	groupSvc GroupService,
+	postRepo domain.PostRepository,
+	userSvc UserService,
+	reactionSvc ReactionService,
+	mediaSvc MediaService,
+	cfg config.FeedConfig,
+) *FeedServiceImpl {
+	return &FeedServiceImpl{
+		feedStore:   feedStore,
+		followSvc:   followSvc,
+		groupSvc:    groupSvc,
+		postRepo:    postRepo,
+		userSvc:     userSvc,
+		reactionSvc: reactionSvc,
+		mediaSvc:    mediaSvc,
+		feedCfg:     cfg,
+	}
+}
+
+// Handle pushes a new post, or a private post that was made visible, into the
+// home timeline of its author and, unless the author is above the fan-out
+// threshold, of every follower. Posts of large accounts are merged in at read
+// time by GetHomeFeed instead. Group posts go to the group members only.
+func (s *FeedServiceImpl) Handle(ctx context.Context, evt domain.EventPayload) error {
+	if evt.EventType != domain.PostCreated && evt.EventType != domain.PostVisibilityChanged {
+		return nil
+	}
+
+	var data domain.EventPostData
+	if err := parsePayloadData(evt, &data); err != nil {
+		return err
+	}
+	if data.Visibility == domain.VisibilityPrivate {
+		return nil
+	}
+
+	if data.GroupID != nil {
+		groupID := *data.GroupID
+		return s.pushInBatches(ctx, data.PostID, func(afterID int64) ([]int64, error) {
+			return s.groupSvc.ListMemberIDs(ctx, groupID, afterID, s.feedCfg.FanoutBatchSize)
+		})
+	}
+
+	author, err := s.userSvc.GetByID(ctx, data.AuthorID)
+	if err != nil {
+		if errors.Is(err, pkg.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if err := s.feedStore.Push(ctx, []int64{author.ID}, data.PostID); err != nil {
+		return err
+	}
+
+	if author.FollowersCount >= s.feedCfg.FanoutThreshold {
+		return nil
+	}
+
+	return s.pushInBatches(ctx, data.PostID, func(afterID int64) ([]int64, error) {
+		return s.followSvc.ListFollowerIDs(ctx, author.ID, afterID, s.feedCfg.FanoutBatchSize)
+	})
+}
+
+func (s *FeedServiceImpl) GetHomeFeed(ctx context.Context, input domain.ListFeedParams) (domain.Page, error) {
+	var empty domain.Page
+
+	beforeID, err := decodeIDCursor(input.Page.Cursor)
+	if err != nil {
+		return empty, err
+	}
+
+	limit := input.Page.Size()
+
+	ids, err := s.feedStore.Range(ctx, input.UserID, beforeID, limit+1)
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	pulled, err := s.pullPopularPosts(ctx, input.UserID, beforeID, limit+1)
+	if err != nil {
+		return empty, err
+	}
+	for _, p := range pulled {
+		ids = append(ids, p.ID)
+	}
+
+	// Merge both sources newest first; a post may be in both when its author
+	// crossed the fan-out threshold after it was pushed.
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+	slices.Reverse(ids)
+
+	page := domain.Page{HasMore: len(ids) > limit}
+	if page.HasMore {
+		ids = ids[:limit]
+		page.NextCursor = pkg.EncodeCursor(ids[len(ids)-1])
+	}
+
+	posts, err := s.postRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	posts, err = s.filterVisible(ctx, input.UserID, posts)
+	if err != nil {
+		return empty, err
+	}
+
+	items := make([]domain.PostResponse, 0, len(posts))
+	for i := range posts {
+		items = append(items, mapPostResponse(s.mediaSvc, &posts[i]))
+	}
+
+	if err := attachPostReactions(ctx, s.reactionSvc, input.UserID, items); err != nil {
+		return empty, err
+	}
+	page.Items = items
+
+	return page, nil
+}
+
+// Internal helpers
+
+// pushInBatches pushes the post to the timelines of the users returned by
+// next, which pages through them by ID in batches of FanoutBatchSize.
+func (s *FeedServiceImpl) pushInBatches(ctx context.Context, postID int64, next func(afterID int64) ([]int64, error)) error {
+	var afterID int64
+	for {
+		userIDs, err := next(afterID)
+		if err != nil {
+			return err
+		}
+		if len(userIDs) == 0 {
+			return nil
+		}
+
+		if err := s.feedStore.Push(ctx, userIDs, postID); err != nil {
+			return err
+		}
+
+		if len(userIDs) < s.feedCfg.FanoutBatchSize {
+			return nil
+		}
+		afterID = userIDs[len(userIDs)-1]
+	}
+}
+
+// pullPopularPosts reads recent posts of followed accounts that are above the
+// fan-out threshold and therefore never pushed into follower timelines.
+func (s *FeedServiceImpl) pullPopularPosts(ctx context.Context, userID, beforeID int64, limit int) ([]domain.Post, error) {
+	authorIDs, err := s.followSvc.ListPopularFollowing(ctx, userID, s.feedCfg.FanoutThreshold)
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+	if len(authorIDs) == 0 {
+		return nil, nil
+	}
+
+	posts, err := s.postRepo.ListByAuthors(ctx, domain.PostFeedFilter{
+		AuthorIDs:    authorIDs,
+		Visibilities: []domain.PostVisibility{domain.VisibilityPublic, domain.VisibilityFollowers},
+		BeforeID:     beforeID,
+		Limit:        limit,
+	})
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+	return posts, nil
+}
+
+// filterVisible drops posts the viewer may no longer see: posts made private
+// after fan-out, posts of accounts the viewer has unfollowed since or that
+// were banned or deleted since, and posts of groups the viewer has left.
+func (s *FeedServiceImpl) filterVisible(ctx context.Context, viewerID int64, posts []domain.Post) ([]domain.Post, error) {
+	var authorIDs, groupIDs []int64
+	for _, p := range posts {
+		switch {
+		case p.AuthorHidden, p.AuthorID == viewerID:
+		case p.GroupID != nil:
+			if !slices.Contains(groupIDs, *p.GroupID) {
+				groupIDs = append(groupIDs, *p.GroupID)
+			}
+		case !slices.Contains(authorIDs, p.AuthorID):
+			authorIDs = append(authorIDs, p.AuthorID)
+		}
+	}
+
+	following, err := s.followSvc.FilterFollowing(ctx, viewerID, authorIDs)
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+
+	memberOf, err := s.groupSvc.FilterMemberOf(ctx, viewerID, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := posts[:0]
+	for _, p := range posts {
+		switch {
+		case p.AuthorHidden:
+			continue
+		case p.AuthorID == viewerID:
+		case p.GroupID != nil:
+			if !slices.Contains(memberOf, *p.GroupID) {
+				continue
+			}
+		case p.Visibility == domain.VisibilityPrivate:
+			continue
+		case !slices.Contains(following, p.AuthorID):
+			continue
+		}
+		visible = append(visible, p)
+	}
+	return visible, nil
+}
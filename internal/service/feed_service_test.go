@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"air-social/internal/config"
+	"air-social/internal/domain"
+	"air-social/internal/mocks"
+	"air-social/pkg"
+)
+
+type feedServiceSuite struct {
+	suite.Suite
+	cfg config.FeedConfig
+}
+
+func TestFeedServiceSuite(t *testing.T) {
+	suite.Run(t, new(feedServiceSuite))
+}
+
+func (s *feedServiceSuite) SetupTest() {
+	s.cfg = config.FeedConfig{
+		FanoutThreshold: 100,
+		MaxLength:       800,
+		FanoutBatchSize: 2,
+	}
+}
+
+func (s *feedServiceSuite) TestHandle() {
+	var (
+		authorID int64 = 1
+		postID   int64 = 50
+	)
+
+	event := func(visibility domain.PostVisibility) domain.EventPayload {
+		return domain.EventPayload{
+			EventType: domain.PostCreated,
+			Data:      domain.EventPostData{PostID: postID, AuthorID: authorID, Visibility: visibility},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		evt       domain.EventPayload
+		setupMock func(store *mocks.FeedStore, follow *mocks.FollowService, user *mocks.UserService)
+		wantErr   error
+	}{
+		{
+			name: "other_event_ignored",
+			evt:  domain.EventPayload{EventType: domain.EmailVerify},
+		},
+		{
+			name: "private_post_ignored",
+			evt:  event(domain.VisibilityPrivate),
+		},
+		{
+			name: "author_deleted",
+			evt:  event(domain.VisibilityPublic),
+			setupMock: func(store *mocks.FeedStore, follow *mocks.FollowService, user *mocks.UserService) {
+				user.EXPECT().GetByID(mock.Anything, authorID).Return(nil, pkg.ErrNotFound).Once()
+			},
+		},
+		{
+			name: "popular_author_skips_fanout",
+			evt:  event(domain.VisibilityPublic),
+			setupMock: func(store *mocks.FeedStore, follow *mocks.FollowService, user *mocks.UserService) {
+				author := &domain.User{ID: authorID, FollowCounts: domain.FollowCounts{FollowersCount: 100}}
+				user.EXPECT().GetByID(mock.Anything, authorID).Return(author, nil).Once()
+				store.EXPECT().Push(mock.Anything, []int64{authorID}, postID).Return(nil).Once()
+			},
+		},
+		{
+			name: "fanout_in_batches",
+			evt:  event(domain.VisibilityFollowers),
+			setupMock: func(store *mocks.FeedStore, follow *mocks.FollowService, user *mocks.UserService) {
+				author := &domain.User{ID: authorID, FollowCounts: domain.FollowCounts{FollowersCount: 3}}
+				user.EXPECT().GetByID(mock.Anything, authorID).Return(author, nil).Once()
+				store.EXPECT().Push(mock.Anything, []int64{authorID}, postID).Return(nil).Once()
+
+				follow.EXPECT().ListFollowerIDs(mock.Anything, authorID, int64(0), 2).Return([]int64{2, 3}, nil).Once()
+				store.EXPECT().Push(mock.Anything, []int64{2, 3}, postID).Return(nil).Once()
+				follow.EXPECT().ListFollowerIDs(mock.Anything, authorID, int64(3), 2).Return([]int64{7}, nil).Once()
+				store.EXPECT().Push(mock.Anything, []int64{7}, postID).Return(nil).Once()
+			},
+		},
+		{
+			name: "visibility_widened",
+			evt: domain.EventPayload{
+				EventType: domain.PostVisibilityChanged,
+				Data:      domain.EventPostData{PostID: postID, AuthorID: authorID, Visibility: domain.VisibilityPublic},
+			},
+			setupMock: func(store *mocks.FeedStore, follow *mocks.FollowService, user *mocks.UserService) {
+				author := &domain.User{ID: authorID, FollowCounts: domain.FollowCounts{FollowersCount: 1}}
+				user.EXPECT().GetByID(mock.Anything, authorID).Return(author, nil).Once()
+				store.EXPECT().Push(mock.Anything, []int64{authorID}, postID).Return(nil).Once()
+				follow.EXPECT().ListFollowerIDs(mock.Anything, authorID, int64(0), 2).Return([]int64{2}, nil).Once()
+				store.EXPECT().Push(mock.Anything, []int64{2}, postID).Return(nil).Once()
+			},
+		},
+		{
+			name: "store_error",
+			evt:  event(domain.VisibilityPublic),
+			setupMock: func(store *mocks.FeedStore, follow *mocks.FollowService, user *mocks.UserService) {
+				user.EXPECT().GetByID(mock.Anything, authorID).Return(&domain.User{ID: authorID}, nil).Once()
+				store.EXPECT().Push(mock.Anything, []int64{authorID}, postID).Return(assert.AnError).Once()
+			},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockStore := mocks.NewFeedStore(s.T())
+			mockFollow := mocks.NewFollowService(s.T())
+			mockUser := mocks.NewUserService(s.T())
+			svc := NewFeedService(mockStore, mockFollow, mocks.NewGroupService(s.T()), mocks.NewPostRepository(s.T()), mockUser, mocks.NewReactionService(s.T()), mocks.NewMediaService(s.T()), s.cfg)
+
+			if tc.setupMock != nil {
+				tc.setupMock(mockStore, mockFollow, mockUser)
+			}
+
+			err := svc.Handle(context.Background(), tc.evt)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+
+	s.Run("group_post_to_members", func() {
+		mockStore := mocks.NewFeedStore(s.T())
+		mockGroup := mocks.NewGroupService(s.T())
+		svc := NewFeedService(mockStore, mocks.NewFollowService(s.T()), mockGroup, mocks.NewPostRepository(s.T()),
+			mocks.NewUserService(s.T()), mocks.NewReactionService(s.T()), mocks.NewMediaService(s.T()), s.cfg)
+
+		var groupID int64 = 5
+		mockGroup.EXPECT().ListMemberIDs(mock.Anything, groupID, int64(0), 2).Return([]int64{authorID, 4}, nil).Once()
+		mockStore.EXPECT().Push(mock.Anything, []int64{authorID, 4}, postID).Return(nil).Once()
+		mockGroup.EXPECT().ListMemberIDs(mock.Anything, groupID, int64(4), 2).Return(nil, nil).Once()
+
+		err := svc.Handle(context.Background(), domain.EventPayload{
+			EventType: domain.PostCreated,
+			Data:      domain.EventPostData{PostID: postID, AuthorID: authorID, Visibility: domain.VisibilityPublic, GroupID: &groupID},
+		})
+		s.NoError(err)
+	})
+}
+
+func (s *feedServiceSuite) TestGetHomeFeed() {
+	var userID int64 = 1
+
+	s.Run("invalid_cursor", func() {
+		svc := NewFeedService(mocks.NewFeedStore(s.T()), mocks.NewFollowService(s.T()), mocks.NewGroupService(s.T()), mocks.NewPostRepository(s.T()),
+			mocks.NewUserService(s.T()), mocks.NewReactionService(s.T()), mocks.NewMediaService(s.T()), s.cfg)
+
+		_, err := svc.GetHomeFeed(context.Background(), domain.ListFeedParams{
+			UserID: userID,
+			Page:   domain.PageParams{Cursor: "%%%"},
+		})
+		s.ErrorIs(err, pkg.ErrBadRequest)
+	})
+
+	s.Run("store_error", func() {
+		mockStore := mocks.NewFeedStore(s.T())
+		svc := NewFeedService(mockStore, mocks.NewFollowService(s.T()), mocks.NewGroupService(s.T()), mocks.NewPostRepository(s.T()),
+			mocks.NewUserService(s.T()), mocks.NewReactionService(s.T()), mocks.NewMediaService(s.T()), s.cfg)
+
+		mockStore.EXPECT().Range(mock.Anything, userID, int64(0), 21).Return(nil, assert.AnError).Once()
+
+		_, err := svc.GetHomeFeed(context.Background(), domain.ListFeedParams{UserID: userID})
+		s.ErrorIs(err, pkg.ErrInternal)
+	})
+
+	s.Run("merges_pushed_and_pulled", func() {
+		mockStore := mocks.NewFeedStore(s.T())
+		mockFollow := mocks.NewFollowService(s.T())
+		mockPost := mocks.NewPostRepository(s.T())
+		mockGroup := mocks.NewGroupService(s.T())
+		mockReaction := mocks.NewReactionService(s.T())
+		svc := NewFeedService(mockStore, mockFollow, mockGroup, mockPost, mocks.NewUserService(s.T()), mockReaction, mocks.NewMediaService(s.T()), s.cfg)
+
+		const popularID int64 = 9
+
+		mockStore.EXPECT().Range(mock.Anything, userID, int64(100), 4).Return([]int64{90, 70, 40}, nil).Once()
+		mockFollow.EXPECT().ListPopularFollowing(mock.Anything, userID, 100).Return([]int64{popularID}, nil).Once()
+		mockPost.EXPECT().ListByAuthors(mock.Anything, domain.PostFeedFilter{
+			AuthorIDs:    []int64{popularID},
+			Visibilities: []domain.PostVisibility{domain.VisibilityPublic, domain.VisibilityFollowers},
+			BeforeID:     100,
+			Limit:        4,
+		}).Return([]domain.Post{{ID: 80, AuthorID: popularID}, {ID: 70, AuthorID: popularID}}, nil).Once()
+
+		mockPost.EXPECT().ListByIDs(mock.Anything, []int64{90, 80, 70}).Return([]domain.Post{
+			{ID: 90, AuthorID: 2, Visibility: domain.VisibilityPublic},
+			{ID: 80, AuthorID: popularID, Visibility: domain.VisibilityPublic},
+			{ID: 70, AuthorID: 3, Visibility: domain.VisibilityFollowers},
+		}, nil).Once()
+		// User 3 has been unfollowed since the post was pushed.
+		mockFollow.EXPECT().FilterFollowing(mock.Anything, userID, []int64{2, popularID, 3}).Return([]int64{2, popularID}, nil).Once()
+		mockGroup.EXPECT().FilterMemberOf(mock.Anything, userID, []int64(nil)).Return(nil, nil).Once()
+		mockReaction.EXPECT().Summaries(mock.Anything, userID, domain.ReactionTargetPost, []int64{90, 80}).
+			Return(map[int64]domain.ReactionSummary{80: domain.NewReactionSummary(domain.ReactionCounts{domain.ReactionWow: 3}, "")}, nil).Once()
+
+		page, err := svc.GetHomeFeed(context.Background(), domain.ListFeedParams{
+			UserID: userID,
+			Page:   domain.PageParams{Cursor: pkg.EncodeCursor(100), Limit: 3},
+		})
+		s.NoError(err)
+		s.True(page.HasMore)
+		s.Equal(pkg.EncodeCursor(70), page.NextCursor)
+
+		items := page.Items.([]domain.PostResponse)
+		s.Len(items, 2)
+		s.Equal(int64(90), items[0].ID)
+		s.Equal(int64(80), items[1].ID)
+		s.Equal(int64(3), items[1].Reactions.Total)
+		s.Zero(items[0].Reactions.Total)
+	})
+
+	s.Run("own_private_post_kept", func() {
+		mockStore := mocks.NewFeedStore(s.T())
+		mockFollow := mocks.NewFollowService(s.T())
+		mockPost := mocks.NewPostRepository(s.T())
+		mockGroup := mocks.NewGroupService(s.T())
+		mockReaction := mocks.NewReactionService(s.T())
+		svc := NewFeedService(mockStore, mockFollow, mockGroup, mockPost, mocks.NewUserService(s.T()), mockReaction, mocks.NewMediaService(s.T()), s.cfg)
+
+		mockStore.EXPECT().Range(mock.Anything, userID, int64(0), 21).Return([]int64{5, 4}, nil).Once()
+		mockFollow.EXPECT().ListPopularFollowing(mock.Anything, userID, 100).Return(nil, nil).Once()
+		mockPost.EXPECT().ListByIDs(mock.Anything, []int64{5, 4}).Return([]domain.Post{
+			{ID: 5, AuthorID: userID, Visibility: domain.VisibilityPrivate},
+			{ID: 4, AuthorID: 2, Visibility: domain.VisibilityPrivate},
+		}, nil).Once()
+		mockFollow.EXPECT().FilterFollowing(mock.Anything, userID, []int64{2}).Return([]int64{2}, nil).Once()
+		mockGroup.EXPECT().FilterMemberOf(mock.Anything, userID, []int64(nil)).Return(nil, nil).Once()
+		mockReaction.EXPECT().Summaries(mock.Anything, userID, domain.ReactionTargetPost, []int64{5}).
+			Return(map[int64]domain.ReactionSummary{}, nil).Once()
+
+		page, err := svc.GetHomeFeed(context.Background(), domain.ListFeedParams{UserID: userID})
+		s.NoError(err)
+		s.False(page.HasMore)
+		s.Empty(page.NextCursor)
+
+		items := page.Items.([]domain.PostResponse)
+		s.Len(items, 1)
+		s.Equal(int64(5), items[0].ID)
+	})
+	s.Run("group_posts_of_left_groups_dropped", func() {
+		mockStore := mocks.NewFeedStore(s.T())
+		mockFollow := mocks.NewFollowService(s.T())
+		mockPost := mocks.NewPostRepository(s.T())
+		mockGroup := mocks.NewGroupService(s.T())
+		mockReaction := mocks.NewReactionService(s.T())
+		svc := NewFeedService(mockStore, mockFollow, mockGroup, mockPost, mocks.NewUserService(s.T()), mockReaction, mocks.NewMediaService(s.T()), s.cfg)
+
+		joined, left := int64(7), int64(8)
+		mockStore.EXPECT().Range(mock.Anything, userID, int64(0), 21).Return([]int64{6, 5}, nil).Once()
+		mockFollow.EXPECT().ListPopularFollowing(mock.Anything, userID, 100).Return(nil, nil).Once()
+		mockPost.EXPECT().ListByIDs(mock.Anything, []int64{6, 5}).Return([]domain.Post{
+			{ID: 6, AuthorID: 2, GroupID: &joined, Visibility: domain.VisibilityPublic},
+			{ID: 5, AuthorID: 3, GroupID: &left, Visibility: domain.VisibilityPublic},
+		}, nil).Once()
+		mockFollow.EXPECT().FilterFollowing(mock.Anything, userID, []int64(nil)).Return(nil, nil).Once()
+		mockGroup.EXPECT().FilterMemberOf(mock.Anything, userID, []int64{joined, left}).Return([]int64{joined}, nil).Once()
+		mockReaction.EXPECT().Summaries(mock.Anything, userID, domain.ReactionTargetPost, []int64{6}).
+			Return(map[int64]domain.ReactionSummary{}, nil).Once()
+
+		page, err := svc.GetHomeFeed(context.Background(), domain.ListFeedParams{UserID: userID})
+		s.NoError(err)
+
+		items := page.Items.([]domain.PostResponse)
+		s.Len(items, 1)
+		s.Equal(int64(6), items[0].ID)
+		s.Equal(&joined, items[0].GroupID)
+	})
+
+	s.Run("posts_of_hidden_authors_dropped", func() {
+		mockStore := mocks.NewFeedStore(s.T())
+		mockFollow := mocks.NewFollowService(s.T())
+		mockPost := mocks.NewPostRepository(s.T())
+		mockGroup := mocks.NewGroupService(s.T())
+		mockReaction := mocks.NewReactionService(s.T())
+		svc := NewFeedService(mockStore, mockFollow, mockGroup, mockPost, mocks.NewUserService(s.T()), mockReaction, mocks.NewMediaService(s.T()), s.cfg)
+
+		mockStore.EXPECT().Range(mock.Anything, userID, int64(0), 21).Return([]int64{5, 4}, nil).Once()
+		mockFollow.EXPECT().ListPopularFollowing(mock.Anything, userID, 100).Return(nil, nil).Once()
+		// The author of post 4 was banned after it was pushed.
+		mockPost.EXPECT().ListByIDs(mock.Anything, []int64{5, 4}).Return([]domain.Post{
+			{ID: 5, AuthorID: 2, Visibility: domain.VisibilityPublic},
+			{ID: 4, AuthorID: 3, Visibility: domain.VisibilityPublic, AuthorHidden: true},
+		}, nil).Once()
+		mockFollow.EXPECT().FilterFollowing(mock.Anything, userID, []int64{2}).Return([]int64{2}, nil).Once()
+		mockGroup.EXPECT().FilterMemberOf(mock.Anything, userID, []int64(nil)).Return(nil, nil).Once()
+		mockReaction.EXPECT().Summaries(mock.Anything, userID, domain.ReactionTargetPost, []int64{5}).
+			Return(map[int64]domain.ReactionSummary{}, nil).Once()
+
+		page, err := svc.GetHomeFeed(context.Background(), domain.ListFeedParams{UserID: userID})
+		s.NoError(err)
+
+		items := page.Items.([]domain.PostResponse)
+		s.Len(items, 1)
+		s.Equal(int64(5), items[0].ID)
+	})
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+type FollowService interface {
+	Follow(ctx context.Context, input domain.FollowParams) error
+	Unfollow(ctx context.Context, input domain.FollowParams) error
+	IsFollowing(ctx context.Context, followerID, followeeID int64) (bool, error)
+	ListFollowers(ctx context.Context, input domain.ListFollowsParams) (domain.Page, error)
+	ListFollowing(ctx context.Context, input domain.ListFollowsParams) (domain.Page, error)
+	// ListFollowerIDs pages through follower IDs in ascending order, starting after afterID.
+	ListFollowerIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error)
+	// ListPopularFollowing returns the followed accounts with at least minFollowers followers.
+	ListPopularFollowing(ctx context.Context, userID int64, minFollowers int) ([]int64, error)
+	// FilterFollowing returns the subset of followeeIDs that followerID follows.
+	FilterFollowing(ctx context.Context, followerID int64, followeeIDs []int64) ([]int64, error)
+}
+
+type FollowServiceImpl struct {
+	followRepo domain.FollowRepository
+	userSvc    UserService
+	mediaSvc   MediaService
+}
+
+func NewFollowService(followRepo domain.FollowRepository, userSvc UserService, mediaSvc MediaService) *FollowServiceImpl {
+	return &FollowServiceImpl{
+		followRepo: followRepo,
+		userSvc:    userSvc,
+		mediaSvc:   mediaSvc,
+	}
+}
+
+// Follow is idempotent: following an account twice is not an error.
+func (s *FollowServiceImpl) Follow(ctx context.Context, input domain.FollowParams) error {
+	if input.FollowerID == input.FolloweeID {
+		return pkg.ErrInvalidData
+	}
+
+	if _, err := s.userSvc.GetByID(ctx, input.FolloweeID); err != nil {
+		return err
+	}
+
+	if _, err := s.followRepo.Create(ctx, input.FollowerID, input.FolloweeID); err != nil {
+		return pkg.OrInternalError(err)
+	}
+	return nil
+}
+
+// Unfollow is idempotent: removing a missing relationship is not an error.
+func (s *FollowServiceImpl) Unfollow(ctx context.Context, input domain.FollowParams) error {
+	if input.FollowerID == input.FolloweeID {
+		return pkg.ErrInvalidData
+	}
+
+	if _, err := s.followRepo.Delete(ctx, input.FollowerID, input.FolloweeID); err != nil {
+		return pkg.OrInternalError(err)
+	}
+	return nil
+}
+
+func (s *FollowServiceImpl) IsFollowing(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	ok, err := s.followRepo.IsFollowing(ctx, followerID, followeeID)
+	if err != nil {
+		return false, pkg.OrInternalError(err)
+	}
+	return ok, nil
+}
+
+func (s *FollowServiceImpl) ListFollowers(ctx context.Context, input domain.ListFollowsParams) (domain.Page, error) {
+	return s.list(ctx, input, s.followRepo.ListFollowers)
+}
+
+func (s *FollowServiceImpl) ListFollowing(ctx context.Context, input domain.ListFollowsParams) (domain.Page, error) {
+	return s.list(ctx, input, s.followRepo.ListFollowing)
+}
+
+func (s *FollowServiceImpl) ListFollowerIDs(ctx context.Context, userID, afterID int64, limit int) ([]int64, error) {
+	ids, err := s.followRepo.ListFollowerIDs(ctx, userID, afterID, limit)
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+	return ids, nil
+}
+
+func (s *FollowServiceImpl) ListPopularFollowing(ctx context.Context, userID int64, minFollowers int) ([]int64, error) {
+	ids, err := s.followRepo.ListPopularFollowing(ctx, userID, minFollowers)
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+	return ids, nil
+}
+
+func (s *FollowServiceImpl) FilterFollowing(ctx context.Context, followerID int64, followeeIDs []int64) ([]int64, error) {
+	ids, err := s.followRepo.FilterFollowing(ctx, followerID, followeeIDs)
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+	return ids, nil
+}
+
+// Internal helpers
+
+type listFollowsFunc func(ctx context.Context, userID, beforeID int64, limit int) ([]domain.FollowEntry, error)
+
+func (s *FollowServiceImpl) list(ctx context.Context, input domain.ListFollowsParams, fetch listFollowsFunc) (domain.Page, error) {
+	var empty domain.Page
+
+	beforeID, err := decodeIDCursor(input.Page.Cursor)
+	if err != nil {
+		return empty, err
+	}
+
+	if _, err := s.userSvc.GetByID(ctx, input.UserID); err != nil {
+		return empty, err
+	}
+
+	limit := input.Page.Size()
+	entries, err := fetch(ctx, input.UserID, beforeID, limit+1)
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	cursors := make(map[int64]int64, len(entries))
+	items := make([]domain.FollowUserResponse, 0, len(entries))
+	for _, e := range entries {
+		e.Avatar = s.mediaSvc.GetPublicURL(e.Avatar)
+		cursors[e.ID] = e.FollowID
+		items = append(items, domain.FollowUserResponse{
+			UserSummary: e.UserSummary,
+			FollowedAt:  e.FollowedAt,
+		})
+	}
+
+	return newPage(items, limit, func(f domain.FollowUserResponse) string {
+		return pkg.EncodeCursor(cursors[f.ID])
+	}), nil
+}
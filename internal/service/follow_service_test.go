@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"air-social/internal/domain"
+	"air-social/internal/mocks"
+	"air-social/pkg"
+)
+
+type followServiceSuite struct {
+	suite.Suite
+}
+
+func TestFollowServiceSuite(t *testing.T) {
+	suite.Run(t, new(followServiceSuite))
+}
+
+func (s *followServiceSuite) TestFollow() {
+	var (
+		followerID int64 = 1
+		followeeID int64 = 2
+	)
+
+	tests := []struct {
+		name      string
+		input     domain.FollowParams
+		setupMock func(repo *mocks.FollowRepository, user *mocks.UserService)
+		wantErr   error
+	}{
+		{
+			name:    "self_follow",
+			input:   domain.FollowParams{FollowerID: followerID, FolloweeID: followerID},
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name:  "target_not_found",
+			input: domain.FollowParams{FollowerID: followerID, FolloweeID: followeeID},
+			setupMock: func(repo *mocks.FollowRepository, user *mocks.UserService) {
+				user.EXPECT().GetByID(mock.Anything, followeeID).Return(nil, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name:  "repo_error",
+			input: domain.FollowParams{FollowerID: followerID, FolloweeID: followeeID},
+			setupMock: func(repo *mocks.FollowRepository, user *mocks.UserService) {
+				user.EXPECT().GetByID(mock.Anything, followeeID).Return(&domain.User{ID: followeeID}, nil).Once()
+				repo.EXPECT().Create(mock.Anything, followerID, followeeID).Return(false, assert.AnError).Once()
+			},
+			wantErr: pkg.ErrInternal,
+		},
+		{
+			name:  "already_following",
+			input: domain.FollowParams{FollowerID: followerID, FolloweeID: followeeID},
+			setupMock: func(repo *mocks.FollowRepository, user *mocks.UserService) {
+				user.EXPECT().GetByID(mock.Anything, followeeID).Return(&domain.User{ID: followeeID}, nil).Once()
+				repo.EXPECT().Create(mock.Anything, followerID, followeeID).Return(false, nil).Once()
+			},
+		},
+		{
+			name:  "success",
+			input: domain.FollowParams{FollowerID: followerID, FolloweeID: followeeID},
+			setupMock: func(repo *mocks.FollowRepository, user *mocks.UserService) {
+				user.EXPECT().GetByID(mock.Anything, followeeID).Return(&domain.User{ID: followeeID}, nil).Once()
+				repo.EXPECT().Create(mock.Anything, followerID, followeeID).Return(true, nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockRepo := mocks.NewFollowRepository(s.T())
+			mockUser := mocks.NewUserService(s.T())
+			svc := NewFollowService(mockRepo, mockUser, mocks.NewMediaService(s.T()))
+
+			if tc.setupMock != nil {
+				tc.setupMock(mockRepo, mockUser)
+			}
+
+			err := svc.Follow(context.Background(), tc.input)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *followServiceSuite) TestUnfollow() {
+	var (
+		followerID int64 = 1
+		followeeID int64 = 2
+	)
+
+	tests := []struct {
+		name      string
+		input     domain.FollowParams
+		setupMock func(repo *mocks.FollowRepository)
+		wantErr   error
+	}{
+		{
+			name:    "self_unfollow",
+			input:   domain.FollowParams{FollowerID: followerID, FolloweeID: followerID},
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name:  "repo_error",
+			input: domain.FollowParams{FollowerID: followerID, FolloweeID: followeeID},
+			setupMock: func(repo *mocks.FollowRepository) {
+				repo.EXPECT().Delete(mock.Anything, followerID, followeeID).Return(false, assert.AnError).Once()
+			},
+			wantErr: pkg.ErrInternal,
+		},
+		{
+			name:  "not_following",
+			input: domain.FollowParams{FollowerID: followerID, FolloweeID: followeeID},
+			setupMock: func(repo *mocks.FollowRepository) {
+				repo.EXPECT().Delete(mock.Anything, followerID, followeeID).Return(false, nil).Once()
+			},
+		},
+		{
+			name:  "success",
+			input: domain.FollowParams{FollowerID: followerID, FolloweeID: followeeID},
+			setupMock: func(repo *mocks.FollowRepository) {
+				repo.EXPECT().Delete(mock.Anything, followerID, followeeID).Return(true, nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockRepo := mocks.NewFollowRepository(s.T())
+			svc := NewFollowService(mockRepo, mocks.NewUserService(s.T()), mocks.NewMediaService(s.T()))
+
+			if tc.setupMock != nil {
+				tc.setupMock(mockRepo)
+			}
+
+			err := svc.Unfollow(context.Background(), tc.input)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *followServiceSuite) TestListFollowers() {
+	var userID int64 = 1
+
+	s.Run("invalid_cursor", func() {
+		svc := NewFollowService(mocks.NewFollowRepository(s.T()), mocks.NewUserService(s.T()), mocks.NewMediaService(s.T()))
+
+		_, err := svc.ListFollowers(context.Background(), domain.ListFollowsParams{
+			UserID: userID,
+			Page:   domain.PageParams{Cursor: "%%%"},
+		})
+		s.ErrorIs(err, pkg.ErrBadRequest)
+	})
+
+	s.Run("user_not_found", func() {
+		mockUser := mocks.NewUserService(s.T())
+		svc := NewFollowService(mocks.NewFollowRepository(s.T()), mockUser, mocks.NewMediaService(s.T()))
+
+		mockUser.EXPECT().GetByID(mock.Anything, userID).Return(nil, pkg.ErrNotFound).Once()
+
+		_, err := svc.ListFollowers(context.Background(), domain.ListFollowsParams{UserID: userID})
+		s.ErrorIs(err, pkg.ErrNotFound)
+	})
+
+	s.Run("has_more", func() {
+		mockRepo := mocks.NewFollowRepository(s.T())
+		mockUser := mocks.NewUserService(s.T())
+		mockMedia := mocks.NewMediaService(s.T())
+		svc := NewFollowService(mockRepo, mockUser, mockMedia)
+
+		now := time.Now()
+		entries := []domain.FollowEntry{
+			{FollowID: 30, FollowedAt: now, UserSummary: domain.UserSummary{ID: 7, Avatar: "avatar/7.jpg"}},
+			{FollowID: 20, FollowedAt: now, UserSummary: domain.UserSummary{ID: 5}},
+			{FollowID: 10, FollowedAt: now, UserSummary: domain.UserSummary{ID: 3}},
+		}
+
+		mockUser.EXPECT().GetByID(mock.Anything, userID).Return(&domain.User{ID: userID}, nil).Once()
+		mockRepo.EXPECT().ListFollowers(mock.Anything, userID, int64(40), 3).Return(entries, nil).Once()
+		mockMedia.EXPECT().GetPublicURL("avatar/7.jpg").Return("http://cdn/avatar/7.jpg").Once()
+		mockMedia.EXPECT().GetPublicURL("").Return("").Times(2)
+
+		page, err := svc.ListFollowers(context.Background(), domain.ListFollowsParams{
+			UserID: userID,
+			Page:   domain.PageParams{Cursor: pkg.EncodeCursor(40), Limit: 2},
+		})
+		s.NoError(err)
+		s.True(page.HasMore)
+		s.Equal(pkg.EncodeCursor(20), page.NextCursor)
+
+		items := page.Items.([]domain.FollowUserResponse)
+		s.Len(items, 2)
+		s.Equal("http://cdn/avatar/7.jpg", items[0].Avatar)
+	})
+}
@@ -0,0 +1,621 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+type GroupService interface {
+	Create(ctx context.Context, input domain.CreateGroupParams) (domain.GroupResponse, error)
+	Update(ctx context.Context, input domain.UpdateGroupParams) (domain.GroupResponse, error)
+	Delete(ctx context.Context, userID, groupID int64) error
+	GetByID(ctx context.Context, viewerID, groupID int64) (domain.GroupResponse, error)
+	List(ctx context.Context, input domain.ListGroupsParams) (domain.Page, error)
+
+	Join(ctx context.Context, userID, groupID int64) (domain.GroupMembershipResponse, error)
+	Leave(ctx context.Context, userID, groupID int64) error
+	Invite(ctx context.Context, input domain.GroupMemberParams) error
+	ListMembers(ctx context.Context, input domain.ListGroupMembersParams) (domain.Page, error)
+	ListRequests(ctx context.Context, input domain.ListGroupMembersParams) (domain.Page, error)
+	ApproveRequest(ctx context.Context, input domain.GroupMemberParams) error
+	RejectRequest(ctx context.Context, input domain.GroupMemberParams) error
+	RemoveMember(ctx context.Context, input domain.GroupMemberParams) error
+	Ban(ctx context.Context, input domain.GroupMemberParams) error
+	Unban(ctx context.Context, input domain.GroupMemberParams) error
+	UpdateMemberRole(ctx context.Context, input domain.UpdateGroupMemberRoleParams) error
+
+	// CheckContentAccess fails with ErrNotFound when the group does not exist
+	// for the viewer and with ErrForbidden when its posts are members only.
+	CheckContentAccess(ctx context.Context, viewerID, groupID int64) error
+	// CheckMember fails like CheckContentAccess unless userID is an active member.
+	CheckMember(ctx context.Context, userID, groupID int64) error
+	CanModerate(ctx context.Context, userID, groupID int64) (bool, error)
+	ListMemberIDs(ctx context.Context, groupID, afterID int64, limit int) ([]int64, error)
+	FilterMemberOf(ctx context.Context, userID int64, groupIDs []int64) ([]int64, error)
+}
+
+type GroupServiceImpl struct {
+	groupRepo domain.GroupRepository
+	userSvc   UserService
+	mediaSvc  MediaService
+}
+
+func NewGroupService(groupRepo domain.GroupRepository, userSvc UserService, mediaSvc MediaService) *GroupServiceImpl {
+	return &GroupServiceImpl{
+		groupRepo: groupRepo,
+		userSvc:   userSvc,
+		mediaSvc:  mediaSvc,
+	}
+}
+
+func (s *GroupServiceImpl) Create(ctx context.Context, input domain.CreateGroupParams) (domain.GroupResponse, error) {
+	var empty domain.GroupResponse
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return empty, pkg.ErrInvalidData
+	}
+
+	visibility := input.Visibility
+	if visibility == "" {
+		visibility = domain.GroupPublic
+	}
+
+	group := &domain.Group{
+		Name:        name,
+		Description: strings.TrimSpace(input.Description),
+		Visibility:  visibility,
+	}
+	if err := s.groupRepo.Create(ctx, group, input.UserID); err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	owner := &domain.GroupMember{Role: domain.GroupRoleOwner, Status: domain.GroupMemberActive}
+	return s.mapGroup(group, owner), nil
+}
+
+// Update changes the details of the group. An empty Cover removes the cover.
+// Admins and the owner only.
+func (s *GroupServiceImpl) Update(ctx context.Context, input domain.UpdateGroupParams) (domain.GroupResponse, error) {
+	var empty domain.GroupResponse
+
+	group, actor, err := s.authorize(ctx, input.GroupID, input.UserID, domain.GroupRoleAdmin)
+	if err != nil {
+		return empty, err
+	}
+
+	if input.Name != nil {
+		group.Name = strings.TrimSpace(*input.Name)
+		if group.Name == "" {
+			return empty, pkg.ErrInvalidData
+		}
+	}
+	if input.Description != nil {
+		group.Description = strings.TrimSpace(*input.Description)
+	}
+	if input.Visibility != nil {
+		group.Visibility = *input.Visibility
+	}
+
+	oldCover := group.Cover
+	if input.Cover != nil && *input.Cover != group.Cover {
+		cover := *input.Cover
+		if cover != "" {
+			cover, err = s.confirmCover(ctx, input.UserID, cover)
+			if err != nil {
+				return empty, err
+			}
+		}
+		group.Cover = cover
+	}
+
+	if err := s.groupRepo.Update(ctx, group); err != nil {
+		return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	if oldCover != "" && oldCover != group.Cover {
+		s.deleteFiles(ctx, "group_cover_delete", oldCover)
+	}
+	return s.mapGroup(group, actor), nil
+}
+
+// Delete removes the group together with its posts. The owner only.
+func (s *GroupServiceImpl) Delete(ctx context.Context, userID, groupID int64) error {
+	group, _, err := s.authorize(ctx, groupID, userID, domain.GroupRoleOwner)
+	if err != nil {
+		return err
+	}
+
+	keys, err := s.groupRepo.Delete(ctx, group.ID)
+	if err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	if group.Cover != "" {
+		keys = append(keys, group.Cover)
+	}
+	s.deleteFiles(ctx, "group_delete", keys...)
+	return nil
+}
+
+func (s *GroupServiceImpl) GetByID(ctx context.Context, viewerID, groupID int64) (domain.GroupResponse, error) {
+	group, member, err := s.visibleGroup(ctx, groupID, viewerID)
+	if err != nil {
+		return domain.GroupResponse{}, err
+	}
+	return s.mapGroup(group, member), nil
+}
+
+// List returns the public and private groups, or with Joined set the groups
+// the viewer is an active member of, newest first.
+func (s *GroupServiceImpl) List(ctx context.Context, input domain.ListGroupsParams) (domain.Page, error) {
+	var empty domain.Page
+
+	beforeID, err := decodeIDCursor(input.Page.Cursor)
+	if err != nil {
+		return empty, err
+	}
+
+	limit := input.Page.Size()
+	filter := domain.GroupListFilter{BeforeID: beforeID, Limit: limit + 1}
+	if input.Joined {
+		filter.MemberID = input.ViewerID
+	}
+
+	groups, err := s.groupRepo.List(ctx, filter)
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	ids := make([]int64, 0, len(groups))
+	for _, g := range groups {
+		ids = append(ids, g.ID)
+	}
+	memberships, err := s.groupRepo.ListMemberships(ctx, input.ViewerID, ids)
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+	byGroup := make(map[int64]*domain.GroupMember, len(memberships))
+	for i := range memberships {
+		byGroup[memberships[i].GroupID] = &memberships[i]
+	}
+
+	items := make([]domain.GroupResponse, 0, len(groups))
+	for i := range groups {
+		items = append(items, s.mapGroup(&groups[i], byGroup[groups[i].ID]))
+	}
+
+	return newPage(items, limit, func(g domain.GroupResponse) string {
+		return pkg.EncodeCursor(g.ID)
+	}), nil
+}
+
+// Join makes the user a member of a public group, or of any group they are
+// invited to. For private groups it files a join request instead, so the
+// returned status is either active or pending.
+func (s *GroupServiceImpl) Join(ctx context.Context, userID, groupID int64) (domain.GroupMembershipResponse, error) {
+	var empty domain.GroupMembershipResponse
+
+	group, member, err := s.visibleGroup(ctx, groupID, userID)
+	if err != nil {
+		return empty, err
+	}
+	if member == nil {
+		member = &domain.GroupMember{GroupID: group.ID, UserID: userID, Role: domain.GroupRoleMember}
+	}
+
+	var status domain.GroupMemberStatus
+	switch {
+	case member.Status == domain.GroupMemberBanned:
+		return empty, pkg.ErrForbidden
+	case member.IsActive():
+		return domain.GroupMembershipResponse{Role: member.Role, Status: member.Status}, nil
+	case member.Status == domain.GroupMemberInvited, group.Visibility == domain.GroupPublic:
+		status = domain.GroupMemberActive
+	case group.Visibility == domain.GroupPrivate:
+		status = domain.GroupMemberPending
+	default:
+		return empty, pkg.ErrForbidden
+	}
+
+	if member.Status != status {
+		member.Status = status
+		if err := s.groupRepo.SaveMember(ctx, member); err != nil {
+			return empty, pkg.OrInternalError(err)
+		}
+	}
+	return domain.GroupMembershipResponse{Role: member.Role, Status: member.Status}, nil
+}
+
+// Leave ends the membership, withdraws a join request or declines an
+// invitation. An owner hands the group over to the longest standing admin,
+// moderator or member, and must delete it instead when nobody is left.
+func (s *GroupServiceImpl) Leave(ctx context.Context, userID, groupID int64) error {
+	member, err := s.groupRepo.GetMember(ctx, groupID, userID)
+	if err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	// A banned user leaving would lift the ban.
+	if member.Status == domain.GroupMemberBanned {
+		return pkg.ErrNotFound
+	}
+
+	if member.Role == domain.GroupRoleOwner {
+		err = s.groupRepo.RemoveOwner(ctx, groupID, userID)
+	} else {
+		err = s.groupRepo.DeleteMember(ctx, groupID, userID)
+	}
+	return pkg.OrInternalError(err, pkg.ErrNotFound, pkg.ErrConflict)
+}
+
+// Invite invites a user to the group, or approves their pending join
+// request. Moderators and above only.
+func (s *GroupServiceImpl) Invite(ctx context.Context, input domain.GroupMemberParams) error {
+	_, target, err := s.moderate(ctx, input, domain.GroupRoleModerator)
+	if err != nil {
+		return err
+	}
+
+	if target == nil {
+		if _, err := s.userSvc.GetByID(ctx, input.MemberID); err != nil {
+			return err
+		}
+		target = &domain.GroupMember{GroupID: input.GroupID, UserID: input.MemberID, Role: domain.GroupRoleMember}
+	}
+
+	switch target.Status {
+	case domain.GroupMemberActive, domain.GroupMemberInvited:
+		return nil
+	case domain.GroupMemberBanned:
+		return pkg.ErrConflict
+	case domain.GroupMemberPending:
+		target.Status = domain.GroupMemberActive
+	default:
+		target.Status = domain.GroupMemberInvited
+	}
+
+	if err := s.groupRepo.SaveMember(ctx, target); err != nil {
+		return pkg.OrInternalError(err)
+	}
+	return nil
+}
+
+// ListMembers pages through the active members. Members of private and
+// secret groups are visible to other members only.
+func (s *GroupServiceImpl) ListMembers(ctx context.Context, input domain.ListGroupMembersParams) (domain.Page, error) {
+	if err := s.CheckContentAccess(ctx, input.ViewerID, input.GroupID); err != nil {
+		return domain.Page{}, err
+	}
+	return s.listMembers(ctx, input, domain.GroupMemberActive)
+}
+
+// ListRequests pages through the pending join requests. Moderators and
+// above only.
+func (s *GroupServiceImpl) ListRequests(ctx context.Context, input domain.ListGroupMembersParams) (domain.Page, error) {
+	if _, _, err := s.authorize(ctx, input.GroupID, input.ViewerID, domain.GroupRoleModerator); err != nil {
+		return domain.Page{}, err
+	}
+	return s.listMembers(ctx, input, domain.GroupMemberPending)
+}
+
+func (s *GroupServiceImpl) ApproveRequest(ctx context.Context, input domain.GroupMemberParams) error {
+	_, target, err := s.moderate(ctx, input, domain.GroupRoleModerator)
+	if err != nil {
+		return err
+	}
+	if target == nil || target.Status != domain.GroupMemberPending {
+		return pkg.ErrNotFound
+	}
+
+	target.Status = domain.GroupMemberActive
+	if err := s.groupRepo.SaveMember(ctx, target); err != nil {
+		return pkg.OrInternalError(err)
+	}
+	return nil
+}
+
+func (s *GroupServiceImpl) RejectRequest(ctx context.Context, input domain.GroupMemberParams) error {
+	_, target, err := s.moderate(ctx, input, domain.GroupRoleModerator)
+	if err != nil {
+		return err
+	}
+	if target == nil || target.Status != domain.GroupMemberPending {
+		return pkg.ErrNotFound
+	}
+	return s.deleteMember(ctx, target)
+}
+
+// RemoveMember removes an active member or withdraws an invitation. The
+// caller must be a moderator who outranks the member.
+func (s *GroupServiceImpl) RemoveMember(ctx context.Context, input domain.GroupMemberParams) error {
+	_, target, err := s.moderate(ctx, input, domain.GroupRoleModerator)
+	if err != nil {
+		return err
+	}
+	if target == nil || (!target.IsActive() && target.Status != domain.GroupMemberInvited) {
+		return pkg.ErrNotFound
+	}
+	return s.deleteMember(ctx, target)
+}
+
+// Ban removes the user from the group and keeps them from joining again.
+// Users who are not related to the group yet can be banned as well.
+func (s *GroupServiceImpl) Ban(ctx context.Context, input domain.GroupMemberParams) error {
+	_, target, err := s.moderate(ctx, input, domain.GroupRoleModerator)
+	if err != nil {
+		return err
+	}
+
+	if target == nil {
+		if _, err := s.userSvc.GetByID(ctx, input.MemberID); err != nil {
+			return err
+		}
+		target = &domain.GroupMember{GroupID: input.GroupID, UserID: input.MemberID}
+	}
+	if target.Status == domain.GroupMemberBanned {
+		return nil
+	}
+
+	target.Role = domain.GroupRoleMember
+	target.Status = domain.GroupMemberBanned
+	if err := s.groupRepo.SaveMember(ctx, target); err != nil {
+		return pkg.OrInternalError(err)
+	}
+	return nil
+}
+
+func (s *GroupServiceImpl) Unban(ctx context.Context, input domain.GroupMemberParams) error {
+	_, target, err := s.moderate(ctx, input, domain.GroupRoleModerator)
+	if err != nil {
+		return err
+	}
+	if target == nil || target.Status != domain.GroupMemberBanned {
+		return pkg.ErrNotFound
+	}
+	return s.deleteMember(ctx, target)
+}
+
+// UpdateMemberRole changes the role of an active member. The caller must
+// outrank both the current and the new role, so admins manage moderators and
+// members while only the owner appoints admins. Giving away the owner role
+// transfers the ownership and makes the caller an admin.
+func (s *GroupServiceImpl) UpdateMemberRole(ctx context.Context, input domain.UpdateGroupMemberRoleParams) error {
+	params := domain.GroupMemberParams{UserID: input.UserID, GroupID: input.GroupID, MemberID: input.MemberID}
+	actor, target, err := s.moderate(ctx, params, domain.GroupRoleAdmin)
+	if err != nil {
+		return err
+	}
+	if target == nil || !target.IsActive() {
+		return pkg.ErrNotFound
+	}
+
+	if input.Role == domain.GroupRoleOwner {
+		if actor.Role != domain.GroupRoleOwner {
+			return pkg.ErrForbidden
+		}
+		if err := s.groupRepo.TransferOwnership(ctx, input.GroupID, input.UserID, target.UserID); err != nil {
+			return pkg.OrInternalError(err, pkg.ErrNotFound, pkg.ErrConflict)
+		}
+		return nil
+	}
+
+	if !actor.Role.Outranks(input.Role) {
+		return pkg.ErrForbidden
+	}
+	if target.Role == input.Role {
+		return nil
+	}
+
+	target.Role = input.Role
+	if err := s.groupRepo.SaveMember(ctx, target); err != nil {
+		return pkg.OrInternalError(err)
+	}
+	return nil
+}
+
+func (s *GroupServiceImpl) CheckContentAccess(ctx context.Context, viewerID, groupID int64) error {
+	group, member, err := s.visibleGroup(ctx, groupID, viewerID)
+	if err != nil {
+		return err
+	}
+	if group.Visibility != domain.GroupPublic && (member == nil || !member.IsActive()) {
+		return pkg.ErrForbidden
+	}
+	return nil
+}
+
+func (s *GroupServiceImpl) CheckMember(ctx context.Context, userID, groupID int64) error {
+	_, member, err := s.visibleGroup(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if member == nil || !member.IsActive() {
+		return pkg.ErrForbidden
+	}
+	return nil
+}
+
+func (s *GroupServiceImpl) CanModerate(ctx context.Context, userID, groupID int64) (bool, error) {
+	member, err := s.membership(ctx, groupID, userID)
+	if err != nil {
+		return false, err
+	}
+	return member != nil && member.HasRole(domain.GroupRoleModerator), nil
+}
+
+func (s *GroupServiceImpl) ListMemberIDs(ctx context.Context, groupID, afterID int64, limit int) ([]int64, error) {
+	ids, err := s.groupRepo.ListMemberIDs(ctx, groupID, afterID, limit)
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+	return ids, nil
+}
+
+func (s *GroupServiceImpl) FilterMemberOf(ctx context.Context, userID int64, groupIDs []int64) ([]int64, error) {
+	ids, err := s.groupRepo.FilterMemberOf(ctx, userID, groupIDs)
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+	return ids, nil
+}
+
+// Internal helpers
+
+// membership returns the relation of userID to the group, nil if there is none.
+func (s *GroupServiceImpl) membership(ctx context.Context, groupID, userID int64) (*domain.GroupMember, error) {
+	member, err := s.groupRepo.GetMember(ctx, groupID, userID)
+	if errors.Is(err, pkg.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+	return member, nil
+}
+
+// visibleGroup loads the group with the viewer's membership. Secret groups
+// are reported as missing to everyone but their members and invitees.
+func (s *GroupServiceImpl) visibleGroup(ctx context.Context, groupID, viewerID int64) (*domain.Group, *domain.GroupMember, error) {
+	group, err := s.groupRepo.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, nil, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	member, err := s.membership(ctx, groupID, viewerID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if group.Visibility == domain.GroupSecret && (member == nil || member.Status == domain.GroupMemberBanned) {
+		return nil, nil, pkg.ErrNotFound
+	}
+	return group, member, nil
+}
+
+// authorize is visibleGroup for actions that need an active member with at
+// least the given role.
+func (s *GroupServiceImpl) authorize(
+	ctx context.Context,
+	groupID, userID int64,
+	role domain.GroupRole,
+) (*domain.Group, *domain.GroupMember, error) {
+	group, member, err := s.visibleGroup(ctx, groupID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if member == nil || !member.HasRole(role) {
+		return nil, nil, pkg.ErrForbidden
+	}
+	return group, member, nil
+}
+
+// moderate authorizes the caller to act on input.MemberID and returns both
+// memberships; the target one is nil if there is none. Active members can
+// only be acted on by callers who outrank them, and never by themselves.
+func (s *GroupServiceImpl) moderate(
+	ctx context.Context,
+	input domain.GroupMemberParams,
+	role domain.GroupRole,
+) (*domain.GroupMember, *domain.GroupMember, error) {
+	if input.UserID == input.MemberID {
+		return nil, nil, pkg.ErrInvalidData
+	}
+
+	_, actor, err := s.authorize(ctx, input.GroupID, input.UserID, role)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	target, err := s.membership(ctx, input.GroupID, input.MemberID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if target != nil && target.IsActive() && !actor.Role.Outranks(target.Role) {
+		return nil, nil, pkg.ErrForbidden
+	}
+	return actor, target, nil
+}
+
+func (s *GroupServiceImpl) deleteMember(ctx context.Context, member *domain.GroupMember) error {
+	if err := s.groupRepo.DeleteMember(ctx, member.GroupID, member.UserID); err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	return nil
+}
+
+func (s *GroupServiceImpl) listMembers(
+	ctx context.Context,
+	input domain.ListGroupMembersParams,
+	status domain.GroupMemberStatus,
+) (domain.Page, error) {
+	var empty domain.Page
+
+	afterID, err := decodeIDCursor(input.Page.Cursor)
+	if err != nil {
+		return empty, err
+	}
+
+	limit := input.Page.Size()
+	members, err := s.groupRepo.ListMembers(ctx, domain.GroupMemberFilter{
+		GroupID:     input.GroupID,
+		Status:      status,
+		AfterUserID: afterID,
+		Limit:       limit + 1,
+	})
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	items := make([]domain.GroupMemberResponse, 0, len(members))
+	for i := range members {
+		res := members[i].ToResponse()
+		res.Avatar = s.mediaSvc.GetPublicURL(res.Avatar)
+		items = append(items, res)
+	}
+
+	return newPage(items, limit, func(m domain.GroupMemberResponse) string {
+		return pkg.EncodeCursor(m.ID)
+	}), nil
+}
+
+// confirmCover accepts only a cover uploaded by the caller to the groups domain.
+func (s *GroupServiceImpl) confirmCover(ctx context.Context, userID int64, objectKey string) (string, error) {
+	prefix := fmt.Sprintf("%s/%d/%s/", domain.DomainGroup, userID, domain.FeatureCover)
+	if !strings.HasPrefix(objectKey, prefix) {
+		return "", pkg.ErrInvalidData
+	}
+
+	key, err := s.mediaSvc.ConfirmUpload(ctx, domain.ConfirmFileParams{
+		UserID:    userID,
+		ObjectKey: objectKey,
+		Domain:    domain.DomainGroup,
+		Feature:   domain.FeatureCover,
+	})
+	if err != nil {
+		return "", pkg.OrInternalError(err, pkg.ErrBadRequest, pkg.ErrForbidden, pkg.ErrNotFound)
+	}
+	return key, nil
+}
+
+func (s *GroupServiceImpl) deleteFiles(ctx context.Context, from string, keys ...string) {
+	for _, key := range keys {
+		if err := s.mediaSvc.DeleteFile(ctx, key); err != nil {
+			pkg.Log().Errorw("[STORAGE ERROR]", "from", from, "key", key, "error", err)
+		}
+	}
+}
+
+// mapGroup builds the response with the viewer's membership, if any.
+func (s *GroupServiceImpl) mapGroup(group *domain.Group, member *domain.GroupMember) domain.GroupResponse {
+	res := group.ToResponse()
+	res.Cover = s.mediaSvc.GetPublicURL(res.Cover)
+	if member != nil {
+		res.Membership = &domain.GroupMembershipResponse{Role: member.Role, Status: member.Status}
+	}
+	return res
+}
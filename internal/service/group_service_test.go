@@ -0,0 +1,485 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"air-social/internal/domain"
+	"air-social/internal/mocks"
+	"air-social/pkg"
+)
+
+type groupServiceSuite struct {
+	suite.Suite
+}
+
+func TestGroupServiceSuite(t *testing.T) {
+	suite.Run(t, new(groupServiceSuite))
+}
+
+type groupMocks struct {
+	repo  *mocks.GroupRepository
+	user  *mocks.UserService
+	media *mocks.MediaService
+}
+
+func (s *groupServiceSuite) newService() (*GroupServiceImpl, groupMocks) {
+	m := groupMocks{
+		repo:  mocks.NewGroupRepository(s.T()),
+		user:  mocks.NewUserService(s.T()),
+		media: mocks.NewMediaService(s.T()),
+	}
+	return NewGroupService(m.repo, m.user, m.media), m
+}
+
+func member(groupID, userID int64, role domain.GroupRole, status domain.GroupMemberStatus) *domain.GroupMember {
+	return &domain.GroupMember{GroupID: groupID, UserID: userID, Role: role, Status: status}
+}
+
+func (s *groupServiceSuite) TestJoin() {
+	var (
+		userID  int64 = 1
+		groupID int64 = 10
+	)
+
+	group := func(v domain.GroupVisibility) *domain.Group {
+		return &domain.Group{ID: groupID, Visibility: v}
+	}
+
+	tests := []struct {
+		name       string
+		setupMock  func(m groupMocks)
+		wantStatus domain.GroupMemberStatus
+		wantErr    error
+	}{
+		{
+			name: "public_joins_directly",
+			setupMock: func(m groupMocks) {
+				m.repo.EXPECT().GetByID(mock.Anything, groupID).Return(group(domain.GroupPublic), nil).Once()
+				m.repo.EXPECT().GetMember(mock.Anything, groupID, userID).Return(nil, pkg.ErrNotFound).Once()
+				m.repo.EXPECT().SaveMember(mock.Anything,
+					member(groupID, userID, domain.GroupRoleMember, domain.GroupMemberActive)).Return(nil).Once()
+			},
+			wantStatus: domain.GroupMemberActive,
+		},
+		{
+			name: "private_files_request",
+			setupMock: func(m groupMocks) {
+				m.repo.EXPECT().GetByID(mock.Anything, groupID).Return(group(domain.GroupPrivate), nil).Once()
+				m.repo.EXPECT().GetMember(mock.Anything, groupID, userID).Return(nil, pkg.ErrNotFound).Once()
+				m.repo.EXPECT().SaveMember(mock.Anything,
+					member(groupID, userID, domain.GroupRoleMember, domain.GroupMemberPending)).Return(nil).Once()
+			},
+			wantStatus: domain.GroupMemberPending,
+		},
+		{
+			name: "pending_request_kept",
+			setupMock: func(m groupMocks) {
+				m.repo.EXPECT().GetByID(mock.Anything, groupID).Return(group(domain.GroupPrivate), nil).Once()
+				m.repo.EXPECT().GetMember(mock.Anything, groupID, userID).
+					Return(member(groupID, userID, domain.GroupRoleMember, domain.GroupMemberPending), nil).Once()
+			},
+			wantStatus: domain.GroupMemberPending,
+		},
+		{
+			name: "secret_invitation_accepted",
+			setupMock: func(m groupMocks) {
+				m.repo.EXPECT().GetByID(mock.Anything, groupID).Return(group(domain.GroupSecret), nil).Once()
+				m.repo.EXPECT().GetMember(mock.Anything, groupID, userID).
+					Return(member(groupID, userID, domain.GroupRoleMember, domain.GroupMemberInvited), nil).Once()
+				m.repo.EXPECT().SaveMember(mock.Anything,
+					member(groupID, userID, domain.GroupRoleMember, domain.GroupMemberActive)).Return(nil).Once()
+			},
+			wantStatus: domain.GroupMemberActive,
+		},
+		{
+			name: "secret_hidden_without_invitation",
+			setupMock: func(m groupMocks) {
+				m.repo.EXPECT().GetByID(mock.Anything, groupID).Return(group(domain.GroupSecret), nil).Once()
+				m.repo.EXPECT().GetMember(mock.Anything, groupID, userID).Return(nil, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name: "banned",
+			setupMock: func(m groupMocks) {
+				m.repo.EXPECT().GetByID(mock.Anything, groupID).Return(group(domain.GroupPublic), nil).Once()
+				m.repo.EXPECT().GetMember(mock.Anything, groupID, userID).
+					Return(member(groupID, userID, domain.GroupRoleMember, domain.GroupMemberBanned), nil).Once()
+			},
+			wantErr: pkg.ErrForbidden,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			svc, m := s.newService()
+			tc.setupMock(m)
+
+			res, err := svc.Join(context.Background(), userID, groupID)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+				return
+			}
+			s.NoError(err)
+			s.Equal(tc.wantStatus, res.Status)
+		})
+	}
+}
+
+func (s *groupServiceSuite) TestLeave() {
+	var (
+		userID  int64 = 1
+		groupID int64 = 10
+	)
+
+	tests := []struct {
+		name      string
+		setupMock func(m groupMocks)
+		wantErr   error
+	}{
+		{
+			name: "member",
+			setupMock: func(m groupMocks) {
+				m.repo.EXPECT().GetMember(mock.Anything, groupID, userID).
+					Return(member(groupID, userID, domain.GroupRoleModerator, domain.GroupMemberActive), nil).Once()
+				m.repo.EXPECT().DeleteMember(mock.Anything, groupID, userID).Return(nil).Once()
+			},
+		},
+		{
+			name: "owner_hands_over",
+			setupMock: func(m groupMocks) {
+				m.repo.EXPECT().GetMember(mock.Anything, groupID, userID).
+					Return(member(groupID, userID, domain.GroupRoleOwner, domain.GroupMemberActive), nil).Once()
+				m.repo.EXPECT().RemoveOwner(mock.Anything, groupID, userID).Return(nil).Once()
+			},
+		},
+		{
+			name: "last_owner",
+			setupMock: func(m groupMocks) {
+				m.repo.EXPECT().GetMember(mock.Anything, groupID, userID).
+					Return(member(groupID, userID, domain.GroupRoleOwner, domain.GroupMemberActive), nil).Once()
+				m.repo.EXPECT().RemoveOwner(mock.Anything, groupID, userID).Return(pkg.ErrConflict).Once()
+			},
+			wantErr: pkg.ErrConflict,
+		},
+		{
+			name: "banned_keeps_ban",
+			setupMock: func(m groupMocks) {
+				m.repo.EXPECT().GetMember(mock.Anything, groupID, userID).
+					Return(member(groupID, userID, domain.GroupRoleMember, domain.GroupMemberBanned), nil).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			svc, m := s.newService()
+			tc.setupMock(m)
+
+			err := svc.Leave(context.Background(), userID, groupID)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *groupServiceSuite) TestModeration() {
+	var (
+		actorID  int64 = 1
+		targetID int64 = 2
+		groupID  int64 = 10
+	)
+	params := domain.GroupMemberParams{UserID: actorID, GroupID: groupID, MemberID: targetID}
+	public := &domain.Group{ID: groupID, Visibility: domain.GroupPublic}
+
+	setup := func(m groupMocks, actorRole domain.GroupRole, target *domain.GroupMember) {
+		m.repo.EXPECT().GetByID(mock.Anything, groupID).Return(public, nil).Once()
+		m.repo.EXPECT().GetMember(mock.Anything, groupID, actorID).
+			Return(member(groupID, actorID, actorRole, domain.GroupMemberActive), nil).Once()
+		if target == nil {
+			m.repo.EXPECT().GetMember(mock.Anything, groupID, targetID).Return(nil, pkg.ErrNotFound).Once()
+		} else {
+			m.repo.EXPECT().GetMember(mock.Anything, groupID, targetID).Return(target, nil).Once()
+		}
+	}
+
+	s.Run("self", func() {
+		svc, _ := s.newService()
+		err := svc.RemoveMember(context.Background(), domain.GroupMemberParams{UserID: actorID, GroupID: groupID, MemberID: actorID})
+		s.ErrorIs(err, pkg.ErrInvalidData)
+	})
+
+	s.Run("member_cannot_moderate", func() {
+		svc, m := s.newService()
+		m.repo.EXPECT().GetByID(mock.Anything, groupID).Return(public, nil).Once()
+		m.repo.EXPECT().GetMember(mock.Anything, groupID, actorID).
+			Return(member(groupID, actorID, domain.GroupRoleMember, domain.GroupMemberActive), nil).Once()
+
+		s.ErrorIs(svc.RemoveMember(context.Background(), params), pkg.ErrForbidden)
+	})
+
+	s.Run("moderator_cannot_remove_moderator", func() {
+		svc, m := s.newService()
+		setup(m, domain.GroupRoleModerator, member(groupID, targetID, domain.GroupRoleModerator, domain.GroupMemberActive))
+
+		s.ErrorIs(svc.RemoveMember(context.Background(), params), pkg.ErrForbidden)
+	})
+
+	s.Run("moderator_removes_member", func() {
+		svc, m := s.newService()
+		setup(m, domain.GroupRoleModerator, member(groupID, targetID, domain.GroupRoleMember, domain.GroupMemberActive))
+		m.repo.EXPECT().DeleteMember(mock.Anything, groupID, targetID).Return(nil).Once()
+
+		s.NoError(svc.RemoveMember(context.Background(), params))
+	})
+
+	s.Run("approve_requires_pending", func() {
+		svc, m := s.newService()
+		setup(m, domain.GroupRoleAdmin, member(groupID, targetID, domain.GroupRoleMember, domain.GroupMemberInvited))
+
+		s.ErrorIs(svc.ApproveRequest(context.Background(), params), pkg.ErrNotFound)
+	})
+
+	s.Run("approve", func() {
+		svc, m := s.newService()
+		setup(m, domain.GroupRoleModerator, member(groupID, targetID, domain.GroupRoleMember, domain.GroupMemberPending))
+		m.repo.EXPECT().SaveMember(mock.Anything,
+			member(groupID, targetID, domain.GroupRoleMember, domain.GroupMemberActive)).Return(nil).Once()
+
+		s.NoError(svc.ApproveRequest(context.Background(), params))
+	})
+
+	s.Run("invite_new_user", func() {
+		svc, m := s.newService()
+		setup(m, domain.GroupRoleModerator, nil)
+		m.user.EXPECT().GetByID(mock.Anything, targetID).Return(&domain.User{ID: targetID}, nil).Once()
+		m.repo.EXPECT().SaveMember(mock.Anything,
+			member(groupID, targetID, domain.GroupRoleMember, domain.GroupMemberInvited)).Return(nil).Once()
+
+		s.NoError(svc.Invite(context.Background(), params))
+	})
+
+	s.Run("invite_banned", func() {
+		svc, m := s.newService()
+		setup(m, domain.GroupRoleModerator, member(groupID, targetID, domain.GroupRoleMember, domain.GroupMemberBanned))
+
+		s.ErrorIs(svc.Invite(context.Background(), params), pkg.ErrConflict)
+	})
+
+	s.Run("ban_demotes", func() {
+		svc, m := s.newService()
+		setup(m, domain.GroupRoleAdmin, member(groupID, targetID, domain.GroupRoleModerator, domain.GroupMemberActive))
+		m.repo.EXPECT().SaveMember(mock.Anything,
+			member(groupID, targetID, domain.GroupRoleMember, domain.GroupMemberBanned)).Return(nil).Once()
+
+		s.NoError(svc.Ban(context.Background(), params))
+	})
+
+	s.Run("unban", func() {
+		svc, m := s.newService()
+		setup(m, domain.GroupRoleModerator, member(groupID, targetID, domain.GroupRoleMember, domain.GroupMemberBanned))
+		m.repo.EXPECT().DeleteMember(mock.Anything, groupID, targetID).Return(nil).Once()
+
+		s.NoError(svc.Unban(context.Background(), params))
+	})
+}
+
+func (s *groupServiceSuite) TestUpdateMemberRole() {
+	var (
+		actorID  int64 = 1
+		targetID int64 = 2
+		groupID  int64 = 10
+	)
+
+	tests := []struct {
+		name      string
+		actorRole domain.GroupRole
+		target    *domain.GroupMember
+		role      domain.GroupRole
+		setupMock func(m groupMocks)
+		wantErr   error
+	}{
+		{
+			name:      "owner_appoints_admin",
+			actorRole: domain.GroupRoleOwner,
+			target:    member(groupID, targetID, domain.GroupRoleMember, domain.GroupMemberActive),
+			role:      domain.GroupRoleAdmin,
+			setupMock: func(m groupMocks) {
+				m.repo.EXPECT().SaveMember(mock.Anything,
+					member(groupID, targetID, domain.GroupRoleAdmin, domain.GroupMemberActive)).Return(nil).Once()
+			},
+		},
+		{
+			name:      "admin_cannot_appoint_admin",
+			actorRole: domain.GroupRoleAdmin,
+			target:    member(groupID, targetID, domain.GroupRoleModerator, domain.GroupMemberActive),
+			role:      domain.GroupRoleAdmin,
+			wantErr:   pkg.ErrForbidden,
+		},
+		{
+			name:      "admin_cannot_demote_admin",
+			actorRole: domain.GroupRoleAdmin,
+			target:    member(groupID, targetID, domain.GroupRoleAdmin, domain.GroupMemberActive),
+			role:      domain.GroupRoleMember,
+			wantErr:   pkg.ErrForbidden,
+		},
+		{
+			name:      "pending_member",
+			actorRole: domain.GroupRoleOwner,
+			target:    member(groupID, targetID, domain.GroupRoleMember, domain.GroupMemberPending),
+			role:      domain.GroupRoleModerator,
+			wantErr:   pkg.ErrNotFound,
+		},
+		{
+			name:      "owner_transfers",
+			actorRole: domain.GroupRoleOwner,
+			target:    member(groupID, targetID, domain.GroupRoleAdmin, domain.GroupMemberActive),
+			role:      domain.GroupRoleOwner,
+			setupMock: func(m groupMocks) {
+				m.repo.EXPECT().TransferOwnership(mock.Anything, groupID, actorID, targetID).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			svc, m := s.newService()
+			m.repo.EXPECT().GetByID(mock.Anything, groupID).Return(&domain.Group{ID: groupID, Visibility: domain.GroupPrivate}, nil).Once()
+			m.repo.EXPECT().GetMember(mock.Anything, groupID, actorID).
+				Return(member(groupID, actorID, tc.actorRole, domain.GroupMemberActive), nil).Once()
+			m.repo.EXPECT().GetMember(mock.Anything, groupID, targetID).Return(tc.target, nil).Once()
+			if tc.setupMock != nil {
+				tc.setupMock(m)
+			}
+
+			err := svc.UpdateMemberRole(context.Background(), domain.UpdateGroupMemberRoleParams{
+				UserID:   actorID,
+				GroupID:  groupID,
+				MemberID: targetID,
+				Role:     tc.role,
+			})
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *groupServiceSuite) TestUpdate() {
+	var (
+		userID  int64 = 1
+		groupID int64 = 10
+	)
+	coverKey := "groups/1/cover/new.jpg"
+
+	s.Run("foreign_cover", func() {
+		svc, m := s.newService()
+		m.repo.EXPECT().GetByID(mock.Anything, groupID).Return(&domain.Group{ID: groupID, Visibility: domain.GroupPublic}, nil).Once()
+		m.repo.EXPECT().GetMember(mock.Anything, groupID, userID).
+			Return(member(groupID, userID, domain.GroupRoleAdmin, domain.GroupMemberActive), nil).Once()
+
+		other := "groups/2/cover/x.jpg"
+		_, err := svc.Update(context.Background(), domain.UpdateGroupParams{UserID: userID, GroupID: groupID, Cover: &other})
+		s.ErrorIs(err, pkg.ErrInvalidData)
+	})
+
+	s.Run("replaces_cover", func() {
+		svc, m := s.newService()
+		m.repo.EXPECT().GetByID(mock.Anything, groupID).
+			Return(&domain.Group{ID: groupID, Name: "Go", Visibility: domain.GroupPublic, Cover: "groups/1/cover/old.jpg"}, nil).Once()
+		m.repo.EXPECT().GetMember(mock.Anything, groupID, userID).
+			Return(member(groupID, userID, domain.GroupRoleOwner, domain.GroupMemberActive), nil).Once()
+		m.media.EXPECT().ConfirmUpload(mock.Anything, domain.ConfirmFileParams{
+			UserID:    userID,
+			ObjectKey: coverKey,
+			Domain:    domain.DomainGroup,
+			Feature:   domain.FeatureCover,
+		}).Return(coverKey, nil).Once()
+		m.repo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(g *domain.Group) bool {
+			return g.Cover == coverKey && g.Name == "Gophers"
+		})).Return(nil).Once()
+		m.media.EXPECT().DeleteFile(mock.Anything, "groups/1/cover/old.jpg").Return(assert.AnError).Once()
+		m.media.EXPECT().GetPublicURL(coverKey).Return("url").Once()
+
+		name := " Gophers "
+		res, err := svc.Update(context.Background(), domain.UpdateGroupParams{
+			UserID:  userID,
+			GroupID: groupID,
+			Name:    &name,
+			Cover:   &coverKey,
+		})
+		s.NoError(err)
+		s.Equal("Gophers", res.Name)
+		s.Equal("url", res.Cover)
+		s.Equal(domain.GroupRoleOwner, res.Membership.Role)
+	})
+}
+
+func (s *groupServiceSuite) TestCheckContentAccess() {
+	var (
+		userID  int64 = 1
+		groupID int64 = 10
+	)
+
+	tests := []struct {
+		name       string
+		visibility domain.GroupVisibility
+		member     *domain.GroupMember
+		wantErr    error
+	}{
+		{name: "public_non_member", visibility: domain.GroupPublic},
+		{name: "private_non_member", visibility: domain.GroupPrivate, wantErr: pkg.ErrForbidden},
+		{
+			name:       "private_pending",
+			visibility: domain.GroupPrivate,
+			member:     member(groupID, userID, domain.GroupRoleMember, domain.GroupMemberPending),
+			wantErr:    pkg.ErrForbidden,
+		},
+		{
+			name:       "private_member",
+			visibility: domain.GroupPrivate,
+			member:     member(groupID, userID, domain.GroupRoleMember, domain.GroupMemberActive),
+		},
+		{name: "secret_non_member", visibility: domain.GroupSecret, wantErr: pkg.ErrNotFound},
+		{
+			name:       "secret_invited",
+			visibility: domain.GroupSecret,
+			member:     member(groupID, userID, domain.GroupRoleMember, domain.GroupMemberInvited),
+			wantErr:    pkg.ErrForbidden,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			svc, m := s.newService()
+			m.repo.EXPECT().GetByID(mock.Anything, groupID).Return(&domain.Group{ID: groupID, Visibility: tc.visibility}, nil).Once()
+			if tc.member == nil {
+				m.repo.EXPECT().GetMember(mock.Anything, groupID, userID).Return(nil, pkg.ErrNotFound).Once()
+			} else {
+				m.repo.EXPECT().GetMember(mock.Anything, groupID, userID).Return(tc.member, nil).Once()
+			}
+
+			err := svc.CheckContentAccess(context.Background(), userID, groupID)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
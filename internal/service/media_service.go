@@ -130,6 +130,11 @@ func (s *MediaServiceImpl) getValidationRules(d domain.UploadDomain, f domain.Up
 			return domain.UploadRule{MaxBytes: domain.Limit100MB, AllowedTypes: domain.VideoAllowedTypes}, nil
 		}
 
+	case domain.DomainGroup:
+		if f == domain.FeatureCover {
+			return domain.UploadRule{MaxBytes: domain.Limit5MB, AllowedTypes: domain.ImageAllowedTypes}, nil
+		}
+
 	case domain.DomainMessage:
 		if f == domain.FeatureVoiceChat {
 			return domain.UploadRule{MaxBytes: domain.Limit10MB, AllowedTypes: domain.AudioAllowedTypes}, nil
@@ -137,6 +142,9 @@ func (s *MediaServiceImpl) getValidationRules(d domain.UploadDomain, f domain.Up
 		if f == domain.FeatureFeedImage {
 			return domain.UploadRule{MaxBytes: domain.Limit10MB, AllowedTypes: domain.ImageAllowedTypes}, nil
 		}
+		if f == domain.FeatureAvatar {
+			return domain.UploadRule{MaxBytes: domain.Limit5MB, AllowedTypes: domain.ImageAllowedTypes}, nil
+		}
 	}
 
 	return domain.UploadRule{}, pkg.ErrFileUnsupported
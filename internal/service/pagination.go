@@ -0,0 +1,34 @@
+package service
+
+import (
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+// newPage trims the look-ahead row that repositories fetch (limit+1)
+// and derives the next cursor from the last item that is kept.
+func newPage[T any](items []T, limit int, cursorOf func(T) string) domain.Page {
+	var page domain.Page
+	if len(items) > limit {
+		items = items[:limit]
+		page.HasMore = true
+		page.NextCursor = cursorOf(items[limit-1])
+	}
+	if items == nil {
+		items = []T{}
+	}
+	page.Items = items
+	return page
+}
+
+// decodeIDCursor decodes a single-key cursor; an empty cursor yields 0.
+func decodeIDCursor(cursor string) (int64, error) {
+	keys, err := pkg.DecodeCursor(cursor, 1)
+	if err != nil {
+		return 0, err
+	}
+	if keys == nil {
+		return 0, nil
+	}
+	return keys[0], nil
+}
@@ -0,0 +1,453 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"air-social/internal/domain"
+	"air-social/internal/infrastructure/rabbitmq"
+	"air-social/pkg"
+)
+
+type PostService interface {
+	Create(ctx context.Context, input domain.CreatePostParams) (domain.PostResponse, error)
+	Update(ctx context.Context, input domain.UpdatePostParams) (domain.PostResponse, error)
+	Delete(ctx context.Context, userID, postID int64) error
+	GetByID(ctx context.Context, viewerID, postID int64) (domain.PostResponse, error)
+	ListByAuthor(ctx context.Context, input domain.ListPostsParams) (domain.Page, error)
+	ListByGroup(ctx context.Context, input domain.ListGroupPostsParams) (domain.Page, error)
+}
+
+type PostServiceImpl struct {
+	postRepo    domain.PostRepository
+	followSvc   FollowService
+	groupSvc    GroupService
+	reactionSvc ReactionService
+	mediaSvc    MediaService
+	event       domain.EventPublisher
+}
+
+func NewPostService(
+	postRepo domain.PostRepository,
+	followSvc FollowService,
+	groupSvc GroupService,
+	reactionSvc ReactionService,
+	mediaSvc MediaService,
+	event domain.EventPublisher,
+) *PostServiceImpl {
+	return &PostServiceImpl{
+		postRepo:    postRepo,
+		followSvc:   followSvc,
+		groupSvc:    groupSvc,
+		reactionSvc: reactionSvc,
+		mediaSvc:    mediaSvc,
+		event:       event,
+	}
+}
+
+// Create publishes a post on the author's profile, or in a group when GroupID
+// is set. Only active members may post in a group, and group posts are public.
+func (s *PostServiceImpl) Create(ctx context.Context, input domain.CreatePostParams) (domain.PostResponse, error) {
+	var empty domain.PostResponse
+
+	content := strings.TrimSpace(input.Content)
+	if content == "" && len(input.Media) == 0 {
+		return empty, pkg.ErrInvalidData
+	}
+
+	visibility := input.Visibility
+	if visibility == "" {
+		visibility = domain.VisibilityPublic
+	}
+
+	if input.GroupID != nil {
+		if visibility != domain.VisibilityPublic {
+			return empty, pkg.ErrInvalidData
+		}
+		if err := s.groupSvc.CheckMember(ctx, input.AuthorID, *input.GroupID); err != nil {
+			return empty, err
+		}
+	}
+
+	media, err := s.confirmMedia(ctx, input.AuthorID, input.Media, nil)
+	if err != nil {
+		return empty, err
+	}
+
+	post := &domain.Post{
+		AuthorID:   input.AuthorID,
+		GroupID:    input.GroupID,
+		Content:    content,
+		Visibility: visibility,
+		Media:      media,
+	}
+
+	if err := s.postRepo.Create(ctx, post); err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	s.publishPostEvent(ctx, domain.PostCreated, rabbitmq.PostCreatedRoutingKey, post)
+	return s.mapToResponse(post), nil
+}
+
+func (s *PostServiceImpl) Update(ctx context.Context, input domain.UpdatePostParams) (domain.PostResponse, error) {
+	var empty domain.PostResponse
+
+	post, err := s.getOwnedPost(ctx, input.UserID, input.PostID)
+	if err != nil {
+		return empty, err
+	}
+
+	if input.Content != nil {
+		post.Content = strings.TrimSpace(*input.Content)
+	}
+	wasPrivate := post.Visibility == domain.VisibilityPrivate
+	if input.Visibility != nil {
+		if post.GroupID != nil && *input.Visibility != domain.VisibilityPublic {
+			return empty, pkg.ErrInvalidData
+		}
+		post.Visibility = *input.Visibility
+	}
+
+	var removed []domain.PostMedia
+	if input.Media != nil {
+		media, err := s.confirmMedia(ctx, input.UserID, *input.Media, post.Media)
+		if err != nil {
+			return empty, err
+		}
+		removed = diffMedia(post.Media, media)
+		post.Media = media
+	}
+
+	if post.Content == "" && len(post.Media) == 0 {
+		return empty, pkg.ErrInvalidData
+	}
+
+	if err := s.postRepo.Update(ctx, post); err != nil {
+		return empty, pkg.OrInternalError(err, pkg.ErrConflict)
+	}
+
+	s.deleteMediaFiles(ctx, removed)
+	if wasPrivate {
+		s.publishPostEvent(ctx, domain.PostVisibilityChanged, rabbitmq.PostVisibilityChangedRoutingKey, post)
+	}
+	return s.mapWithReactions(ctx, input.UserID, post)
+}
+
+// Delete removes a post of the caller. Group moderators may remove any post
+// of their group.
+func (s *PostServiceImpl) Delete(ctx context.Context, userID, postID int64) error {
+	post, err := s.postRepo.GetByID(ctx, postID)
+	if err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	if post.AuthorID != userID {
+		if post.GroupID == nil {
+			return pkg.ErrForbidden
+		}
+		canModerate, err := s.groupSvc.CanModerate(ctx, userID, *post.GroupID)
+		if err != nil {
+			return err
+		}
+		if !canModerate {
+			return pkg.ErrForbidden
+		}
+	}
+
+	if err := s.postRepo.Delete(ctx, post.ID); err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	s.deleteMediaFiles(ctx, post.Media)
+	return nil
+}
+
+func (s *PostServiceImpl) GetByID(ctx context.Context, viewerID, postID int64) (domain.PostResponse, error) {
+	var empty domain.PostResponse
+
+	post, err := s.postRepo.GetByID(ctx, postID)
+	if err != nil {
+		return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	visible, err := s.canView(ctx, viewerID, post)
+	if err != nil {
+		return empty, err
+	}
+	// Hidden posts are reported as missing so their existence is not leaked.
+	if !visible {
+		return empty, pkg.ErrNotFound
+	}
+
+	return s.mapWithReactions(ctx, viewerID, post)
+}
+
+func (s *PostServiceImpl) ListByAuthor(ctx context.Context, input domain.ListPostsParams) (domain.Page, error) {
+	var empty domain.Page
+
+	beforeID, err := decodeIDCursor(input.Page.Cursor)
+	if err != nil {
+		return empty, err
+	}
+
+	visibilities, err := s.visibleTo(ctx, input.ViewerID, input.AuthorID)
+	if err != nil {
+		return empty, err
+	}
+
+	limit := input.Page.Size()
+	filter := domain.PostListFilter{
+		AuthorID:     input.AuthorID,
+		Visibilities: visibilities,
+		BeforeID:     beforeID,
+		Limit:        limit + 1,
+	}
+
+	posts, err := s.postRepo.ListByAuthor(ctx, filter)
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	items := make([]domain.PostResponse, 0, len(posts))
+	for i := range posts {
+		items = append(items, s.mapToResponse(&posts[i]))
+	}
+
+	page := newPage(items, limit, func(p domain.PostResponse) string {
+		return pkg.EncodeCursor(p.ID)
+	})
+	if err := attachPostReactions(ctx, s.reactionSvc, input.ViewerID, page.Items.([]domain.PostResponse)); err != nil {
+		return empty, err
+	}
+	return page, nil
+}
+
+// ListByGroup pages through the posts of a group, newest first. Posts of
+// private and secret groups are visible to members only.
+func (s *PostServiceImpl) ListByGroup(ctx context.Context, input domain.ListGroupPostsParams) (domain.Page, error) {
+	var empty domain.Page
+
+	if err := s.groupSvc.CheckContentAccess(ctx, input.ViewerID, input.GroupID); err != nil {
+		return empty, err
+	}
+
+	beforeID, err := decodeIDCursor(input.Page.Cursor)
+	if err != nil {
+		return empty, err
+	}
+
+	limit := input.Page.Size()
+	posts, err := s.postRepo.ListByGroup(ctx, domain.PostGroupFilter{
+		GroupID:  input.GroupID,
+		BeforeID: beforeID,
+		Limit:    limit + 1,
+	})
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	items := make([]domain.PostResponse, 0, len(posts))
+	for i := range posts {
+		items = append(items, s.mapToResponse(&posts[i]))
+	}
+
+	page := newPage(items, limit, func(p domain.PostResponse) string {
+		return pkg.EncodeCursor(p.ID)
+	})
+	if err := attachPostReactions(ctx, s.reactionSvc, input.ViewerID, page.Items.([]domain.PostResponse)); err != nil {
+		return empty, err
+	}
+	return page, nil
+}
+
+// Internal helpers
+
+func (s *PostServiceImpl) getOwnedPost(ctx context.Context, userID, postID int64) (*domain.Post, error) {
+	post, err := s.postRepo.GetByID(ctx, postID)
+	if err != nil {
+		return nil, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	if post.AuthorID != userID {
+		return nil, pkg.ErrForbidden
+	}
+	return post, nil
+}
+
+func (s *PostServiceImpl) canView(ctx context.Context, viewerID int64, post *domain.Post) (bool, error) {
+	return canViewPost(ctx, s.followSvc, s.groupSvc, viewerID, post)
+}
+
+// visibleTo lists the visibilities of authorID's posts that viewerID may read.
+func (s *PostServiceImpl) visibleTo(ctx context.Context, viewerID, authorID int64) ([]domain.PostVisibility, error) {
+	if viewerID == authorID {
+		return []domain.PostVisibility{domain.VisibilityPublic, domain.VisibilityFollowers, domain.VisibilityPrivate}, nil
+	}
+
+	following, err := s.followSvc.IsFollowing(ctx, viewerID, authorID)
+	if err != nil {
+		return nil, err
+	}
+	if following {
+		return []domain.PostVisibility{domain.VisibilityPublic, domain.VisibilityFollowers}, nil
+	}
+	return []domain.PostVisibility{domain.VisibilityPublic}, nil
+}
+
+// confirmMedia turns the requested attachments into ordered PostMedia.
+//
+// Keys already attached to the post are kept as-is. New keys must belong to the
+// posts domain of the caller and are confirmed through MediaService, which
+// checks the Redis upload session so users cannot attach other people's objects.
+func (s *PostServiceImpl) confirmMedia(
+	ctx context.Context,
+	userID int64,
+	items []domain.PostMediaItem,
+	attached []domain.PostMedia,
+) ([]domain.PostMedia, error) {
+	existing := make(map[string]domain.PostMedia, len(attached))
+	for _, m := range attached {
+		existing[m.ObjectKey] = m
+	}
+
+	seen := make(map[string]struct{}, len(items))
+	media := make([]domain.PostMedia, 0, len(items))
+
+	for _, item := range items {
+		if _, dup := seen[item.ObjectKey]; dup {
+			return nil, pkg.ErrInvalidData
+		}
+		seen[item.ObjectKey] = struct{}{}
+
+		if m, ok := existing[item.ObjectKey]; ok {
+			media = append(media, domain.PostMedia{ObjectKey: m.ObjectKey, Feature: m.Feature})
+			continue
+		}
+
+		prefix := fmt.Sprintf("%s/%d/%s/", domain.DomainPost, userID, item.Feature)
+		if !strings.HasPrefix(item.ObjectKey, prefix) {
+			return nil, pkg.ErrInvalidData
+		}
+
+		key, err := s.mediaSvc.ConfirmUpload(ctx, domain.ConfirmFileParams{
+			UserID:    userID,
+			ObjectKey: item.ObjectKey,
+			Domain:    domain.DomainPost,
+			Feature:   item.Feature,
+		})
+		if err != nil {
+			return nil, pkg.OrInternalError(err, pkg.ErrBadRequest, pkg.ErrForbidden, pkg.ErrNotFound)
+		}
+
+		media = append(media, domain.PostMedia{ObjectKey: key, Feature: item.Feature})
+	}
+
+	return media, nil
+}
+
+func (s *PostServiceImpl) deleteMediaFiles(ctx context.Context, media []domain.PostMedia) {
+	for _, m := range media {
+		if err := s.mediaSvc.DeleteFile(ctx, m.ObjectKey); err != nil {
+			pkg.Log().Errorw("[STORAGE ERROR]", "from", "post_media_delete", "key", m.ObjectKey, "error", err)
+		}
+	}
+}
+
+// publishPostEvent hands the post over to the feed fan-out worker, either when
+// it is created or when a private post is widened to followers/public.
+// Private posts never reach other timelines, so no event is sent for them.
+func (s *PostServiceImpl) publishPostEvent(ctx context.Context, eventType domain.EventType, routingKey string, post *domain.Post) {
+	if post.Visibility == domain.VisibilityPrivate {
+		return
+	}
+
+	payload := domain.EventPayload{
+		EventID:   uuid.NewString(),
+		EventType: eventType,
+		Timestamp: pkg.TimeNowUTC(),
+		Data: domain.EventPostData{
+			PostID:     post.ID,
+			AuthorID:   post.AuthorID,
+			Visibility: post.Visibility,
+			GroupID:    post.GroupID,
+		},
+	}
+
+	if err := s.event.Publish(ctx, routingKey, payload); err != nil {
+		pkg.Log().Errorw("[EVENT QUEUE ERROR]", "from", string(eventType), "post_id", post.ID, "error", err)
+	}
+}
+
+func (s *PostServiceImpl) mapToResponse(post *domain.Post) domain.PostResponse {
+	return mapPostResponse(s.mediaSvc, post)
+}
+
+func (s *PostServiceImpl) mapWithReactions(ctx context.Context, viewerID int64, post *domain.Post) (domain.PostResponse, error) {
+	items := []domain.PostResponse{s.mapToResponse(post)}
+	if err := attachPostReactions(ctx, s.reactionSvc, viewerID, items); err != nil {
+		return domain.PostResponse{}, err
+	}
+	return items[0], nil
+}
+
+func mapPostResponse(mediaSvc MediaService, post *domain.Post) domain.PostResponse {
+	res := post.ToResponse()
+	for i := range res.Media {
+		res.Media[i].URL = mediaSvc.GetPublicURL(res.Media[i].ObjectKey)
+	}
+	return res
+}
+
+// attachPostReactions fills in reaction counts and the viewer's own reaction.
+func attachPostReactions(ctx context.Context, reactionSvc ReactionService, viewerID int64, items []domain.PostResponse) error {
+	return attachReactions(ctx, reactionSvc, viewerID, domain.ReactionTargetPost, items,
+		func(p *domain.PostResponse) (int64, *domain.ReactionSummary) { return p.ID, &p.Reactions })
+}
+
+// canViewPost reports whether viewerID may read the post. It is shared with
+// ReactionService, which cannot depend on PostService without a cycle.
+func canViewPost(
+	ctx context.Context,
+	followSvc FollowService,
+	groupSvc GroupService,
+	viewerID int64,
+	post *domain.Post,
+) (bool, error) {
+	switch {
+	case post.AuthorHidden:
+		return false, nil
+	case post.AuthorID == viewerID:
+		return true, nil
+	case post.GroupID != nil:
+		err := groupSvc.CheckContentAccess(ctx, viewerID, *post.GroupID)
+		if errors.Is(err, pkg.ErrForbidden) || errors.Is(err, pkg.ErrNotFound) {
+			return false, nil
+		}
+		return err == nil, err
+	case post.Visibility == domain.VisibilityPublic:
+		return true, nil
+	case post.Visibility == domain.VisibilityFollowers:
+		return followSvc.IsFollowing(ctx, viewerID, post.AuthorID)
+	default:
+		return false, nil
+	}
+}
+
+// diffMedia returns the attachments in before that are no longer in after.
+func diffMedia(before, after []domain.PostMedia) []domain.PostMedia {
+	kept := make(map[string]struct{}, len(after))
+	for _, m := range after {
+		kept[m.ObjectKey] = struct{}{}
+	}
+
+	var removed []domain.PostMedia
+	for _, m := range before {
+		if _, ok := kept[m.ObjectKey]; !ok {
+			removed = append(removed, m)
+		}
+	}
+	return removed
+}
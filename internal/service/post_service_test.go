@@ -0,0 +1,481 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"air-social/internal/domain"
+	"air-social/internal/mocks"
+	"air-social/pkg"
+)
+
+type postServiceSuite struct {
+	suite.Suite
+}
+
+func TestPostServiceSuite(t *testing.T) {
+	suite.Run(t, new(postServiceSuite))
+}
+
+func (s *postServiceSuite) TestCreate() {
+	var (
+		userID   int64 = 1
+		imageKey       = "posts/1/feed_image/1_a.jpg"
+	)
+
+	type args struct {
+		input domain.CreatePostParams
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		setupMock func(repo *mocks.PostRepository, media *mocks.MediaService)
+		wantErr   error
+	}{
+		{
+			name:    "empty_post",
+			args:    args{input: domain.CreatePostParams{AuthorID: userID, Content: "   "}},
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name: "foreign_object_key",
+			args: args{input: domain.CreatePostParams{
+				AuthorID: userID,
+				Media:    []domain.PostMediaItem{{ObjectKey: "posts/2/feed_image/1_a.jpg", Feature: domain.FeatureFeedImage}},
+			}},
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name: "feature_mismatch",
+			args: args{input: domain.CreatePostParams{
+				AuthorID: userID,
+				Media:    []domain.PostMediaItem{{ObjectKey: imageKey, Feature: domain.FeatureFeedVideo}},
+			}},
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name: "duplicate_media",
+			args: args{input: domain.CreatePostParams{
+				AuthorID: userID,
+				Media: []domain.PostMediaItem{
+					{ObjectKey: imageKey, Feature: domain.FeatureFeedImage},
+					{ObjectKey: imageKey, Feature: domain.FeatureFeedImage},
+				},
+			}},
+			setupMock: func(repo *mocks.PostRepository, media *mocks.MediaService) {
+				media.EXPECT().ConfirmUpload(mock.Anything, mock.Anything).Return(imageKey, nil).Once()
+			},
+			wantErr: pkg.ErrInvalidData,
+		},
+		{
+			name: "upload_session_not_owned",
+			args: args{input: domain.CreatePostParams{
+				AuthorID: userID,
+				Media:    []domain.PostMediaItem{{ObjectKey: imageKey, Feature: domain.FeatureFeedImage}},
+			}},
+			setupMock: func(repo *mocks.PostRepository, media *mocks.MediaService) {
+				media.EXPECT().ConfirmUpload(mock.Anything, mock.Anything).Return("", pkg.ErrForbidden).Once()
+			},
+			wantErr: pkg.ErrForbidden,
+		},
+		{
+			name: "repo_error",
+			args: args{input: domain.CreatePostParams{AuthorID: userID, Content: "hello"}},
+			setupMock: func(repo *mocks.PostRepository, media *mocks.MediaService) {
+				repo.EXPECT().Create(mock.Anything, mock.Anything).Return(assert.AnError).Once()
+			},
+			wantErr: pkg.ErrInternal,
+		},
+		{
+			name: "success",
+			args: args{input: domain.CreatePostParams{
+				AuthorID: userID,
+				Content:  " hello ",
+				Media:    []domain.PostMediaItem{{ObjectKey: imageKey, Feature: domain.FeatureFeedImage}},
+			}},
+			setupMock: func(repo *mocks.PostRepository, media *mocks.MediaService) {
+				media.EXPECT().ConfirmUpload(mock.Anything, domain.ConfirmFileParams{
+					UserID:    userID,
+					ObjectKey: imageKey,
+					Domain:    domain.DomainPost,
+					Feature:   domain.FeatureFeedImage,
+				}).Return(imageKey, nil).Once()
+
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(p *domain.Post) bool {
+					return p.AuthorID == userID &&
+						p.Content == "hello" &&
+						p.Visibility == domain.VisibilityPublic &&
+						len(p.Media) == 1
+				})).Return(nil).Once()
+
+				media.EXPECT().GetPublicURL(imageKey).Return("http://cdn/" + imageKey).Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockRepo := mocks.NewPostRepository(s.T())
+			mockMedia := mocks.NewMediaService(s.T())
+			mockEvent := mocks.NewEventPublisher(s.T())
+			svc := NewPostService(mockRepo, mocks.NewFollowService(s.T()), mocks.NewGroupService(s.T()), mocks.NewReactionService(s.T()), mockMedia, mockEvent)
+
+			if tc.setupMock != nil {
+				tc.setupMock(mockRepo, mockMedia)
+			}
+			if tc.wantErr == nil {
+				mockEvent.EXPECT().Publish(mock.Anything, "post.created", mock.MatchedBy(func(p domain.EventPayload) bool {
+					data, ok := p.Data.(domain.EventPostData)
+					return ok && p.EventType == domain.PostCreated && data.AuthorID == userID
+				})).Return(nil).Once()
+			}
+
+			got, err := svc.Create(context.Background(), tc.args.input)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+				s.Empty(got)
+			} else {
+				s.NoError(err)
+				s.Equal("hello", got.Content)
+				s.Len(got.Media, 1)
+				s.Equal("http://cdn/"+imageKey, got.Media[0].URL)
+			}
+		})
+	}
+}
+
+func (s *postServiceSuite) TestUpdate() {
+	var (
+		userID  int64 = 1
+		postID  int64 = 10
+		oldKey        = "posts/1/feed_image/1_old.jpg"
+		newKey        = "posts/1/feed_image/2_new.jpg"
+		content       = "edited"
+		public        = domain.VisibilityPublic
+	)
+
+	existing := func() *domain.Post {
+		return &domain.Post{
+			ID:         postID,
+			AuthorID:   userID,
+			Content:    "original",
+			Visibility: domain.VisibilityPublic,
+			Media:      []domain.PostMedia{{PostID: postID, ObjectKey: oldKey, Feature: domain.FeatureFeedImage}},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		input     domain.UpdatePostParams
+		setupMock func(repo *mocks.PostRepository, media *mocks.MediaService, event *mocks.EventPublisher)
+		wantErr   error
+	}{
+		{
+			name:  "not_found",
+			input: domain.UpdatePostParams{UserID: userID, PostID: postID},
+			setupMock: func(repo *mocks.PostRepository, media *mocks.MediaService, event *mocks.EventPublisher) {
+				repo.EXPECT().GetByID(mock.Anything, postID).Return(nil, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name:  "not_owner",
+			input: domain.UpdatePostParams{UserID: 2, PostID: postID, Content: &content},
+			setupMock: func(repo *mocks.PostRepository, media *mocks.MediaService, event *mocks.EventPublisher) {
+				repo.EXPECT().GetByID(mock.Anything, postID).Return(existing(), nil).Once()
+			},
+			wantErr: pkg.ErrForbidden,
+		},
+		{
+			name: "replace_media",
+			input: domain.UpdatePostParams{
+				UserID:  userID,
+				PostID:  postID,
+				Content: &content,
+				Media:   &[]domain.PostMediaItem{{ObjectKey: newKey, Feature: domain.FeatureFeedImage}},
+			},
+			setupMock: func(repo *mocks.PostRepository, media *mocks.MediaService, event *mocks.EventPublisher) {
+				repo.EXPECT().GetByID(mock.Anything, postID).Return(existing(), nil).Once()
+				media.EXPECT().ConfirmUpload(mock.Anything, mock.Anything).Return(newKey, nil).Once()
+				repo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(p *domain.Post) bool {
+					return p.Content == content && len(p.Media) == 1 && p.Media[0].ObjectKey == newKey
+				})).Return(nil).Once()
+				media.EXPECT().DeleteFile(mock.Anything, oldKey).Return(nil).Once()
+				media.EXPECT().GetPublicURL(newKey).Return("url").Once()
+			},
+		},
+		{
+			name: "concurrent_edit",
+			input: domain.UpdatePostParams{
+				UserID:  userID,
+				PostID:  postID,
+				Content: &content,
+				Media:   &[]domain.PostMediaItem{},
+			},
+			setupMock: func(repo *mocks.PostRepository, media *mocks.MediaService, event *mocks.EventPublisher) {
+				repo.EXPECT().GetByID(mock.Anything, postID).Return(existing(), nil).Once()
+				// The losing edit must not delete media the winner may still reference.
+				repo.EXPECT().Update(mock.Anything, mock.Anything).Return(pkg.ErrConflict).Once()
+			},
+			wantErr: pkg.ErrConflict,
+		},
+		{
+			name: "keep_existing_media",
+			input: domain.UpdatePostParams{
+				UserID: userID,
+				PostID: postID,
+				Media:  &[]domain.PostMediaItem{{ObjectKey: oldKey, Feature: domain.FeatureFeedImage}},
+			},
+			setupMock: func(repo *mocks.PostRepository, media *mocks.MediaService, event *mocks.EventPublisher) {
+				repo.EXPECT().GetByID(mock.Anything, postID).Return(existing(), nil).Once()
+				repo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil).Once()
+				media.EXPECT().GetPublicURL(oldKey).Return("url").Once()
+			},
+		},
+		{
+			name:  "private_made_public",
+			input: domain.UpdatePostParams{UserID: userID, PostID: postID, Visibility: &public},
+			setupMock: func(repo *mocks.PostRepository, media *mocks.MediaService, event *mocks.EventPublisher) {
+				post := existing()
+				post.Visibility = domain.VisibilityPrivate
+				repo.EXPECT().GetByID(mock.Anything, postID).Return(post, nil).Once()
+				repo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil).Once()
+				event.EXPECT().Publish(mock.Anything, "post.visibility_changed", mock.MatchedBy(func(p domain.EventPayload) bool {
+					data, ok := p.Data.(domain.EventPostData)
+					return p.EventType == domain.PostVisibilityChanged && ok && data.PostID == postID && data.Visibility == domain.VisibilityPublic
+				})).Return(nil).Once()
+				media.EXPECT().GetPublicURL(oldKey).Return("url").Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockRepo := mocks.NewPostRepository(s.T())
+			mockReaction := mocks.NewReactionService(s.T())
+			mockMedia := mocks.NewMediaService(s.T())
+			mockEvent := mocks.NewEventPublisher(s.T())
+			svc := NewPostService(mockRepo, mocks.NewFollowService(s.T()), mocks.NewGroupService(s.T()), mockReaction, mockMedia, mockEvent)
+
+			if tc.setupMock != nil {
+				tc.setupMock(mockRepo, mockMedia, mockEvent)
+			}
+			if tc.wantErr == nil {
+				mockReaction.EXPECT().Summaries(mock.Anything, userID, domain.ReactionTargetPost, []int64{postID}).
+					Return(map[int64]domain.ReactionSummary{}, nil).Once()
+			}
+
+			_, err := svc.Update(context.Background(), tc.input)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *postServiceSuite) TestDelete() {
+	var (
+		userID int64 = 1
+		postID int64 = 10
+		key          = "posts/1/feed_video/1_a.mp4"
+	)
+
+	post := &domain.Post{
+		ID:       postID,
+		AuthorID: userID,
+		Media:    []domain.PostMedia{{ObjectKey: key, Feature: domain.FeatureFeedVideo}},
+	}
+
+	tests := []struct {
+		name      string
+		userID    int64
+		setupMock func(repo *mocks.PostRepository, media *mocks.MediaService)
+		wantErr   error
+	}{
+		{
+			name:   "not_owner",
+			userID: 2,
+			setupMock: func(repo *mocks.PostRepository, media *mocks.MediaService) {
+				repo.EXPECT().GetByID(mock.Anything, postID).Return(post, nil).Once()
+			},
+			wantErr: pkg.ErrForbidden,
+		},
+		{
+			name:   "repo_error",
+			userID: userID,
+			setupMock: func(repo *mocks.PostRepository, media *mocks.MediaService) {
+				repo.EXPECT().GetByID(mock.Anything, postID).Return(post, nil).Once()
+				repo.EXPECT().Delete(mock.Anything, postID).Return(assert.AnError).Once()
+			},
+			wantErr: pkg.ErrInternal,
+		},
+		{
+			name:   "success_storage_error_ignored",
+			userID: userID,
+			setupMock: func(repo *mocks.PostRepository, media *mocks.MediaService) {
+				repo.EXPECT().GetByID(mock.Anything, postID).Return(post, nil).Once()
+				repo.EXPECT().Delete(mock.Anything, postID).Return(nil).Once()
+				media.EXPECT().DeleteFile(mock.Anything, key).Return(assert.AnError).Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockRepo := mocks.NewPostRepository(s.T())
+			mockMedia := mocks.NewMediaService(s.T())
+			svc := NewPostService(mockRepo, mocks.NewFollowService(s.T()), mocks.NewGroupService(s.T()), mocks.NewReactionService(s.T()), mockMedia, mocks.NewEventPublisher(s.T()))
+
+			tc.setupMock(mockRepo, mockMedia)
+
+			err := svc.Delete(context.Background(), tc.userID, postID)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *postServiceSuite) TestGetByID() {
+	var (
+		authorID int64 = 1
+		postID   int64 = 10
+	)
+
+	tests := []struct {
+		name       string
+		viewerID   int64
+		visibility domain.PostVisibility
+		hidden     bool
+		following  *bool
+		wantErr    error
+	}{
+		{name: "public_other_viewer", viewerID: 2, visibility: domain.VisibilityPublic},
+		{name: "private_author", viewerID: authorID, visibility: domain.VisibilityPrivate},
+		{name: "private_other_viewer", viewerID: 2, visibility: domain.VisibilityPrivate, wantErr: pkg.ErrNotFound},
+		{name: "followers_follower", viewerID: 2, visibility: domain.VisibilityFollowers, following: &[]bool{true}[0]},
+		{name: "followers_stranger", viewerID: 2, visibility: domain.VisibilityFollowers, following: &[]bool{false}[0], wantErr: pkg.ErrNotFound},
+		{name: "hidden_author", viewerID: 2, visibility: domain.VisibilityPublic, hidden: true, wantErr: pkg.ErrNotFound},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockRepo := mocks.NewPostRepository(s.T())
+			mockFollow := mocks.NewFollowService(s.T())
+			mockReaction := mocks.NewReactionService(s.T())
+			mockMedia := mocks.NewMediaService(s.T())
+			svc := NewPostService(mockRepo, mockFollow, mocks.NewGroupService(s.T()), mockReaction, mockMedia, mocks.NewEventPublisher(s.T()))
+
+			if tc.wantErr == nil {
+				own := domain.ReactionLike
+				mockReaction.EXPECT().Summaries(mock.Anything, tc.viewerID, domain.ReactionTargetPost, []int64{postID}).
+					Return(map[int64]domain.ReactionSummary{postID: domain.NewReactionSummary(domain.ReactionCounts{own: 1}, own)}, nil).Once()
+			}
+			if tc.following != nil {
+				mockFollow.EXPECT().IsFollowing(mock.Anything, tc.viewerID, authorID).Return(*tc.following, nil).Once()
+			}
+			mockRepo.EXPECT().GetByID(mock.Anything, postID).
+				Return(&domain.Post{ID: postID, AuthorID: authorID, Visibility: tc.visibility, AuthorHidden: tc.hidden}, nil).Once()
+
+			got, err := svc.GetByID(context.Background(), tc.viewerID, postID)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+				s.Empty(got)
+			} else {
+				s.NoError(err)
+				s.Equal(postID, got.ID)
+				s.Equal(int64(1), got.Reactions.Total)
+				s.Equal(domain.ReactionLike, *got.Reactions.ViewerReaction)
+			}
+		})
+	}
+}
+
+func (s *postServiceSuite) TestListByAuthor() {
+	var authorID int64 = 1
+
+	s.Run("invalid_cursor", func() {
+		svc := NewPostService(mocks.NewPostRepository(s.T()), mocks.NewFollowService(s.T()), mocks.NewGroupService(s.T()), mocks.NewReactionService(s.T()), mocks.NewMediaService(s.T()), mocks.NewEventPublisher(s.T()))
+
+		_, err := svc.ListByAuthor(context.Background(), domain.ListPostsParams{
+			AuthorID: authorID,
+			Page:     domain.PageParams{Cursor: "%%%"},
+		})
+		s.ErrorIs(err, pkg.ErrBadRequest)
+	})
+
+	s.Run("has_more", func() {
+		mockRepo := mocks.NewPostRepository(s.T())
+		mockFollow := mocks.NewFollowService(s.T())
+		mockReaction := mocks.NewReactionService(s.T())
+		svc := NewPostService(mockRepo, mockFollow, mocks.NewGroupService(s.T()), mockReaction, mocks.NewMediaService(s.T()), mocks.NewEventPublisher(s.T()))
+
+		mockFollow.EXPECT().IsFollowing(mock.Anything, int64(2), authorID).Return(false, nil).Once()
+		mockRepo.EXPECT().ListByAuthor(mock.Anything, domain.PostListFilter{
+			AuthorID:     authorID,
+			Visibilities: []domain.PostVisibility{domain.VisibilityPublic},
+			BeforeID:     100,
+			Limit:        3,
+		}).Return([]domain.Post{{ID: 99}, {ID: 98}, {ID: 97}}, nil).Once()
+		// The look-ahead row is dropped before reactions are loaded.
+		mockReaction.EXPECT().Summaries(mock.Anything, int64(2), domain.ReactionTargetPost, []int64{99, 98}).
+			Return(map[int64]domain.ReactionSummary{}, nil).Once()
+
+		page, err := svc.ListByAuthor(context.Background(), domain.ListPostsParams{
+			ViewerID: 2,
+			AuthorID: authorID,
+			Page:     domain.PageParams{Cursor: pkg.EncodeCursor(100), Limit: 2},
+		})
+
+		s.NoError(err)
+		s.Len(page.Items.([]domain.PostResponse), 2)
+		s.True(page.HasMore)
+		s.Equal(pkg.EncodeCursor(98), page.NextCursor)
+	})
+
+	s.Run("follower_sees_followers_only", func() {
+		mockRepo := mocks.NewPostRepository(s.T())
+		mockFollow := mocks.NewFollowService(s.T())
+		svc := NewPostService(mockRepo, mockFollow, mocks.NewGroupService(s.T()), mocks.NewReactionService(s.T()), mocks.NewMediaService(s.T()), mocks.NewEventPublisher(s.T()))
+
+		mockFollow.EXPECT().IsFollowing(mock.Anything, int64(2), authorID).Return(true, nil).Once()
+		mockRepo.EXPECT().ListByAuthor(mock.Anything, mock.MatchedBy(func(f domain.PostListFilter) bool {
+			return len(f.Visibilities) == 2 && f.Visibilities[1] == domain.VisibilityFollowers
+		})).Return(nil, nil).Once()
+
+		_, err := svc.ListByAuthor(context.Background(), domain.ListPostsParams{ViewerID: 2, AuthorID: authorID})
+		s.NoError(err)
+	})
+
+	s.Run("own_posts_include_private", func() {
+		mockRepo := mocks.NewPostRepository(s.T())
+		mockFollow := mocks.NewFollowService(s.T())
+		svc := NewPostService(mockRepo, mockFollow, mocks.NewGroupService(s.T()), mocks.NewReactionService(s.T()), mocks.NewMediaService(s.T()), mocks.NewEventPublisher(s.T()))
+
+		mockRepo.EXPECT().ListByAuthor(mock.Anything, mock.MatchedBy(func(f domain.PostListFilter) bool {
+			return len(f.Visibilities) == 3 && f.BeforeID == 0 && f.Limit == domain.DefaultPageLimit+1
+		})).Return(nil, nil).Once()
+
+		page, err := svc.ListByAuthor(context.Background(), domain.ListPostsParams{
+			ViewerID: authorID,
+			AuthorID: authorID,
+		})
+
+		s.NoError(err)
+		s.Empty(page.Items.([]domain.PostResponse))
+		s.False(page.HasMore)
+	})
+}
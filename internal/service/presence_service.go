@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"air-social/internal/config"
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+type PresenceService interface {
+	// Connect, Heartbeat and Disconnect follow the lifecycle of a realtime
+	// connection. Failures are logged; presence is best effort and never
+	// closes a connection.
+	Connect(ctx context.Context, userID int64, connID string)
+	Heartbeat(ctx context.Context, userID int64, connID string)
+	Disconnect(ctx context.Context, userID int64, connID string)
+
+	GetPresence(ctx context.Context, viewerID int64, userIDs []int64) ([]domain.PresenceResponse, error)
+	SetHidden(ctx context.Context, userID int64, hidden bool) error
+}
+
+type PresenceServiceImpl struct {
+	store       domain.PresenceStore
+	userSvc     UserService
+	followSvc   FollowService
+	convRepo    domain.ConversationRepository
+	realtime    domain.RealtimeSender
+	presenceCfg config.PresenceConfig
+}
+
+func NewPresenceService(
+	store domain.PresenceStore,
+	userSvc UserService,
+	followSvc FollowService,
+	convRepo domain.ConversationRepository,
+	realtime domain.RealtimeSender,
+	cfg config.PresenceConfig,
+) *PresenceServiceImpl {
+	return &PresenceServiceImpl{
+		store:       store,
+		userSvc:     userSvc,
+		followSvc:   followSvc,
+		convRepo:    convRepo,
+		realtime:    realtime,
+		presenceCfg: cfg,
+	}
+}
+
+// Connect tells the user's peers they came online when this is their first
+// live connection on any instance.
+func (s *PresenceServiceImpl) Connect(ctx context.Context, userID int64, connID string) {
+	first, err := s.store.Connect(ctx, userID, connID)
+	if err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "presence_connect", "user_id", userID, "error", err)
+		return
+	}
+	if first {
+		s.notify(ctx, domain.Presence{UserID: userID, Online: true})
+	}
+}
+
+func (s *PresenceServiceImpl) Heartbeat(ctx context.Context, userID int64, connID string) {
+	if err := s.store.Heartbeat(ctx, userID, connID); err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "presence_heartbeat", "user_id", userID, "error", err)
+	}
+}
+
+// Disconnect tells the user's peers they went offline once their last live
+// connection is gone. Connections of a crashed instance are never
+// disconnected; they expire after the TTL and the user silently reads as
+// offline from then on.
+func (s *PresenceServiceImpl) Disconnect(ctx context.Context, userID int64, connID string) {
+	last, err := s.store.Disconnect(ctx, userID, connID)
+	if err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "presence_disconnect", "user_id", userID, "error", err)
+		return
+	}
+	if last {
+		now := pkg.TimeNowUTC()
+		s.notify(ctx, domain.Presence{UserID: userID, LastSeen: &now})
+	}
+}
+
+// GetPresence returns the presence of userIDs in the given order. Users that
+// hide their presence read as offline with no last-seen time, except to
+// themselves.
+func (s *PresenceServiceImpl) GetPresence(ctx context.Context, viewerID int64, userIDs []int64) ([]domain.PresenceResponse, error) {
+	ids := uniqueIDs(userIDs)
+	if len(ids) == 0 || len(ids) > domain.MaxPresenceLookup {
+		return nil, pkg.ErrInvalidData
+	}
+
+	presences, err := s.store.Get(ctx, ids)
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+
+	hidden, err := s.userSvc.ListHidingPresence(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]domain.PresenceResponse, 0, len(presences))
+	for _, p := range presences {
+		if p.UserID != viewerID && slices.Contains(hidden, p.UserID) {
+			p = domain.Presence{UserID: p.UserID}
+		}
+		items = append(items, p.ToResponse())
+	}
+	return items, nil
+}
+
+// SetHidden changes the privacy setting. While the user is online, peers see
+// them go offline when hiding and come back online when showing again.
+func (s *PresenceServiceImpl) SetHidden(ctx context.Context, userID int64, hidden bool) error {
+	if err := s.userSvc.SetHidePresence(ctx, userID, hidden); err != nil {
+		return err
+	}
+
+	current, err := s.store.Get(ctx, []int64{userID})
+	if err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "presence_get", "user_id", userID, "error", err)
+		return nil
+	}
+	if len(current) == 0 || !current[0].Online {
+		return nil
+	}
+
+	s.push(ctx, domain.Presence{UserID: userID, Online: !hidden})
+	return nil
+}
+
+// Internal helpers
+
+// notify pushes a presence change unless the user hides their presence.
+func (s *PresenceServiceImpl) notify(ctx context.Context, p domain.Presence) {
+	hidden, err := s.userSvc.ListHidingPresence(ctx, []int64{p.UserID})
+	if err != nil {
+		pkg.Log().Errorw("[REALTIME ERROR]", "from", string(domain.RealtimePresence), "user_id", p.UserID, "error", err)
+		return
+	}
+	if len(hidden) > 0 {
+		return
+	}
+	s.push(ctx, p)
+}
+
+// push sends p to everyone sharing a conversation with the user and to up to
+// MaxFanout followers.
+func (s *PresenceServiceImpl) push(ctx context.Context, p domain.Presence) {
+	peers, err := s.convRepo.ListPeerIDs(ctx, p.UserID)
+	if err != nil {
+		pkg.Log().Errorw("[REALTIME ERROR]", "from", string(domain.RealtimePresence), "user_id", p.UserID, "error", err)
+		return
+	}
+
+	if s.presenceCfg.MaxFanout > 0 {
+		followers, err := s.followSvc.ListFollowerIDs(ctx, p.UserID, 0, s.presenceCfg.MaxFanout)
+		if err != nil {
+			pkg.Log().Errorw("[REALTIME ERROR]", "from", string(domain.RealtimePresence), "user_id", p.UserID, "error", err)
+			return
+		}
+		peers = append(peers, followers...)
+	}
+
+	audience := uniqueIDs(peers, p.UserID)
+	if len(audience) == 0 {
+		return
+	}
+
+	msg := domain.RealtimeMessage{Type: domain.RealtimePresence, Data: p.ToResponse()}
+	if err := s.realtime.SendToUsers(ctx, audience, msg); err != nil {
+		pkg.Log().Errorw("[REALTIME ERROR]", "from", string(msg.Type), "user_id", p.UserID, "error", err)
+	}
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"air-social/internal/config"
+	"air-social/internal/domain"
+	"air-social/internal/mocks"
+	"air-social/pkg"
+)
+
+type presenceServiceSuite struct {
+	suite.Suite
+}
+
+func TestPresenceServiceSuite(t *testing.T) {
+	suite.Run(t, new(presenceServiceSuite))
+}
+
+type presenceMocks struct {
+	store    *mocks.PresenceStore
+	user     *mocks.UserService
+	follow   *mocks.FollowService
+	conv     *mocks.ConversationRepository
+	realtime *mocks.RealtimeSender
+}
+
+func (s *presenceServiceSuite) newService() (*PresenceServiceImpl, presenceMocks) {
+	m := presenceMocks{
+		store:    mocks.NewPresenceStore(s.T()),
+		user:     mocks.NewUserService(s.T()),
+		follow:   mocks.NewFollowService(s.T()),
+		conv:     mocks.NewConversationRepository(s.T()),
+		realtime: mocks.NewRealtimeSender(s.T()),
+	}
+	cfg := config.PresenceConfig{TTL: time.Minute, MaxFanout: 100}
+	return NewPresenceService(m.store, m.user, m.follow, m.conv, m.realtime, cfg), m
+}
+
+func (s *presenceServiceSuite) TestConnect() {
+	var userID int64 = 1
+
+	tests := []struct {
+		name      string
+		setupMock func(m presenceMocks)
+	}{
+		{
+			name: "store_error",
+			setupMock: func(m presenceMocks) {
+				m.store.EXPECT().Connect(mock.Anything, userID, "c1").Return(false, assert.AnError).Once()
+			},
+		},
+		{
+			name: "another_device_online",
+			setupMock: func(m presenceMocks) {
+				m.store.EXPECT().Connect(mock.Anything, userID, "c1").Return(false, nil).Once()
+			},
+		},
+		{
+			name: "hidden",
+			setupMock: func(m presenceMocks) {
+				m.store.EXPECT().Connect(mock.Anything, userID, "c1").Return(true, nil).Once()
+				m.user.EXPECT().ListHidingPresence(mock.Anything, []int64{userID}).Return([]int64{userID}, nil).Once()
+			},
+		},
+		{
+			name: "first_connection",
+			setupMock: func(m presenceMocks) {
+				m.store.EXPECT().Connect(mock.Anything, userID, "c1").Return(true, nil).Once()
+				m.user.EXPECT().ListHidingPresence(mock.Anything, []int64{userID}).Return(nil, nil).Once()
+				m.conv.EXPECT().ListPeerIDs(mock.Anything, userID).Return([]int64{2, 3}, nil).Once()
+				m.follow.EXPECT().ListFollowerIDs(mock.Anything, userID, int64(0), 100).Return([]int64{3, 4}, nil).Once()
+				m.realtime.EXPECT().SendToUsers(mock.Anything, []int64{2, 3, 4}, domain.RealtimeMessage{
+					Type: domain.RealtimePresence,
+					Data: domain.PresenceResponse{UserID: userID, Online: true},
+				}).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			svc, m := s.newService()
+			tc.setupMock(m)
+
+			svc.Connect(context.Background(), userID, "c1")
+		})
+	}
+}
+
+func (s *presenceServiceSuite) TestDisconnect() {
+	var userID int64 = 1
+
+	s.Run("other_connections_left", func() {
+		svc, m := s.newService()
+		m.store.EXPECT().Disconnect(mock.Anything, userID, "c1").Return(false, nil).Once()
+
+		svc.Disconnect(context.Background(), userID, "c1")
+	})
+
+	s.Run("last_connection", func() {
+		svc, m := s.newService()
+		m.store.EXPECT().Disconnect(mock.Anything, userID, "c1").Return(true, nil).Once()
+		m.user.EXPECT().ListHidingPresence(mock.Anything, []int64{userID}).Return(nil, nil).Once()
+		m.conv.EXPECT().ListPeerIDs(mock.Anything, userID).Return([]int64{2}, nil).Once()
+		m.follow.EXPECT().ListFollowerIDs(mock.Anything, userID, int64(0), 100).Return(nil, nil).Once()
+		m.realtime.EXPECT().SendToUsers(mock.Anything, []int64{2}, mock.MatchedBy(func(rm domain.RealtimeMessage) bool {
+			res, ok := rm.Data.(domain.PresenceResponse)
+			return ok && !res.Online && res.LastSeen != nil
+		})).Return(nil).Once()
+
+		svc.Disconnect(context.Background(), userID, "c1")
+	})
+}
+
+func (s *presenceServiceSuite) TestGetPresence() {
+	var viewerID int64 = 1
+	seen := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	s.Run("masks_hidden_users_except_self", func() {
+		svc, m := s.newService()
+		m.store.EXPECT().Get(mock.Anything, []int64{1, 2, 3}).Return([]domain.Presence{
+			{UserID: 1, Online: true},
+			{UserID: 2, Online: true},
+			{UserID: 3, LastSeen: &seen},
+		}, nil).Once()
+		m.user.EXPECT().ListHidingPresence(mock.Anything, []int64{1, 2, 3}).Return([]int64{1, 2}, nil).Once()
+
+		items, err := svc.GetPresence(context.Background(), viewerID, []int64{1, 2, 3, 2})
+
+		s.Require().NoError(err)
+		s.Equal([]domain.PresenceResponse{
+			{UserID: 1, Online: true},
+			{UserID: 2},
+			{UserID: 3, LastSeen: &seen},
+		}, items)
+	})
+
+	s.Run("too_many", func() {
+		svc, _ := s.newService()
+		ids := make([]int64, domain.MaxPresenceLookup+1)
+		for i := range ids {
+			ids[i] = int64(i + 1)
+		}
+
+		_, err := svc.GetPresence(context.Background(), viewerID, ids)
+
+		s.ErrorIs(err, pkg.ErrInvalidData)
+	})
+}
+
+func (s *presenceServiceSuite) TestSetHidden() {
+	var userID int64 = 1
+
+	s.Run("offline_no_push", func() {
+		svc, m := s.newService()
+		m.user.EXPECT().SetHidePresence(mock.Anything, userID, true).Return(nil).Once()
+		m.store.EXPECT().Get(mock.Anything, []int64{userID}).Return([]domain.Presence{{UserID: userID}}, nil).Once()
+
+		s.NoError(svc.SetHidden(context.Background(), userID, true))
+	})
+
+	s.Run("online_pushes_offline", func() {
+		svc, m := s.newService()
+		m.user.EXPECT().SetHidePresence(mock.Anything, userID, true).Return(nil).Once()
+		m.store.EXPECT().Get(mock.Anything, []int64{userID}).Return([]domain.Presence{{UserID: userID, Online: true}}, nil).Once()
+		m.conv.EXPECT().ListPeerIDs(mock.Anything, userID).Return([]int64{2}, nil).Once()
+		m.follow.EXPECT().ListFollowerIDs(mock.Anything, userID, int64(0), 100).Return(nil, nil).Once()
+		m.realtime.EXPECT().SendToUsers(mock.Anything, []int64{2}, domain.RealtimeMessage{
+			Type: domain.RealtimePresence,
+			Data: domain.PresenceResponse{UserID: userID},
+		}).Return(nil).Once()
+
+		s.NoError(svc.SetHidden(context.Background(), userID, true))
+	})
+
+	s.Run("user_not_found", func() {
+		svc, m := s.newService()
+		m.user.EXPECT().SetHidePresence(mock.Anything, userID, false).Return(pkg.ErrNotFound).Once()
+
+		s.ErrorIs(svc.SetHidden(context.Background(), userID, false), pkg.ErrNotFound)
+	})
+}
@@ -0,0 +1,326 @@
+package service
+
+import (
+	"context"
+
+	"air-social/internal/config"
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+type ReactionService interface {
+	// React is idempotent; reacting with another type replaces the previous reaction.
+	React(ctx context.Context, input domain.ReactParams) error
+	Unreact(ctx context.Context, userID int64, target domain.ReactionTarget) error
+	ListReactors(ctx context.Context, input domain.ListReactorsParams) (domain.Page, error)
+	// Summaries returns reaction counts and the viewer's own reaction for each target ID.
+	Summaries(ctx context.Context, viewerID int64, targetType domain.ReactionTargetType, ids []int64) (map[int64]domain.ReactionSummary, error)
+	// Reconcile rewrites the cached counters touched since the last run from Postgres.
+	Reconcile(ctx context.Context) error
+}
+
+type ReactionServiceImpl struct {
+	reactionRepo domain.ReactionRepository
+	counter      domain.ReactionCounter
+	postRepo     domain.PostRepository
+	commentRepo  domain.CommentRepository
+	followSvc    FollowService
+	groupSvc     GroupService
+	mediaSvc     MediaService
+	reactionCfg  config.ReactionConfig
+}
+
+func NewReactionService(
+	reactionRepo domain.ReactionRepository,
+	counter domain.ReactionCounter,
+	postRepo domain.PostRepository,
+	commentRepo domain.CommentRepository,
+	followSvc FollowService,
+	groupSvc GroupService,
+	mediaSvc MediaService,
+	cfg config.ReactionConfig,
+) *ReactionServiceImpl {
+	return &ReactionServiceImpl{
+		reactionRepo: reactionRepo,
+		counter:      counter,
+		postRepo:     postRepo,
+		commentRepo:  commentRepo,
+		followSvc:    followSvc,
+		groupSvc:     groupSvc,
+		mediaSvc:     mediaSvc,
+		reactionCfg:  cfg,
+	}
+}
+
+func (s *ReactionServiceImpl) React(ctx context.Context, input domain.ReactParams) error {
+	if err := s.checkTarget(ctx, input.UserID, input.Target); err != nil {
+		return err
+	}
+
+	prev, err := s.reactionRepo.Upsert(ctx, input.Target, input.UserID, input.Type)
+	if err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound, pkg.ErrConflict)
+	}
+	if prev == input.Type {
+		return nil
+	}
+
+	deltas := domain.ReactionCounts{input.Type: 1}
+	if prev != "" {
+		deltas[prev] = -1
+	}
+	s.incrCounter(ctx, input.Target, deltas)
+	return nil
+}
+
+func (s *ReactionServiceImpl) Unreact(ctx context.Context, userID int64, target domain.ReactionTarget) error {
+	if err := s.checkTarget(ctx, userID, target); err != nil {
+		return err
+	}
+
+	prev, err := s.reactionRepo.Delete(ctx, target, userID)
+	if err != nil {
+		return pkg.OrInternalError(err)
+	}
+	if prev == "" {
+		return nil
+	}
+
+	s.incrCounter(ctx, target, domain.ReactionCounts{prev: -1})
+	return nil
+}
+
+func (s *ReactionServiceImpl) ListReactors(ctx context.Context, input domain.ListReactorsParams) (domain.Page, error) {
+	var empty domain.Page
+
+	beforeID, err := decodeIDCursor(input.Page.Cursor)
+	if err != nil {
+		return empty, err
+	}
+
+	if err := s.checkTarget(ctx, input.ViewerID, input.Target); err != nil {
+		return empty, err
+	}
+
+	limit := input.Page.Size()
+	entries, err := s.reactionRepo.ListReactors(ctx, domain.ReactorListFilter{
+		Target:   input.Target,
+		Type:     input.Type,
+		BeforeID: beforeID,
+		Limit:    limit + 1,
+	})
+	if err != nil {
+		return empty, pkg.OrInternalError(err)
+	}
+
+	cursors := make(map[int64]int64, len(entries))
+	items := make([]domain.ReactorResponse, 0, len(entries))
+	for _, e := range entries {
+		e.Avatar = s.mediaSvc.GetPublicURL(e.Avatar)
+		cursors[e.ID] = e.ReactionID
+		items = append(items, domain.ReactorResponse{
+			UserSummary: e.UserSummary,
+			Type:        e.Type,
+			ReactedAt:   e.ReactedAt,
+		})
+	}
+
+	return newPage(items, limit, func(r domain.ReactorResponse) string {
+		return pkg.EncodeCursor(cursors[r.ID])
+	}), nil
+}
+
+func (s *ReactionServiceImpl) Summaries(
+	ctx context.Context,
+	viewerID int64,
+	targetType domain.ReactionTargetType,
+	ids []int64,
+) (map[int64]domain.ReactionSummary, error) {
+	result := make(map[int64]domain.ReactionSummary, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	counts, err := s.loadCounts(ctx, targetType, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	own, err := s.reactionRepo.GetUserReactions(ctx, targetType, ids, viewerID)
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+
+	for _, id := range ids {
+		result[id] = domain.NewReactionSummary(counts[id], own[id])
+	}
+	return result, nil
+}
+
+func (s *ReactionServiceImpl) Reconcile(ctx context.Context) error {
+	batch := s.reactionCfg.ReconcileBatchSize
+
+	for {
+		targets, err := s.counter.PopDirty(ctx, batch)
+		if err != nil {
+			return err
+		}
+		if len(targets) == 0 {
+			return nil
+		}
+
+		if err := s.reconcileBatch(ctx, targets); err != nil {
+			// Popped targets would otherwise stay stale until the counter expires.
+			if markErr := s.counter.MarkDirty(ctx, targets); markErr != nil {
+				pkg.Log().Errorw("[CACHE ERROR]", "from", "reaction_mark_dirty", "error", markErr)
+			}
+			return err
+		}
+
+		if len(targets) < batch {
+			return nil
+		}
+	}
+}
+
+// Internal helpers
+
+// checkTarget makes sure the target exists and the user may see it.
+// Comments inherit the visibility of their post.
+func (s *ReactionServiceImpl) checkTarget(ctx context.Context, userID int64, target domain.ReactionTarget) error {
+	postID := target.ID
+
+	switch target.Type {
+	case domain.ReactionTargetPost:
+	case domain.ReactionTargetComment:
+		comment, err := s.commentRepo.GetByID(ctx, target.ID)
+		if err != nil {
+			return pkg.OrInternalError(err, pkg.ErrNotFound)
+		}
+		postID = comment.PostID
+	default:
+		return pkg.ErrInvalidData
+	}
+
+	post, err := s.postRepo.GetByID(ctx, postID)
+	if err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	visible, err := canViewPost(ctx, s.followSvc, s.groupSvc, userID, post)
+	if err != nil {
+		return err
+	}
+	if !visible {
+		return pkg.ErrNotFound
+	}
+	return nil
+}
+
+// attachReactions fills in the reaction summary of each item. field returns
+// the item's target ID and the summary to fill in.
+func attachReactions[T any](
+	ctx context.Context,
+	reactionSvc ReactionService,
+	viewerID int64,
+	targetType domain.ReactionTargetType,
+	items []T,
+	field func(*T) (int64, *domain.ReactionSummary),
+) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(items))
+	for i := range items {
+		ids[i], _ = field(&items[i])
+	}
+
+	summaries, err := reactionSvc.Summaries(ctx, viewerID, targetType, ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range items {
+		id, summary := field(&items[i])
+		if got, ok := summaries[id]; ok {
+			*summary = got
+		}
+	}
+	return nil
+}
+
+// reconcileBatch rewrites the counters of targets from Postgres.
+func (s *ReactionServiceImpl) reconcileBatch(ctx context.Context, targets []domain.ReactionTarget) error {
+	byType := make(map[domain.ReactionTargetType][]int64)
+	for _, t := range targets {
+		byType[t.Type] = append(byType[t.Type], t.ID)
+	}
+
+	for targetType, ids := range byType {
+		counts, err := s.reactionRepo.CountByTargets(ctx, targetType, ids)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if err := s.counter.Set(ctx, domain.ReactionTarget{Type: targetType, ID: id}, counts[id]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// loadCounts reads counters from Redis and falls back to Postgres for the
+// ones that are not cached, caching them on the way.
+func (s *ReactionServiceImpl) loadCounts(
+	ctx context.Context,
+	targetType domain.ReactionTargetType,
+	ids []int64,
+) (map[int64]domain.ReactionCounts, error) {
+	targets := make([]domain.ReactionTarget, len(ids))
+	for i, id := range ids {
+		targets[i] = domain.ReactionTarget{Type: targetType, ID: id}
+	}
+
+	cached, err := s.counter.Get(ctx, targets)
+	if err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "reaction_counter_get", "error", err)
+		cached = nil
+	}
+
+	counts := make(map[int64]domain.ReactionCounts, len(ids))
+	var missing []int64
+	for _, t := range targets {
+		if c, ok := cached[t]; ok {
+			counts[t.ID] = c
+		} else {
+			missing = append(missing, t.ID)
+		}
+	}
+	if len(missing) == 0 {
+		return counts, nil
+	}
+
+	loaded, err := s.reactionRepo.CountByTargets(ctx, targetType, missing)
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+
+	for _, id := range missing {
+		counts[id] = loaded[id]
+		target := domain.ReactionTarget{Type: targetType, ID: id}
+		if err := s.counter.Set(ctx, target, loaded[id]); err != nil {
+			pkg.Log().Errorw("[CACHE ERROR]", "from", "reaction_counter_set", "target", target.String(), "error", err)
+		}
+	}
+	return counts, nil
+}
+
+// incrCounter applies a counter change. Postgres stays the source of truth,
+// so a failure only leaves the cached counter stale until it expires.
+func (s *ReactionServiceImpl) incrCounter(ctx context.Context, target domain.ReactionTarget, deltas domain.ReactionCounts) {
+	if err := s.counter.Incr(ctx, target, deltas); err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "reaction_counter_incr", "target", target.String(), "error", err)
+	}
+}
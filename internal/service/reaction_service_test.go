@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"air-social/internal/config"
+	"air-social/internal/domain"
+	"air-social/internal/mocks"
+	"air-social/pkg"
+)
+
+type reactionServiceSuite struct {
+	suite.Suite
+	cfg config.ReactionConfig
+}
+
+func TestReactionServiceSuite(t *testing.T) {
+	suite.Run(t, new(reactionServiceSuite))
+}
+
+func (s *reactionServiceSuite) SetupSuite() {
+	s.cfg = config.ReactionConfig{ReconcileBatchSize: 2}
+}
+
+type reactionMocks struct {
+	repo    *mocks.ReactionRepository
+	counter *mocks.ReactionCounter
+	post    *mocks.PostRepository
+	comment *mocks.CommentRepository
+	follow  *mocks.FollowService
+	group   *mocks.GroupService
+	media   *mocks.MediaService
+}
+
+func (s *reactionServiceSuite) newService() (*ReactionServiceImpl, reactionMocks) {
+	m := reactionMocks{
+		repo:    mocks.NewReactionRepository(s.T()),
+		counter: mocks.NewReactionCounter(s.T()),
+		post:    mocks.NewPostRepository(s.T()),
+		comment: mocks.NewCommentRepository(s.T()),
+		follow:  mocks.NewFollowService(s.T()),
+		group:   mocks.NewGroupService(s.T()),
+		media:   mocks.NewMediaService(s.T()),
+	}
+	return NewReactionService(m.repo, m.counter, m.post, m.comment, m.follow, m.group, m.media, s.cfg), m
+}
+
+func (s *reactionServiceSuite) TestReact() {
+	var (
+		userID   int64 = 1
+		authorID int64 = 2
+		postID   int64 = 10
+	)
+
+	postTarget := domain.ReactionTarget{Type: domain.ReactionTargetPost, ID: postID}
+	publicPost := &domain.Post{ID: postID, AuthorID: authorID, Visibility: domain.VisibilityPublic}
+
+	tests := []struct {
+		name      string
+		input     domain.ReactParams
+		setupMock func(m reactionMocks)
+		wantErr   error
+	}{
+		{
+			name:  "post_not_found",
+			input: domain.ReactParams{UserID: userID, Target: postTarget, Type: domain.ReactionLike},
+			setupMock: func(m reactionMocks) {
+				m.post.EXPECT().GetByID(mock.Anything, postID).Return(nil, pkg.ErrNotFound).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name:  "post_hidden",
+			input: domain.ReactParams{UserID: userID, Target: postTarget, Type: domain.ReactionLike},
+			setupMock: func(m reactionMocks) {
+				m.post.EXPECT().GetByID(mock.Anything, postID).
+					Return(&domain.Post{ID: postID, AuthorID: authorID, Visibility: domain.VisibilityFollowers}, nil).Once()
+				m.follow.EXPECT().IsFollowing(mock.Anything, userID, authorID).Return(false, nil).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name: "comment_on_hidden_post",
+			input: domain.ReactParams{
+				UserID: userID,
+				Target: domain.ReactionTarget{Type: domain.ReactionTargetComment, ID: 100},
+				Type:   domain.ReactionLike,
+			},
+			setupMock: func(m reactionMocks) {
+				m.comment.EXPECT().GetByID(mock.Anything, int64(100)).Return(&domain.Comment{ID: 100, PostID: postID}, nil).Once()
+				m.post.EXPECT().GetByID(mock.Anything, postID).
+					Return(&domain.Post{ID: postID, AuthorID: authorID, Visibility: domain.VisibilityPrivate}, nil).Once()
+			},
+			wantErr: pkg.ErrNotFound,
+		},
+		{
+			name:  "repo_error",
+			input: domain.ReactParams{UserID: userID, Target: postTarget, Type: domain.ReactionLike},
+			setupMock: func(m reactionMocks) {
+				m.post.EXPECT().GetByID(mock.Anything, postID).Return(publicPost, nil).Once()
+				m.repo.EXPECT().Upsert(mock.Anything, postTarget, userID, domain.ReactionLike).Return("", assert.AnError).Once()
+			},
+			wantErr: pkg.ErrInternal,
+		},
+		{
+			name:  "first_reaction",
+			input: domain.ReactParams{UserID: userID, Target: postTarget, Type: domain.ReactionLike},
+			setupMock: func(m reactionMocks) {
+				m.post.EXPECT().GetByID(mock.Anything, postID).Return(publicPost, nil).Once()
+				m.repo.EXPECT().Upsert(mock.Anything, postTarget, userID, domain.ReactionLike).Return("", nil).Once()
+				m.counter.EXPECT().Incr(mock.Anything, postTarget, domain.ReactionCounts{domain.ReactionLike: 1}).Return(nil).Once()
+			},
+		},
+		{
+			name:  "replace_reaction",
+			input: domain.ReactParams{UserID: userID, Target: postTarget, Type: domain.ReactionLove},
+			setupMock: func(m reactionMocks) {
+				m.post.EXPECT().GetByID(mock.Anything, postID).Return(publicPost, nil).Once()
+				m.repo.EXPECT().Upsert(mock.Anything, postTarget, userID, domain.ReactionLove).Return(domain.ReactionLike, nil).Once()
+				m.counter.EXPECT().Incr(mock.Anything, postTarget, domain.ReactionCounts{
+					domain.ReactionLove: 1,
+					domain.ReactionLike: -1,
+				}).Return(nil).Once()
+			},
+		},
+		{
+			name:  "same_reaction_again",
+			input: domain.ReactParams{UserID: userID, Target: postTarget, Type: domain.ReactionLike},
+			setupMock: func(m reactionMocks) {
+				m.post.EXPECT().GetByID(mock.Anything, postID).Return(publicPost, nil).Once()
+				m.repo.EXPECT().Upsert(mock.Anything, postTarget, userID, domain.ReactionLike).Return(domain.ReactionLike, nil).Once()
+			},
+		},
+		{
+			name:  "counter_error_ignored",
+			input: domain.ReactParams{UserID: userID, Target: postTarget, Type: domain.ReactionLike},
+			setupMock: func(m reactionMocks) {
+				m.post.EXPECT().GetByID(mock.Anything, postID).Return(publicPost, nil).Once()
+				m.repo.EXPECT().Upsert(mock.Anything, postTarget, userID, domain.ReactionLike).Return("", nil).Once()
+				m.counter.EXPECT().Incr(mock.Anything, postTarget, mock.Anything).Return(assert.AnError).Once()
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			svc, m := s.newService()
+			tc.setupMock(m)
+
+			err := svc.React(context.Background(), tc.input)
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *reactionServiceSuite) TestUnreact() {
+	var (
+		userID int64 = 1
+		postID int64 = 10
+	)
+
+	target := domain.ReactionTarget{Type: domain.ReactionTargetPost, ID: postID}
+	post := &domain.Post{ID: postID, AuthorID: userID, Visibility: domain.VisibilityPrivate}
+
+	s.Run("nothing_to_remove", func() {
+		svc, m := s.newService()
+
+		m.post.EXPECT().GetByID(mock.Anything, postID).Return(post, nil).Once()
+		m.repo.EXPECT().Delete(mock.Anything, target, userID).Return("", nil).Once()
+
+		s.NoError(svc.Unreact(context.Background(), userID, target))
+	})
+
+	s.Run("success", func() {
+		svc, m := s.newService()
+
+		m.post.EXPECT().GetByID(mock.Anything, postID).Return(post, nil).Once()
+		m.repo.EXPECT().Delete(mock.Anything, target, userID).Return(domain.ReactionSad, nil).Once()
+		m.counter.EXPECT().Incr(mock.Anything, target, domain.ReactionCounts{domain.ReactionSad: -1}).Return(nil).Once()
+
+		s.NoError(svc.Unreact(context.Background(), userID, target))
+	})
+}
+
+func (s *reactionServiceSuite) TestListReactors() {
+	var (
+		viewerID int64 = 1
+		postID   int64 = 10
+	)
+
+	target := domain.ReactionTarget{Type: domain.ReactionTargetPost, ID: postID}
+
+	s.Run("invalid_cursor", func() {
+		svc, _ := s.newService()
+
+		_, err := svc.ListReactors(context.Background(), domain.ListReactorsParams{
+			ViewerID: viewerID,
+			Target:   target,
+			Page:     domain.PageParams{Cursor: "%%%"},
+		})
+		s.ErrorIs(err, pkg.ErrBadRequest)
+	})
+
+	s.Run("has_more", func() {
+		svc, m := s.newService()
+
+		m.post.EXPECT().GetByID(mock.Anything, postID).
+			Return(&domain.Post{ID: postID, AuthorID: 2, Visibility: domain.VisibilityPublic}, nil).Once()
+		m.repo.EXPECT().ListReactors(mock.Anything, domain.ReactorListFilter{
+			Target:   target,
+			Type:     domain.ReactionLike,
+			BeforeID: 50,
+			Limit:    3,
+		}).Return([]domain.ReactorEntry{
+			{ReactionID: 49, Type: domain.ReactionLike, UserSummary: domain.UserSummary{ID: 7, Avatar: "a/7.jpg"}},
+			{ReactionID: 45, Type: domain.ReactionLike, UserSummary: domain.UserSummary{ID: 8}},
+			{ReactionID: 40, Type: domain.ReactionLike, UserSummary: domain.UserSummary{ID: 9}},
+		}, nil).Once()
+		m.media.EXPECT().GetPublicURL("a/7.jpg").Return("http://cdn/a/7.jpg").Once()
+		m.media.EXPECT().GetPublicURL("").Return("").Twice()
+
+		page, err := svc.ListReactors(context.Background(), domain.ListReactorsParams{
+			ViewerID: viewerID,
+			Target:   target,
+			Type:     domain.ReactionLike,
+			Page:     domain.PageParams{Cursor: pkg.EncodeCursor(50), Limit: 2},
+		})
+		s.NoError(err)
+		s.True(page.HasMore)
+		// The cursor is the reaction ID, not the user ID.
+		s.Equal(pkg.EncodeCursor(45), page.NextCursor)
+
+		items := page.Items.([]domain.ReactorResponse)
+		s.Len(items, 2)
+		s.Equal("http://cdn/a/7.jpg", items[0].Avatar)
+	})
+}
+
+func (s *reactionServiceSuite) TestSummaries() {
+	var viewerID int64 = 1
+
+	cachedTarget := domain.ReactionTarget{Type: domain.ReactionTargetPost, ID: 10}
+	missingTarget := domain.ReactionTarget{Type: domain.ReactionTargetPost, ID: 11}
+
+	s.Run("empty", func() {
+		svc, _ := s.newService()
+
+		got, err := svc.Summaries(context.Background(), viewerID, domain.ReactionTargetPost, nil)
+		s.NoError(err)
+		s.Empty(got)
+	})
+
+	s.Run("cache_miss_falls_back_to_db", func() {
+		svc, m := s.newService()
+
+		m.counter.EXPECT().Get(mock.Anything, []domain.ReactionTarget{cachedTarget, missingTarget}).
+			Return(map[domain.ReactionTarget]domain.ReactionCounts{
+				cachedTarget: {domain.ReactionLike: 2, domain.ReactionHaha: 0},
+			}, nil).Once()
+		m.repo.EXPECT().CountByTargets(mock.Anything, domain.ReactionTargetPost, []int64{11}).
+			Return(map[int64]domain.ReactionCounts{11: {domain.ReactionWow: 1}}, nil).Once()
+		m.counter.EXPECT().Set(mock.Anything, missingTarget, domain.ReactionCounts{domain.ReactionWow: 1}).Return(nil).Once()
+		m.repo.EXPECT().GetUserReactions(mock.Anything, domain.ReactionTargetPost, []int64{10, 11}, viewerID).
+			Return(map[int64]domain.ReactionType{11: domain.ReactionWow}, nil).Once()
+
+		got, err := svc.Summaries(context.Background(), viewerID, domain.ReactionTargetPost, []int64{10, 11})
+		s.NoError(err)
+
+		s.Equal(domain.ReactionCounts{domain.ReactionLike: 2}, got[10].Counts)
+		s.Equal(int64(2), got[10].Total)
+		s.Nil(got[10].ViewerReaction)
+
+		s.Equal(int64(1), got[11].Total)
+		s.Equal(domain.ReactionWow, *got[11].ViewerReaction)
+	})
+
+	s.Run("cache_error_uses_db", func() {
+		svc, m := s.newService()
+
+		m.counter.EXPECT().Get(mock.Anything, []domain.ReactionTarget{cachedTarget}).Return(nil, assert.AnError).Once()
+		m.repo.EXPECT().CountByTargets(mock.Anything, domain.ReactionTargetPost, []int64{10}).
+			Return(map[int64]domain.ReactionCounts{10: {}}, nil).Once()
+		m.counter.EXPECT().Set(mock.Anything, cachedTarget, domain.ReactionCounts{}).Return(assert.AnError).Once()
+		m.repo.EXPECT().GetUserReactions(mock.Anything, domain.ReactionTargetPost, []int64{10}, viewerID).
+			Return(map[int64]domain.ReactionType{}, nil).Once()
+
+		got, err := svc.Summaries(context.Background(), viewerID, domain.ReactionTargetPost, []int64{10})
+		s.NoError(err)
+		s.Zero(got[10].Total)
+		s.NotNil(got[10].Counts)
+	})
+
+	s.Run("db_error", func() {
+		svc, m := s.newService()
+
+		m.counter.EXPECT().Get(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		m.repo.EXPECT().CountByTargets(mock.Anything, domain.ReactionTargetPost, []int64{10}).Return(nil, assert.AnError).Once()
+
+		_, err := svc.Summaries(context.Background(), viewerID, domain.ReactionTargetPost, []int64{10})
+		s.ErrorIs(err, pkg.ErrInternal)
+	})
+}
+
+func (s *reactionServiceSuite) TestReconcile() {
+	post := func(id int64) domain.ReactionTarget {
+		return domain.ReactionTarget{Type: domain.ReactionTargetPost, ID: id}
+	}
+	comment := domain.ReactionTarget{Type: domain.ReactionTargetComment, ID: 100}
+
+	s.Run("nothing_dirty", func() {
+		svc, m := s.newService()
+
+		m.counter.EXPECT().PopDirty(mock.Anything, 2).Return(nil, nil).Once()
+
+		s.NoError(svc.Reconcile(context.Background()))
+	})
+
+	s.Run("rewrites_until_drained", func() {
+		svc, m := s.newService()
+
+		m.counter.EXPECT().PopDirty(mock.Anything, 2).Return([]domain.ReactionTarget{post(1), comment}, nil).Once()
+		m.counter.EXPECT().PopDirty(mock.Anything, 2).Return([]domain.ReactionTarget{post(2)}, nil).Once()
+
+		m.repo.EXPECT().CountByTargets(mock.Anything, domain.ReactionTargetPost, []int64{1}).
+			Return(map[int64]domain.ReactionCounts{1: {domain.ReactionLike: 3}}, nil).Once()
+		m.repo.EXPECT().CountByTargets(mock.Anything, domain.ReactionTargetComment, []int64{100}).
+			Return(map[int64]domain.ReactionCounts{100: {}}, nil).Once()
+		m.repo.EXPECT().CountByTargets(mock.Anything, domain.ReactionTargetPost, []int64{2}).
+			Return(map[int64]domain.ReactionCounts{2: {domain.ReactionSad: 1}}, nil).Once()
+
+		m.counter.EXPECT().Set(mock.Anything, post(1), domain.ReactionCounts{domain.ReactionLike: 3}).Return(nil).Once()
+		m.counter.EXPECT().Set(mock.Anything, comment, domain.ReactionCounts{}).Return(nil).Once()
+		m.counter.EXPECT().Set(mock.Anything, post(2), domain.ReactionCounts{domain.ReactionSad: 1}).Return(nil).Once()
+
+		s.NoError(svc.Reconcile(context.Background()))
+	})
+
+	s.Run("failure_marks_batch_dirty_again", func() {
+		svc, m := s.newService()
+
+		batch := []domain.ReactionTarget{post(1), post(2)}
+		m.counter.EXPECT().PopDirty(mock.Anything, 2).Return(batch, nil).Once()
+		m.repo.EXPECT().CountByTargets(mock.Anything, domain.ReactionTargetPost, []int64{1, 2}).Return(nil, assert.AnError).Once()
+		m.counter.EXPECT().MarkDirty(mock.Anything, batch).Return(nil).Once()
+
+		s.ErrorIs(svc.Reconcile(context.Background()), assert.AnError)
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"time"
+	"unicode/utf8"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
@@ -15,29 +17,63 @@ import (
 )
 
 type TokenService interface {
-	CreateSession(ctx context.Context, userID int64, deviceID string) (domain.TokenInfo, error)
-	Refresh(ctx context.Context, refreshToken string) (domain.TokenInfo, error)
+	CreateSession(ctx context.Context, userID int64, role domain.UserRole, client domain.SessionClient) (domain.TokenInfo, error)
+	// Refresh rotates the refresh token, recording the user agent and IP of
+	// client. The device of the session stays the same.
+	Refresh(ctx context.Context, refreshToken string, client domain.SessionClient) (domain.TokenInfo, error)
 	RevokeSingle(ctx context.Context, refreshToken string) error
 	RevokeDeviceSession(ctx context.Context, userID int64, deviceID string) error
+	// RevokeAllUserSessions signs the user out everywhere, revoking their
+	// access tokens and closing their realtime connections as well.
 	RevokeAllUserSessions(ctx context.Context, userID int64) error
+	// RevokeAccessToken revokes the access token with ID jti, which expires
+	// at expiresAt.
+	RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
+	// IsAccessTokenRevoked reports whether the access token of claims was
+	// revoked before it expired.
+	IsAccessTokenRevoked(ctx context.Context, claims *domain.AuthClaims) (bool, error)
+	// RevokeSession signs one session of the user out.
+	RevokeSession(ctx context.Context, userID int64, sessionID string) error
+	// ListSessions returns the active sessions of the user, most recently
+	// used first, marking the one on currentDeviceID.
+	ListSessions(ctx context.Context, userID int64, currentDeviceID string) ([]domain.SessionResponse, error)
 	CleanupDatabase(ctx context.Context) error
 	Validate(accessToken string) (*jwt.Token, error)
+	// JWKS returns the public keys that verify access tokens.
+	JWKS() pkg.JWKSet
 }
 
+// maxUserAgentLength is the size of refresh_tokens.user_agent.
+const maxUserAgentLength = 512
+
 type TokenServiceImpl struct {
 	tokenRepo domain.TokenRepository
+	denylist  domain.TokenDenylist
+	realtime  domain.RealtimeDisconnector
+	keys      *pkg.KeySet
 	tokenCfg  config.TokenConfig
 }
 
-func NewTokenService(repo domain.TokenRepository, cfg config.TokenConfig) *TokenServiceImpl {
-	return &TokenServiceImpl{tokenRepo: repo, tokenCfg: cfg}
+func NewTokenService(repo domain.TokenRepository, denylist domain.TokenDenylist, realtime domain.RealtimeDisconnector, keys *pkg.KeySet, cfg config.TokenConfig) *TokenServiceImpl {
+	return &TokenServiceImpl{tokenRepo: repo, denylist: denylist, realtime: realtime, keys: keys, tokenCfg: cfg}
 }
 
-func (s *TokenServiceImpl) CreateSession(ctx context.Context, userID int64, deviceID string) (domain.TokenInfo, error) {
-	_ = s.RevokeDeviceSession(ctx, userID, deviceID)
+func (s *TokenServiceImpl) CreateSession(ctx context.Context, userID int64, role domain.UserRole, client domain.SessionClient) (domain.TokenInfo, error) {
+	_ = s.RevokeDeviceSession(ctx, userID, client.DeviceID)
 
 	var empty domain.TokenInfo
-	res, err := s.generateTokens(ctx, userID, deviceID)
+	now := pkg.TimeNowUTC()
+	session := domain.RefreshToken{
+		UserID:     userID,
+		SessionID:  uuid.NewString(),
+		DeviceID:   client.DeviceID,
+		DeviceName: client.DeviceName,
+		UserAgent:  truncate(client.UserAgent, maxUserAgentLength),
+		IPAddress:  client.IP,
+		SignedInAt: now,
+		LastUsedAt: now,
+	}
+	res, err := s.generateTokens(ctx, session, role)
 	if err != nil {
 		return empty, pkg.OrInternalError(err)
 	}
@@ -45,7 +81,7 @@ func (s *TokenServiceImpl) CreateSession(ctx context.Context, userID int64, devi
 	return res, nil
 }
 
-func (s *TokenServiceImpl) Refresh(ctx context.Context, refreshToken string) (domain.TokenInfo, error) {
+func (s *TokenServiceImpl) Refresh(ctx context.Context, refreshToken string, client domain.SessionClient) (domain.TokenInfo, error) {
 	var empty domain.TokenInfo
 
 	dbToken, err := s.verifyRefreshToken(ctx, refreshToken)
@@ -53,7 +89,7 @@ func (s *TokenServiceImpl) Refresh(ctx context.Context, refreshToken string) (do
 		return empty, err
 	}
 
-	newTokens, err := s.rotateSession(ctx, dbToken)
+	newTokens, err := s.rotateSession(ctx, dbToken, client)
 	if err != nil {
 		return empty, err
 	}
@@ -80,9 +116,62 @@ func (s *TokenServiceImpl) RevokeAllUserSessions(ctx context.Context, userID int
 	if err := s.tokenRepo.UpdateRevokedByUser(ctx, userID); err != nil {
 		return pkg.OrInternalError(err)
 	}
+
+	// Access tokens older than their TTL have expired, so the mark can go then.
+	if err := s.denylist.RevokeBefore(ctx, userID, pkg.TimeNowUTC(), s.tokenCfg.AccessTokenTTL); err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "token_revoke_before", "user_id", userID, "error", err)
+		return pkg.ErrInternal
+	}
+
+	// Sockets are authenticated once, on upgrade, so they outlive the tokens
+	// revoked above unless closed here. The tokens are gone either way, so a
+	// failure is only logged.
+	if err := s.realtime.DisconnectUsers(ctx, []int64{userID}); err != nil {
+		pkg.Log().Errorw("[REALTIME ERROR]", "from", "token_revoke_all", "user_id", userID, "error", err)
+	}
 	return nil
 }
 
+func (s *TokenServiceImpl) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	if err := s.denylist.Deny(ctx, jti, time.Until(expiresAt)); err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "token_deny", "error", err)
+		return pkg.ErrInternal
+	}
+	return nil
+}
+
+func (s *TokenServiceImpl) IsAccessTokenRevoked(ctx context.Context, claims *domain.AuthClaims) (bool, error) {
+	return s.denylist.IsRevoked(ctx, claims)
+}
+
+func (s *TokenServiceImpl) RevokeSession(ctx context.Context, userID int64, sessionID string) error {
+	if err := s.tokenRepo.UpdateRevokedBySession(ctx, userID, sessionID); err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	// The session's latest access token lives at most one TTL past now.
+	if err := s.denylist.DenySession(ctx, sessionID, s.tokenCfg.AccessTokenTTL); err != nil {
+		pkg.Log().Errorw("[CACHE ERROR]", "from", "token_deny_session", "session_id", sessionID, "error", err)
+		return pkg.ErrInternal
+	}
+	return nil
+}
+
+func (s *TokenServiceImpl) ListSessions(ctx context.Context, userID int64, currentDeviceID string) ([]domain.SessionResponse, error) {
+	tokens, err := s.tokenRepo.ListActiveByUser(ctx, userID)
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+
+	sessions := make([]domain.SessionResponse, 0, len(tokens))
+	for i := range tokens {
+		session := tokens[i].ToSessionResponse()
+		session.Current = currentDeviceID != "" && tokens[i].DeviceID == currentDeviceID
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
 func (s *TokenServiceImpl) CleanupDatabase(ctx context.Context) error {
 	threshold := pkg.TimeNowUTC().Add(-domain.AuditRetentionPeriod)
 	if err := s.tokenRepo.DeleteExpiredAndRevoked(ctx, threshold, threshold); err != nil {
@@ -92,19 +181,18 @@ func (s *TokenServiceImpl) CleanupDatabase(ctx context.Context) error {
 }
 
 func (s *TokenServiceImpl) Validate(accessToken string) (*jwt.Token, error) {
-	return jwt.Parse(accessToken, func(t *jwt.Token) (any, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
-		}
-		return []byte(s.tokenCfg.Secret), nil
-	},
+	return jwt.Parse(accessToken, s.keys.Keyfunc,
 		jwt.WithExpirationRequired(),
 		jwt.WithAudience(s.tokenCfg.Aud),
 		jwt.WithIssuer(s.tokenCfg.Iss),
-		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}),
+		jwt.WithValidMethods(s.keys.Methods()),
 	)
 }
 
+func (s *TokenServiceImpl) JWKS() pkg.JWKSet {
+	return s.keys.JWKS()
+}
+
 // Internal helpers
 func (s *TokenServiceImpl) verifyRefreshToken(ctx context.Context, rawRefreshToken string) (domain.RefreshToken, error) {
 	var empty domain.RefreshToken
@@ -124,24 +212,34 @@ func (s *TokenServiceImpl) verifyRefreshToken(ctx context.Context, rawRefreshTok
 	return dbToken, nil
 }
 
-func (s *TokenServiceImpl) rotateSession(ctx context.Context, oldToken domain.RefreshToken) (domain.TokenInfo, error) {
+func (s *TokenServiceImpl) rotateSession(ctx context.Context, oldToken domain.RefreshToken, client domain.SessionClient) (domain.TokenInfo, error) {
 	var empty domain.TokenInfo
 	if err := s.tokenRepo.UpdateRevoked(ctx, oldToken.ID); err != nil {
 		return empty, pkg.OrInternalError(err)
 	}
 
-	return s.generateTokens(ctx, oldToken.UserID, oldToken.DeviceID)
+	session := oldToken
+	if client.UserAgent != "" {
+		session.UserAgent = truncate(client.UserAgent, maxUserAgentLength)
+	}
+	if client.IP != "" {
+		session.IPAddress = client.IP
+	}
+	session.LastUsedAt = pkg.TimeNowUTC()
+
+	return s.generateTokens(ctx, session, oldToken.UserRole)
 }
 
-func (s *TokenServiceImpl) generateTokens(ctx context.Context, userID int64, deviceID string) (domain.TokenInfo, error) {
+// generateTokens issues an access token and the next refresh token of session.
+func (s *TokenServiceImpl) generateTokens(ctx context.Context, session domain.RefreshToken, role domain.UserRole) (domain.TokenInfo, error) {
 	var empty domain.TokenInfo
 
-	access, err := s.generateAccessToken(userID, deviceID)
+	access, err := s.generateAccessToken(session, role)
 	if err != nil {
 		return empty, pkg.OrInternalError(err)
 	}
 
-	raw, refresh := s.generateRefreshToken(userID, deviceID)
+	raw, refresh := s.generateRefreshToken(session)
 
 	if err := s.tokenRepo.Create(ctx, refresh); err != nil {
 		return empty, pkg.OrInternalError(err)
@@ -155,33 +253,39 @@ func (s *TokenServiceImpl) generateTokens(ctx context.Context, userID int64, dev
 	}, nil
 }
 
-func (s *TokenServiceImpl) generateAccessToken(userID int64, deviceID string) (string, error) {
+func (s *TokenServiceImpl) generateAccessToken(session domain.RefreshToken, role domain.UserRole) (string, error) {
 	now := pkg.TimeNowUTC()
 	claims := jwt.MapClaims{
-		pkg.JWTClaimSubject:   fmt.Sprintf("%d", userID),
-		pkg.JWTClaimDevice:    deviceID,
+		pkg.JWTClaimID:        uuid.NewString(),
+		pkg.JWTClaimSubject:   fmt.Sprintf("%d", session.UserID),
+		pkg.JWTClaimDevice:    session.DeviceID,
+		pkg.JWTClaimSession:   session.SessionID,
+		pkg.JWTClaimRole:      string(role),
 		pkg.JWTClaimAudience:  s.tokenCfg.Aud,
 		pkg.JWTClaimIssuer:    s.tokenCfg.Iss,
-		pkg.JWTClaimIssuedAt:  now.Unix(),
+		pkg.JWTClaimIssuedAt:  pkg.NumericDateMillis(now),
 		pkg.JWTClaimNotBefore: now.Unix(),
 		pkg.JWTClaimExpiresAt: now.Add(s.tokenCfg.AccessTokenTTL).Unix(),
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(s.tokenCfg.Secret))
+	return s.keys.Sign(claims)
 }
 
-func (s *TokenServiceImpl) generateRefreshToken(userID int64, deviceID string) (string, domain.RefreshToken) {
+func (s *TokenServiceImpl) generateRefreshToken(session domain.RefreshToken) (string, domain.RefreshToken) {
 	raw := uuid.NewString()
-	hashed := s.hashToken(raw)
 	now := pkg.TimeNowUTC()
-	expiresAt := now.Add(s.tokenCfg.RefreshTokenTTL)
 
 	return raw, domain.RefreshToken{
-		UserID:    userID,
-		DeviceID:  deviceID,
-		TokenHash: hashed,
-		ExpiresAt: expiresAt,
-		CreatedAt: now,
+		UserID:     session.UserID,
+		SessionID:  session.SessionID,
+		DeviceID:   session.DeviceID,
+		TokenHash:  s.hashToken(raw),
+		ExpiresAt:  now.Add(s.tokenCfg.RefreshTokenTTL),
+		CreatedAt:  now,
+		DeviceName: session.DeviceName,
+		UserAgent:  session.UserAgent,
+		IPAddress:  session.IPAddress,
+		SignedInAt: session.SignedInAt,
+		LastUsedAt: session.LastUsedAt,
 	}
 }
 
@@ -189,3 +293,11 @@ func (s *TokenServiceImpl) hashToken(raw string) string {
 	src := sha256.Sum256([]byte(raw))
 	return hex.EncodeToString(src[:])
 }
+
+// truncate cuts s down to n characters.
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
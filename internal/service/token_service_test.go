@@ -2,6 +2,14 @@ package service
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -18,7 +26,9 @@ import (
 
 type tokenServiceSuite struct {
 	suite.Suite
-	cfg config.TokenConfig
+	cfg     config.TokenConfig
+	keys    *pkg.KeySet
+	session domain.RefreshToken
 }
 
 func TestTokenServiceSuite(t *testing.T) {
@@ -29,10 +39,15 @@ func (s *tokenServiceSuite) SetupSuite() {
 	s.cfg = config.TokenConfig{
 		AccessTokenTTL:  15 * time.Minute,
 		RefreshTokenTTL: 7 * 24 * time.Hour,
-		Secret:          "secret",
+		SigningKeyID:    "key-1",
 		Aud:             "users",
 		Iss:             "air-social",
 	}
+
+	keys, err := pkg.GenerateKeySet(s.cfg.SigningKeyID)
+	s.Require().NoError(err)
+	s.keys = keys
+	s.session = domain.RefreshToken{UserID: 1, DeviceID: "device-1", SessionID: "session-1"}
 }
 
 func (s *tokenServiceSuite) TestCreateSession() {
@@ -43,6 +58,7 @@ func (s *tokenServiceSuite) TestCreateSession() {
 
 	type args struct {
 		userID   int64
+		role     domain.UserRole
 		deviceID string
 	}
 
@@ -59,7 +75,7 @@ func (s *tokenServiceSuite) TestCreateSession() {
 	}{
 		{
 			name: "revoke_device_error_ignored",
-			args: args{userID: userID, deviceID: deviceID},
+			args: args{userID: userID, role: domain.UserRoleAdmin, deviceID: deviceID},
 			setupMock: func(repo *mocks.TokenRepository) {
 				repo.EXPECT().UpdateRevokedByDevice(mock.Anything, userID, deviceID).Return(assert.AnError).Once()
 				repo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
@@ -74,7 +90,7 @@ func (s *tokenServiceSuite) TestCreateSession() {
 		},
 		{
 			name: "create_token_error",
-			args: args{userID: userID, deviceID: deviceID},
+			args: args{userID: userID, role: domain.UserRoleAdmin, deviceID: deviceID},
 			setupMock: func(repo *mocks.TokenRepository) {
 				repo.EXPECT().UpdateRevokedByDevice(mock.Anything, userID, deviceID).Return(nil).Once()
 				repo.EXPECT().Create(mock.Anything, mock.Anything).Return(assert.AnError).Once()
@@ -85,11 +101,13 @@ func (s *tokenServiceSuite) TestCreateSession() {
 		},
 		{
 			name: "success",
-			args: args{userID: userID, deviceID: deviceID},
+			args: args{userID: userID, role: domain.UserRoleAdmin, deviceID: deviceID},
 			setupMock: func(repo *mocks.TokenRepository) {
 				repo.EXPECT().UpdateRevokedByDevice(mock.Anything, userID, deviceID).Return(nil).Once()
 				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(t domain.RefreshToken) bool {
-					return t.UserID == userID && t.DeviceID == deviceID
+					return t.UserID == userID && t.DeviceID == deviceID && t.SessionID != "" &&
+						t.DeviceName == "Pixel" && t.UserAgent == "agent" && t.IPAddress == "10.0.0.1" &&
+						!t.SignedInAt.IsZero() && t.LastUsedAt.Equal(t.SignedInAt)
 				})).Return(nil).Once()
 			},
 			want: want{
@@ -105,13 +123,14 @@ func (s *tokenServiceSuite) TestCreateSession() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			mockRepo := mocks.NewTokenRepository(s.T())
-			svc := NewTokenService(mockRepo, s.cfg)
+			svc := NewTokenService(mockRepo, nil, nil, s.keys, s.cfg)
 
 			if tc.setupMock != nil {
 				tc.setupMock(mockRepo)
 			}
 
-			got, err := svc.CreateSession(context.Background(), tc.args.userID, tc.args.deviceID)
+			client := domain.SessionClient{DeviceID: tc.args.deviceID, DeviceName: "Pixel", UserAgent: "agent", IP: "10.0.0.1"}
+			got, err := svc.CreateSession(context.Background(), tc.args.userID, tc.args.role, client)
 
 			if tc.want.err != nil {
 				s.ErrorIs(err, tc.want.err)
@@ -122,22 +141,31 @@ func (s *tokenServiceSuite) TestCreateSession() {
 				s.NotEmpty(got.RefreshToken)
 				s.Equal(tc.want.tokenInfo.TokenType, got.TokenType)
 				s.Equal(tc.want.tokenInfo.ExpiresIn, got.ExpiresIn)
+				s.Equal(string(tc.args.role), s.claims(svc, got.AccessToken)[pkg.JWTClaimRole])
 			}
 		})
 	}
 }
 
 func (s *tokenServiceSuite) TestRefresh() {
-	svc := NewTokenService(nil, s.cfg)
+	svc := NewTokenService(nil, nil, nil, s.keys, s.cfg)
 	rawToken := "raw-refresh-token"
 	hashedToken := svc.hashToken(rawToken)
 
+	signedInAt := pkg.TimeNowUTC().Add(-24 * time.Hour)
 	dbToken := domain.RefreshToken{
-		ID:        1,
-		UserID:    1,
-		DeviceID:  "device-1",
-		TokenHash: hashedToken,
-		ExpiresAt: pkg.TimeNowUTC().Add(1 * time.Hour),
+		ID:         1,
+		UserID:     1,
+		SessionID:  "session-1",
+		DeviceID:   "device-1",
+		TokenHash:  hashedToken,
+		ExpiresAt:  pkg.TimeNowUTC().Add(1 * time.Hour),
+		DeviceName: "Pixel",
+		UserAgent:  "old-agent",
+		IPAddress:  "10.0.0.1",
+		SignedInAt: signedInAt,
+		LastUsedAt: signedInAt,
+		UserRole:   domain.UserRoleModerator,
 	}
 
 	type args struct {
@@ -206,7 +234,10 @@ func (s *tokenServiceSuite) TestRefresh() {
 				repo.EXPECT().GetByHash(mock.Anything, hashedToken).Return(dbToken, nil).Once()
 				repo.EXPECT().UpdateRevoked(mock.Anything, dbToken.ID).Return(nil).Once()
 				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(t domain.RefreshToken) bool {
-					return t.UserID == dbToken.UserID && t.DeviceID == dbToken.DeviceID
+					return t.UserID == dbToken.UserID && t.DeviceID == dbToken.DeviceID &&
+						t.SessionID == dbToken.SessionID && t.DeviceName == dbToken.DeviceName &&
+						t.UserAgent == "new-agent" && t.IPAddress == dbToken.IPAddress &&
+						t.SignedInAt.Equal(signedInAt) && t.LastUsedAt.After(signedInAt)
 				})).Return(nil).Once()
 			},
 			wantErr: nil,
@@ -216,13 +247,13 @@ func (s *tokenServiceSuite) TestRefresh() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			mockRepo := mocks.NewTokenRepository(s.T())
-			svc := NewTokenService(mockRepo, s.cfg)
+			svc := NewTokenService(mockRepo, nil, nil, s.keys, s.cfg)
 
 			if tc.setupMock != nil {
 				tc.setupMock(mockRepo)
 			}
 
-			got, err := svc.Refresh(context.Background(), tc.args.refreshToken)
+			got, err := svc.Refresh(context.Background(), tc.args.refreshToken, domain.SessionClient{UserAgent: "new-agent"})
 
 			if tc.wantErr != nil {
 				s.ErrorIs(err, tc.wantErr)
@@ -231,13 +262,20 @@ func (s *tokenServiceSuite) TestRefresh() {
 				s.NoError(err)
 				s.NotEmpty(got.AccessToken)
 				s.NotEmpty(got.RefreshToken)
+				s.Equal(string(dbToken.UserRole), s.claims(svc, got.AccessToken)[pkg.JWTClaimRole])
 			}
 		})
 	}
 }
 
+func (s *tokenServiceSuite) claims(svc *TokenServiceImpl, accessToken string) jwt.MapClaims {
+	token, err := svc.Validate(accessToken)
+	s.Require().NoError(err)
+	return token.Claims.(jwt.MapClaims)
+}
+
 func (s *tokenServiceSuite) TestRevokeSingle() {
-	svc := NewTokenService(nil, s.cfg)
+	svc := NewTokenService(nil, nil, nil, s.keys, s.cfg)
 	rawToken := "raw-token"
 	hashedToken := svc.hashToken(rawToken)
 	dbToken := domain.RefreshToken{ID: 1}
@@ -279,7 +317,7 @@ func (s *tokenServiceSuite) TestRevokeSingle() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			mockRepo := mocks.NewTokenRepository(s.T())
-			svc := NewTokenService(mockRepo, s.cfg)
+			svc := NewTokenService(mockRepo, nil, nil, s.keys, s.cfg)
 			if tc.setupMock != nil {
 				tc.setupMock(mockRepo)
 			}
@@ -321,7 +359,7 @@ func (s *tokenServiceSuite) TestRevokeDeviceSession() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			mockRepo := mocks.NewTokenRepository(s.T())
-			svc := NewTokenService(mockRepo, s.cfg)
+			svc := NewTokenService(mockRepo, nil, nil, s.keys, s.cfg)
 			if tc.setupMock != nil {
 				tc.setupMock(mockRepo)
 			}
@@ -340,20 +378,39 @@ func (s *tokenServiceSuite) TestRevokeAllUserSessions() {
 
 	tests := []struct {
 		name      string
-		setupMock func(repo *mocks.TokenRepository)
+		setupMock func(repo *mocks.TokenRepository, denylist *mocks.TokenDenylist, realtime *mocks.RealtimeDisconnector)
 		wantErr   error
 	}{
 		{
 			name: "error",
-			setupMock: func(repo *mocks.TokenRepository) {
+			setupMock: func(repo *mocks.TokenRepository, denylist *mocks.TokenDenylist, realtime *mocks.RealtimeDisconnector) {
 				repo.EXPECT().UpdateRevokedByUser(mock.Anything, userID).Return(assert.AnError).Once()
 			},
 			wantErr: pkg.ErrInternal,
 		},
+		{
+			name: "denylist_error",
+			setupMock: func(repo *mocks.TokenRepository, denylist *mocks.TokenDenylist, realtime *mocks.RealtimeDisconnector) {
+				repo.EXPECT().UpdateRevokedByUser(mock.Anything, userID).Return(nil).Once()
+				denylist.EXPECT().RevokeBefore(mock.Anything, userID, mock.Anything, s.cfg.AccessTokenTTL).Return(assert.AnError).Once()
+			},
+			wantErr: pkg.ErrInternal,
+		},
 		{
 			name: "success",
-			setupMock: func(repo *mocks.TokenRepository) {
+			setupMock: func(repo *mocks.TokenRepository, denylist *mocks.TokenDenylist, realtime *mocks.RealtimeDisconnector) {
+				repo.EXPECT().UpdateRevokedByUser(mock.Anything, userID).Return(nil).Once()
+				denylist.EXPECT().RevokeBefore(mock.Anything, userID, mock.Anything, s.cfg.AccessTokenTTL).Return(nil).Once()
+				realtime.EXPECT().DisconnectUsers(mock.Anything, []int64{userID}).Return(nil).Once()
+			},
+			wantErr: nil,
+		},
+		{
+			name: "disconnect_error_ignored",
+			setupMock: func(repo *mocks.TokenRepository, denylist *mocks.TokenDenylist, realtime *mocks.RealtimeDisconnector) {
 				repo.EXPECT().UpdateRevokedByUser(mock.Anything, userID).Return(nil).Once()
+				denylist.EXPECT().RevokeBefore(mock.Anything, userID, mock.Anything, s.cfg.AccessTokenTTL).Return(nil).Once()
+				realtime.EXPECT().DisconnectUsers(mock.Anything, []int64{userID}).Return(assert.AnError).Once()
 			},
 			wantErr: nil,
 		},
@@ -362,9 +419,11 @@ func (s *tokenServiceSuite) TestRevokeAllUserSessions() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			mockRepo := mocks.NewTokenRepository(s.T())
-			svc := NewTokenService(mockRepo, s.cfg)
+			mockDenylist := mocks.NewTokenDenylist(s.T())
+			mockRealtime := mocks.NewRealtimeDisconnector(s.T())
+			svc := NewTokenService(mockRepo, mockDenylist, mockRealtime, s.keys, s.cfg)
 			if tc.setupMock != nil {
-				tc.setupMock(mockRepo)
+				tc.setupMock(mockRepo, mockDenylist, mockRealtime)
 			}
 			err := svc.RevokeAllUserSessions(context.Background(), userID)
 			if tc.wantErr != nil {
@@ -376,6 +435,27 @@ func (s *tokenServiceSuite) TestRevokeAllUserSessions() {
 	}
 }
 
+func (s *tokenServiceSuite) TestRevokeAccessToken() {
+	expiresAt := time.Now().Add(10 * time.Minute)
+	ttl := mock.MatchedBy(func(d time.Duration) bool { return d > 9*time.Minute && d <= 10*time.Minute })
+
+	s.Run("success", func() {
+		mockDenylist := mocks.NewTokenDenylist(s.T())
+		mockDenylist.EXPECT().Deny(mock.Anything, "jti-1", ttl).Return(nil).Once()
+		svc := NewTokenService(nil, mockDenylist, nil, s.keys, s.cfg)
+
+		s.NoError(svc.RevokeAccessToken(context.Background(), "jti-1", expiresAt))
+	})
+
+	s.Run("error", func() {
+		mockDenylist := mocks.NewTokenDenylist(s.T())
+		mockDenylist.EXPECT().Deny(mock.Anything, "jti-1", ttl).Return(assert.AnError).Once()
+		svc := NewTokenService(nil, mockDenylist, nil, s.keys, s.cfg)
+
+		s.ErrorIs(svc.RevokeAccessToken(context.Background(), "jti-1", expiresAt), pkg.ErrInternal)
+	})
+}
+
 func (s *tokenServiceSuite) TestCleanupDatabase() {
 	tests := []struct {
 		name      string
@@ -401,7 +481,7 @@ func (s *tokenServiceSuite) TestCleanupDatabase() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			mockRepo := mocks.NewTokenRepository(s.T())
-			svc := NewTokenService(mockRepo, s.cfg)
+			svc := NewTokenService(mockRepo, nil, nil, s.keys, s.cfg)
 			if tc.setupMock != nil {
 				tc.setupMock(mockRepo)
 			}
@@ -416,31 +496,51 @@ func (s *tokenServiceSuite) TestCleanupDatabase() {
 }
 
 func (s *tokenServiceSuite) TestValidate() {
-	svc := NewTokenService(nil, s.cfg)
-	validToken, _ := svc.generateAccessToken(1, "device-1")
+	svc := NewTokenService(nil, nil, nil, s.keys, s.cfg)
+	validToken, _ := svc.generateAccessToken(s.session, domain.UserRoleUser)
+
+	otherKey, err := pkg.GenerateKeySet(s.cfg.SigningKeyID)
+	s.Require().NoError(err)
+	unknownKey, err := pkg.GenerateKeySet("key-2")
+	s.Require().NoError(err)
 
 	tests := []struct {
 		name        string
 		tokenString string
-		cfg         config.TokenConfig
+		keys        *pkg.KeySet
 		wantErr     error
 		wantToken   bool
 	}{
 		{
 			name:        "valid_token",
 			tokenString: validToken,
-			cfg:         s.cfg,
+			keys:        s.keys,
 			wantErr:     nil,
 			wantToken:   true,
 		},
 		{
 			name:        "invalid_signature",
 			tokenString: validToken,
-			cfg: func() config.TokenConfig {
-				c := s.cfg
-				c.Secret = "wrong-secret"
-				return c
+			keys:        otherKey,
+			wantErr:     jwt.ErrTokenSignatureInvalid,
+			wantToken:   true,
+		},
+		{
+			name:        "unknown_key",
+			tokenString: validToken,
+			keys:        unknownKey,
+			wantErr:     jwt.ErrTokenUnverifiable,
+			wantToken:   true,
+		},
+		{
+			name: "hmac_token",
+			tokenString: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{pkg.JWTClaimSubject: "1"})
+				token.Header["kid"] = s.cfg.SigningKeyID
+				t, _ := token.SignedString([]byte("secret"))
+				return t
 			}(),
+			keys:      s.keys,
 			wantErr:   jwt.ErrTokenSignatureInvalid,
 			wantToken: true,
 		},
@@ -449,18 +549,18 @@ func (s *tokenServiceSuite) TestValidate() {
 			tokenString: func() string {
 				expiredCfg := s.cfg
 				expiredCfg.AccessTokenTTL = -1 * time.Hour
-				expiredSvc := NewTokenService(nil, expiredCfg)
-				t, _ := expiredSvc.generateAccessToken(1, "device-1")
+				expiredSvc := NewTokenService(nil, nil, nil, s.keys, expiredCfg)
+				t, _ := expiredSvc.generateAccessToken(s.session, domain.UserRoleUser)
 				return t
 			}(),
-			cfg:       s.cfg,
+			keys:      s.keys,
 			wantErr:   jwt.ErrTokenExpired,
 			wantToken: true,
 		},
 		{
 			name:        "malformed_token",
 			tokenString: "invalid-token-string",
-			cfg:         s.cfg,
+			keys:        s.keys,
 			wantErr:     jwt.ErrTokenMalformed,
 			wantToken:   false,
 		},
@@ -468,7 +568,7 @@ func (s *tokenServiceSuite) TestValidate() {
 
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
-			svc := NewTokenService(nil, tc.cfg)
+			svc := NewTokenService(nil, nil, nil, tc.keys, s.cfg)
 			token, err := svc.Validate(tc.tokenString)
 
 			if tc.wantErr != nil {
@@ -487,3 +587,110 @@ func (s *tokenServiceSuite) TestValidate() {
 		})
 	}
 }
+
+// TestKeyRotation signs with a new key while tokens of the retired one, kept
+// as a public key only, still verify.
+func (s *tokenServiceSuite) TestKeyRotation() {
+	dir := s.T().TempDir()
+	writePEM := func(name, typ string, der []byte, err error) {
+		s.T().Helper()
+		s.Require().NoError(err)
+		s.Require().NoError(os.WriteFile(filepath.Join(dir, name), pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600))
+	}
+
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	_, newKey, err := ed25519.GenerateKey(rand.Reader)
+	s.Require().NoError(err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(oldKey)
+	writePEM("old.pem", "PRIVATE KEY", der, err)
+	oldKeys, err := pkg.LoadKeySet(dir, "old")
+	s.Require().NoError(err)
+	oldToken, err := NewTokenService(nil, nil, nil, oldKeys, s.cfg).generateAccessToken(s.session, domain.UserRoleUser)
+	s.Require().NoError(err)
+
+	der, err = x509.MarshalPKIXPublicKey(&oldKey.PublicKey)
+	writePEM("old.pem", "PUBLIC KEY", der, err)
+	der, err = x509.MarshalPKCS8PrivateKey(newKey)
+	writePEM("new.pem", "PRIVATE KEY", der, err)
+
+	_, err = pkg.LoadKeySet(dir, "old")
+	s.Error(err, "a public key cannot sign")
+
+	keys, err := pkg.LoadKeySet(dir, "new")
+	s.Require().NoError(err)
+	svc := NewTokenService(nil, nil, nil, keys, s.cfg)
+
+	newToken, err := svc.generateAccessToken(s.session, domain.UserRoleUser)
+	s.Require().NoError(err)
+	for _, raw := range []string{oldToken, newToken} {
+		token, err := svc.Validate(raw)
+		s.Require().NoError(err)
+		s.True(token.Valid)
+	}
+
+	jwks := svc.JWKS()
+	s.Require().Len(jwks.Keys, 2)
+	s.Equal(pkg.JWK{KeyType: "OKP", KeyID: "new", Use: "sig", Algorithm: "EdDSA", Curve: "Ed25519",
+		X: base64.RawURLEncoding.EncodeToString(newKey.Public().(ed25519.PublicKey))}, jwks.Keys[0])
+	s.Equal("old", jwks.Keys[1].KeyID)
+	s.Equal("RS256", jwks.Keys[1].Algorithm)
+	s.Equal("AQAB", jwks.Keys[1].E)
+}
+
+func (s *tokenServiceSuite) TestListSessions() {
+	var userID int64 = 1
+	mockRepo := mocks.NewTokenRepository(s.T())
+	svc := NewTokenService(mockRepo, nil, nil, s.keys, s.cfg)
+
+	mockRepo.EXPECT().ListActiveByUser(mock.Anything, userID).Return([]domain.RefreshToken{
+		{ID: 2, SessionID: "session-2", DeviceID: "phone"},
+		{ID: 1, SessionID: "session-1", DeviceID: "laptop"},
+	}, nil).Once()
+
+	got, err := svc.ListSessions(context.Background(), userID, "laptop")
+
+	s.Require().NoError(err)
+	s.Require().Len(got, 2)
+	s.Equal("session-2", got[0].ID)
+	s.False(got[0].Current)
+	s.True(got[1].Current)
+}
+
+func (s *tokenServiceSuite) TestRevokeSession() {
+	var userID int64 = 1
+
+	tests := []struct {
+		name     string
+		repoErr  error
+		denyErr  error
+		wantDeny bool
+		wantErr  error
+	}{
+		{name: "not_found", repoErr: pkg.ErrNotFound, wantErr: pkg.ErrNotFound},
+		{name: "repo_error", repoErr: assert.AnError, wantErr: pkg.ErrInternal},
+		{name: "denylist_error", denyErr: assert.AnError, wantDeny: true, wantErr: pkg.ErrInternal},
+		{name: "success", wantDeny: true},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			mockRepo := mocks.NewTokenRepository(s.T())
+			mockDenylist := mocks.NewTokenDenylist(s.T())
+			svc := NewTokenService(mockRepo, mockDenylist, nil, s.keys, s.cfg)
+			mockRepo.EXPECT().UpdateRevokedBySession(mock.Anything, userID, "session-1").Return(tc.repoErr).Once()
+			if tc.wantDeny {
+				mockDenylist.EXPECT().DenySession(mock.Anything, "session-1", s.cfg.AccessTokenTTL).Return(tc.denyErr).Once()
+			}
+
+			err := svc.RevokeSession(context.Background(), userID, "session-1")
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
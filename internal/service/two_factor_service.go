@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"air-social/internal/config"
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+const (
+	qrCodeScale        = 6
+	recoveryCodeBytes  = 5
+	totpValidationSkew = 1
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TwoFactorService manages TOTP two-factor authentication. Enrolling only
+// stores the secret; 2FA is on once Confirm has seen a first code from it.
+type TwoFactorService interface {
+	Enroll(ctx context.Context, userID int64) (domain.TwoFactorEnrollResponse, error)
+	Confirm(ctx context.Context, userID int64, code string) (domain.RecoveryCodesResponse, error)
+	Disable(ctx context.Context, input domain.DisableTwoFactorParams) error
+	IsEnabled(ctx context.Context, userID int64) (bool, error)
+	// Verify accepts a code from the authenticator app or a recovery code,
+	// each of which only works once.
+	Verify(ctx context.Context, userID int64, code string) error
+}
+
+type TwoFactorServiceImpl struct {
+	repo    domain.TwoFactorRepository
+	userSvc UserService
+	box     *pkg.SecretBox
+	cfg     config.TwoFactorConfig
+}
+
+func NewTwoFactorService(
+	repo domain.TwoFactorRepository,
+	userSvc UserService,
+	box *pkg.SecretBox,
+	cfg config.TwoFactorConfig,
+) *TwoFactorServiceImpl {
+	return &TwoFactorServiceImpl{
+		repo:    repo,
+		userSvc: userSvc,
+		box:     box,
+		cfg:     cfg,
+	}
+}
+
+func (s *TwoFactorServiceImpl) Enroll(ctx context.Context, userID int64) (domain.TwoFactorEnrollResponse, error) {
+	var empty domain.TwoFactorEnrollResponse
+
+	user, err := s.userSvc.GetByID(ctx, userID)
+	if err != nil {
+		return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	secret, err := pkg.GenerateTOTPSecret()
+	if err != nil {
+		return empty, pkg.ErrInternal
+	}
+	sealed, err := s.box.Seal([]byte(secret))
+	if err != nil {
+		return empty, pkg.ErrInternal
+	}
+
+	// Fails with ErrConflict when 2FA is on already, so an enrollment cannot
+	// swap the secret of an account without a code from the current one.
+	if err := s.repo.SavePending(ctx, userID, sealed); err != nil {
+		return empty, pkg.OrInternalError(err, pkg.ErrConflict)
+	}
+
+	uri := pkg.TOTPURI(s.cfg.Issuer, user.Email, secret)
+	png, err := pkg.QRCodePNG([]byte(uri), qrCodeScale)
+	if err != nil {
+		return empty, pkg.ErrInternal
+	}
+
+	return domain.TwoFactorEnrollResponse{
+		Secret: secret,
+		URI:    uri,
+		QRCode: "data:image/png;base64," + base64.StdEncoding.EncodeToString(png),
+	}, nil
+}
+
+func (s *TwoFactorServiceImpl) Confirm(ctx context.Context, userID int64, code string) (domain.RecoveryCodesResponse, error) {
+	var empty domain.RecoveryCodesResponse
+
+	tf, err := s.repo.Get(ctx, userID)
+	if err != nil {
+		return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	if tf.Enabled() {
+		return empty, pkg.ErrConflict
+	}
+
+	secret, err := s.box.Open(tf.Secret)
+	if err != nil {
+		return empty, pkg.ErrInternal
+	}
+	step, ok := pkg.ValidateTOTP(string(secret), code, pkg.TimeNowUTC(), totpValidationSkew)
+	if !ok {
+		return empty, pkg.ErrInvalidTwoFactorCode
+	}
+
+	codes, hashes, err := s.generateRecoveryCodes()
+	if err != nil {
+		return empty, pkg.ErrInternal
+	}
+	if err := s.repo.Enable(ctx, userID, step, hashes); err != nil {
+		return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+
+	return domain.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+func (s *TwoFactorServiceImpl) Disable(ctx context.Context, input domain.DisableTwoFactorParams) error {
+	user, err := s.userSvc.GetByID(ctx, input.UserID)
+	if err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	if !verifyPassword(input.Password, user.PasswordHash) {
+		return pkg.ErrInvalidCredentials
+	}
+
+	if err := s.Verify(ctx, input.UserID, input.Code); err != nil {
+		return err
+	}
+
+	err = s.repo.Delete(ctx, input.UserID)
+	return pkg.OrInternalError(err, pkg.ErrNotFound)
+}
+
+func (s *TwoFactorServiceImpl) IsEnabled(ctx context.Context, userID int64) (bool, error) {
+	tf, err := s.repo.Get(ctx, userID)
+	if errors.Is(err, pkg.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, pkg.ErrInternal
+	}
+	return tf.Enabled(), nil
+}
+
+func (s *TwoFactorServiceImpl) Verify(ctx context.Context, userID int64, code string) error {
+	tf, err := s.repo.Get(ctx, userID)
+	if errors.Is(err, pkg.ErrNotFound) {
+		return pkg.ErrInvalidTwoFactorCode
+	}
+	if err != nil {
+		return pkg.ErrInternal
+	}
+	if !tf.Enabled() {
+		return pkg.ErrInvalidTwoFactorCode
+	}
+
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != pkg.TOTPDigits {
+		err := s.repo.UseRecoveryCode(ctx, userID, hashRecoveryCode(code))
+		if errors.Is(err, pkg.ErrNotFound) {
+			return pkg.ErrInvalidTwoFactorCode
+		}
+		return pkg.OrInternalError(err)
+	}
+
+	secret, err := s.box.Open(tf.Secret)
+	if err != nil {
+		return pkg.ErrInternal
+	}
+	step, ok := pkg.ValidateTOTP(string(secret), code, pkg.TimeNowUTC(), totpValidationSkew)
+	if !ok {
+		return pkg.ErrInvalidTwoFactorCode
+	}
+
+	// A code seen before is refused, so one read over a shoulder or from a
+	// log cannot be replayed within its window.
+	err = s.repo.UseStep(ctx, userID, step)
+	if errors.Is(err, pkg.ErrConflict) {
+		return pkg.ErrInvalidTwoFactorCode
+	}
+	return pkg.OrInternalError(err)
+}
+
+// generateRecoveryCodes returns the codes to show once, as xxxx-xxxx, and
+// the hashes to store.
+func (s *TwoFactorServiceImpl) generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, s.cfg.RecoveryCodes)
+	hashes := make([]string, s.cfg.RecoveryCodes)
+	for i := range codes {
+		b := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		raw := strings.ToLower(recoveryCodeEncoding.EncodeToString(b))
+		codes[i] = raw[:4] + "-" + raw[4:]
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode ignores case and dashes, as codes are typed in by hand.
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(code, "-", ""))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"air-social/internal/config"
+	"air-social/internal/domain"
+	"air-social/internal/mocks"
+	"air-social/pkg"
+)
+
+type twoFactorServiceSuite struct {
+	suite.Suite
+	box *pkg.SecretBox
+}
+
+func TestTwoFactorServiceSuite(t *testing.T) {
+	suite.Run(t, new(twoFactorServiceSuite))
+}
+
+func (s *twoFactorServiceSuite) SetupTest() {
+	box, err := pkg.NewSecretBox("test-key")
+	s.Require().NoError(err)
+	s.box = box
+}
+
+type twoFactorMocks struct {
+	repo *mocks.TwoFactorRepository
+	user *mocks.UserService
+}
+
+func (s *twoFactorServiceSuite) newService() (*TwoFactorServiceImpl, twoFactorMocks) {
+	m := twoFactorMocks{
+		repo: mocks.NewTwoFactorRepository(s.T()),
+		user: mocks.NewUserService(s.T()),
+	}
+	cfg := config.TwoFactorConfig{Issuer: "Air Social", RecoveryCodes: 3}
+	return NewTwoFactorService(m.repo, m.user, s.box, cfg), m
+}
+
+// enrolled returns a TOTP setup with a fresh secret, on when enabled.
+func (s *twoFactorServiceSuite) enrolled(enabled bool) (*domain.TwoFactor, string) {
+	secret, err := pkg.GenerateTOTPSecret()
+	s.Require().NoError(err)
+	sealed, err := s.box.Seal([]byte(secret))
+	s.Require().NoError(err)
+
+	tf := &domain.TwoFactor{UserID: 1, Secret: sealed}
+	if enabled {
+		now := time.Now()
+		tf.EnabledAt = &now
+	}
+	return tf, secret
+}
+
+func (s *twoFactorServiceSuite) currentCode(secret string) (string, int64) {
+	step := pkg.TOTPStep(pkg.TimeNowUTC())
+	code, err := pkg.TOTPCode(secret, step)
+	s.Require().NoError(err)
+	return code, step
+}
+
+func (s *twoFactorServiceSuite) TestEnroll() {
+	user := &domain.User{ID: 1, Email: "test@example.com"}
+
+	s.Run("success", func() {
+		svc, m := s.newService()
+		var sealed []byte
+		m.user.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+		m.repo.EXPECT().SavePending(mock.Anything, user.ID, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ int64, secret []byte) error {
+				sealed = secret
+				return nil
+			}).Once()
+
+		got, err := svc.Enroll(context.Background(), user.ID)
+
+		s.Require().NoError(err)
+		s.True(strings.HasPrefix(got.URI, "otpauth://totp/Air%20Social:test@example.com?"))
+		s.Contains(got.URI, "secret="+got.Secret)
+		s.True(strings.HasPrefix(got.QRCode, "data:image/png;base64,"))
+		plain, err := s.box.Open(sealed)
+		s.Require().NoError(err)
+		s.Equal(got.Secret, string(plain))
+	})
+
+	s.Run("already_enabled", func() {
+		svc, m := s.newService()
+		m.user.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+		m.repo.EXPECT().SavePending(mock.Anything, user.ID, mock.Anything).Return(pkg.ErrConflict).Once()
+
+		_, err := svc.Enroll(context.Background(), user.ID)
+		s.ErrorIs(err, pkg.ErrConflict)
+	})
+}
+
+func (s *twoFactorServiceSuite) TestConfirm() {
+	s.Run("not_enrolled", func() {
+		svc, m := s.newService()
+		m.repo.EXPECT().Get(mock.Anything, int64(1)).Return(nil, pkg.ErrNotFound).Once()
+
+		_, err := svc.Confirm(context.Background(), 1, "123456")
+		s.ErrorIs(err, pkg.ErrNotFound)
+	})
+
+	s.Run("already_enabled", func() {
+		svc, m := s.newService()
+		tf, _ := s.enrolled(true)
+		m.repo.EXPECT().Get(mock.Anything, int64(1)).Return(tf, nil).Once()
+
+		_, err := svc.Confirm(context.Background(), 1, "123456")
+		s.ErrorIs(err, pkg.ErrConflict)
+	})
+
+	s.Run("wrong_code", func() {
+		svc, m := s.newService()
+		tf, secret := s.enrolled(false)
+		code, _ := s.currentCode(secret)
+		wrong := "000000"
+		if code == wrong {
+			wrong = "111111"
+		}
+		m.repo.EXPECT().Get(mock.Anything, int64(1)).Return(tf, nil).Once()
+
+		_, err := svc.Confirm(context.Background(), 1, wrong)
+		s.ErrorIs(err, pkg.ErrInvalidTwoFactorCode)
+	})
+
+	s.Run("success", func() {
+		svc, m := s.newService()
+		tf, secret := s.enrolled(false)
+		code, step := s.currentCode(secret)
+		var hashes []string
+		m.repo.EXPECT().Get(mock.Anything, int64(1)).Return(tf, nil).Once()
+		m.repo.EXPECT().Enable(mock.Anything, int64(1), mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ int64, got int64, h []string) error {
+				s.InDelta(step, got, 1)
+				hashes = h
+				return nil
+			}).Once()
+
+		got, err := svc.Confirm(context.Background(), 1, code)
+
+		s.Require().NoError(err)
+		s.Len(got.RecoveryCodes, 3)
+		for i, c := range got.RecoveryCodes {
+			s.Regexp(`^[a-z2-7]{4}-[a-z2-7]{4}$`, c)
+			s.Equal(hashRecoveryCode(c), hashes[i])
+		}
+	})
+}
+
+func (s *twoFactorServiceSuite) TestVerify() {
+	s.Run("not_enabled", func() {
+		svc, m := s.newService()
+		tf, secret := s.enrolled(false)
+		code, _ := s.currentCode(secret)
+		m.repo.EXPECT().Get(mock.Anything, int64(1)).Return(tf, nil).Once()
+
+		s.ErrorIs(svc.Verify(context.Background(), 1, code), pkg.ErrInvalidTwoFactorCode)
+	})
+
+	s.Run("totp_code", func() {
+		svc, m := s.newService()
+		tf, secret := s.enrolled(true)
+		code, _ := s.currentCode(secret)
+		m.repo.EXPECT().Get(mock.Anything, int64(1)).Return(tf, nil).Once()
+		m.repo.EXPECT().UseStep(mock.Anything, int64(1), mock.Anything).Return(nil).Once()
+
+		s.NoError(svc.Verify(context.Background(), 1, code))
+	})
+
+	s.Run("replayed_totp_code", func() {
+		svc, m := s.newService()
+		tf, secret := s.enrolled(true)
+		code, _ := s.currentCode(secret)
+		m.repo.EXPECT().Get(mock.Anything, int64(1)).Return(tf, nil).Once()
+		m.repo.EXPECT().UseStep(mock.Anything, int64(1), mock.Anything).Return(pkg.ErrConflict).Once()
+
+		s.ErrorIs(svc.Verify(context.Background(), 1, code), pkg.ErrInvalidTwoFactorCode)
+	})
+
+	s.Run("recovery_code", func() {
+		svc, m := s.newService()
+		tf, _ := s.enrolled(true)
+		m.repo.EXPECT().Get(mock.Anything, int64(1)).Return(tf, nil).Once()
+		m.repo.EXPECT().UseRecoveryCode(mock.Anything, int64(1), hashRecoveryCode("abcd-efgh")).Return(nil).Once()
+
+		s.NoError(svc.Verify(context.Background(), 1, " ABCD EFGH "))
+	})
+
+	s.Run("unknown_recovery_code", func() {
+		svc, m := s.newService()
+		tf, _ := s.enrolled(true)
+		m.repo.EXPECT().Get(mock.Anything, int64(1)).Return(tf, nil).Once()
+		m.repo.EXPECT().UseRecoveryCode(mock.Anything, int64(1), mock.Anything).Return(pkg.ErrNotFound).Once()
+
+		s.ErrorIs(svc.Verify(context.Background(), 1, "abcd-efgh"), pkg.ErrInvalidTwoFactorCode)
+	})
+
+	s.Run("repo_error", func() {
+		svc, m := s.newService()
+		m.repo.EXPECT().Get(mock.Anything, int64(1)).Return(nil, assert.AnError).Once()
+
+		s.ErrorIs(svc.Verify(context.Background(), 1, "123456"), pkg.ErrInternal)
+	})
+}
+
+func (s *twoFactorServiceSuite) TestDisable() {
+	hashed, _ := hashPassword("password123")
+	user := &domain.User{ID: 1, PasswordHash: hashed}
+
+	s.Run("wrong_password", func() {
+		svc, m := s.newService()
+		m.user.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+
+		err := svc.Disable(context.Background(), domain.DisableTwoFactorParams{UserID: 1, Password: "wrong", Code: "123456"})
+		s.ErrorIs(err, pkg.ErrInvalidCredentials)
+	})
+
+	s.Run("success", func() {
+		svc, m := s.newService()
+		tf, _ := s.enrolled(true)
+		m.user.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+		m.repo.EXPECT().Get(mock.Anything, user.ID).Return(tf, nil).Once()
+		m.repo.EXPECT().UseRecoveryCode(mock.Anything, user.ID, hashRecoveryCode("abcd-efgh")).Return(nil).Once()
+		m.repo.EXPECT().Delete(mock.Anything, user.ID).Return(nil).Once()
+
+		err := svc.Disable(context.Background(), domain.DisableTwoFactorParams{UserID: 1, Password: "password123", Code: "abcd-efgh"})
+		s.NoError(err)
+	})
+}
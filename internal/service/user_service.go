@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 
+	"air-social/internal/config"
 	"air-social/internal/domain"
 	"air-social/pkg"
 )
@@ -11,26 +13,59 @@ type UserService interface {
 	GetByID(ctx context.Context, id int64) (*domain.User, error)
 	GetByEmail(ctx context.Context, email string) (*domain.User, error)
 	GetProfile(ctx context.Context, id int64) (domain.UserResponse, error)
+	GetPublicProfile(ctx context.Context, id int64) (domain.PublicProfileResponse, error)
 
 	CreateUser(ctx context.Context, input domain.CreateUserParams) (domain.UserResponse, error)
+	// CreateUserWithIdentity signs up the user of an external provider.
+	CreateUserWithIdentity(ctx context.Context, input domain.CreateUserParams, identity domain.UserIdentity) (*domain.User, error)
+	// GetByIdentity returns the user linked to subject at provider.
+	GetByIdentity(ctx context.Context, provider, subject string) (*domain.User, error)
+	LinkIdentity(ctx context.Context, identity domain.UserIdentity) error
 	UpdateProfile(ctx context.Context, input domain.UpdateProfileParams) (domain.UserResponse, error)
 	ChangePassword(ctx context.Context, input domain.ChangePasswordParams) error
 	UpdatePassword(ctx context.Context, email, passwordHashed string) error
 	VerifyEmail(ctx context.Context, email string) error
+	// ChangeEmail moves the user to newEmail, which counts as verified, unless
+	// their email changed from oldEmail meanwhile or newEmail is taken.
+	ChangeEmail(ctx context.Context, userID int64, oldEmail, newEmail string) error
+	// DeleteAccount signs the user out everywhere and hides their profile
+	// until the account is purged, after the grace period.
+	DeleteAccount(ctx context.Context, input domain.DeleteAccountParams) (domain.AccountDeletionResponse, error)
+	// CancelDeletion keeps the account of a user who logged in during the
+	// grace period.
+	CancelDeletion(ctx context.Context, userID int64) error
+	// PurgeDeletedAccounts deletes the accounts whose grace period is over,
+	// together with their files.
+	PurgeDeletedAccounts(ctx context.Context) error
+	SetHidePresence(ctx context.Context, userID int64, hidden bool) error
+	// ListHidingPresence returns the users among ids that hide their presence.
+	ListHidingPresence(ctx context.Context, ids []int64) ([]int64, error)
 
 	ConfirmImageUpload(ctx context.Context, input domain.ConfirmFileParams) (string, error)
 	ResolveMediaURLs(res *domain.UserResponse)
 }
 
 type UserServiceImpl struct {
-	userRepo domain.UserRepository
-	mediaSvc MediaService
+	userRepo  domain.UserRepository
+	tokenSvc  TokenService
+	mediaSvc  MediaService
+	reactions domain.ReactionCounter
+	deletion  config.DeletionConfig
 }
 
-func NewUserService(userRepo domain.UserRepository, mediaSvc MediaService) *UserServiceImpl {
+func NewUserService(
+	userRepo domain.UserRepository,
+	tokenSvc TokenService,
+	mediaSvc MediaService,
+	reactions domain.ReactionCounter,
+	deletion config.DeletionConfig,
+) *UserServiceImpl {
 	return &UserServiceImpl{
-		userRepo: userRepo,
-		mediaSvc: mediaSvc,
+		userRepo:  userRepo,
+		tokenSvc:  tokenSvc,
+		mediaSvc:  mediaSvc,
+		reactions: reactions,
+		deletion:  deletion,
 	}
 }
 
@@ -58,6 +93,18 @@ func (s *UserServiceImpl) GetProfile(ctx context.Context, id int64) (domain.User
 	return s.mapToResponse(user), nil
 }
 
+func (s *UserServiceImpl) GetPublicProfile(ctx context.Context, id int64) (domain.PublicProfileResponse, error) {
+	user, err := s.GetByID(ctx, id)
+	if err != nil {
+		return domain.PublicProfileResponse{}, err
+	}
+	// A deleted account is gone for everybody else at once.
+	if user.DeleteAfter != nil {
+		return domain.PublicProfileResponse{}, pkg.ErrNotFound
+	}
+	return s.mapToResponse(user).ToPublic(), nil
+}
+
 func (s *UserServiceImpl) ResolveMediaURLs(res *domain.UserResponse) {
 	if res == nil {
 		return
@@ -94,6 +141,37 @@ func (s *UserServiceImpl) CreateUser(ctx context.Context, input domain.CreateUse
 	return s.mapToResponse(user), nil
 }
 
+func (s *UserServiceImpl) CreateUserWithIdentity(ctx context.Context, input domain.CreateUserParams, identity domain.UserIdentity) (*domain.User, error) {
+	user := &domain.User{
+		Email:        input.Email,
+		Username:     input.Username,
+		PasswordHash: input.PasswordHashed,
+		Verified:     input.Verified,
+	}
+	if input.Verified {
+		now := pkg.TimeNowUTC()
+		user.VerifiedAt = &now
+	}
+
+	if err := s.userRepo.CreateWithIdentity(ctx, user, &identity); err != nil {
+		return nil, pkg.OrInternalError(err, pkg.ErrAlreadyExists)
+	}
+	return user, nil
+}
+
+func (s *UserServiceImpl) GetByIdentity(ctx context.Context, provider, subject string) (*domain.User, error) {
+	user, err := s.userRepo.GetByIdentity(ctx, provider, subject)
+	if err != nil {
+		return nil, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	return user, nil
+}
+
+func (s *UserServiceImpl) LinkIdentity(ctx context.Context, identity domain.UserIdentity) error {
+	err := s.userRepo.CreateIdentity(ctx, &identity)
+	return pkg.OrInternalError(err, pkg.ErrAlreadyExists)
+}
+
 func (s *UserServiceImpl) UpdateProfile(ctx context.Context, input domain.UpdateProfileParams) (domain.UserResponse, error) {
 	var empty domain.UserResponse
 
@@ -141,9 +219,7 @@ func (s *UserServiceImpl) ChangePassword(ctx context.Context, input domain.Chang
 	if err != nil {
 		return pkg.OrInternalError(err)
 	}
-	user.PasswordHash = hashedPwd
-
-	return s.updateUser(ctx, user)
+	return s.setPassword(ctx, user, hashedPwd)
 }
 
 func (s *UserServiceImpl) UpdatePassword(ctx context.Context, email, passwordHashed string) error {
@@ -152,8 +228,17 @@ func (s *UserServiceImpl) UpdatePassword(ctx context.Context, email, passwordHas
 		return err
 	}
 
+	return s.setPassword(ctx, user, passwordHashed)
+}
+
+// setPassword saves the new password of user and signs them out everywhere,
+// so whoever holds one of their old tokens loses access at once.
+func (s *UserServiceImpl) setPassword(ctx context.Context, user *domain.User, passwordHashed string) error {
 	user.PasswordHash = passwordHashed
-	return s.updateUser(ctx, user)
+	if err := s.updateUser(ctx, user); err != nil {
+		return err
+	}
+	return s.tokenSvc.RevokeAllUserSessions(ctx, user.ID)
 }
 
 func (s *UserServiceImpl) VerifyEmail(ctx context.Context, email string) error {
@@ -169,6 +254,91 @@ func (s *UserServiceImpl) VerifyEmail(ctx context.Context, email string) error {
 	return s.updateUser(ctx, user)
 }
 
+func (s *UserServiceImpl) ChangeEmail(ctx context.Context, userID int64, oldEmail, newEmail string) error {
+	err := s.userRepo.UpdateEmail(ctx, userID, oldEmail, newEmail)
+	if errors.Is(err, pkg.ErrAlreadyExists) {
+		return pkg.ErrEmailTaken
+	}
+	return pkg.OrInternalError(err, pkg.ErrNotFound)
+}
+
+func (s *UserServiceImpl) DeleteAccount(ctx context.Context, input domain.DeleteAccountParams) (domain.AccountDeletionResponse, error) {
+	var empty domain.AccountDeletionResponse
+
+	user, err := s.GetByID(ctx, input.UserID)
+	if err != nil {
+		return empty, err
+	}
+	if !verifyPassword(input.Password, user.PasswordHash) {
+		return empty, pkg.ErrInvalidCredentials
+	}
+
+	deleteAfter := pkg.TimeNowUTC().Add(s.deletion.GracePeriod)
+	if err := s.userRepo.ScheduleDeletion(ctx, user.ID, &deleteAfter); err != nil {
+		return empty, pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	if err := s.tokenSvc.RevokeAllUserSessions(ctx, user.ID); err != nil {
+		return empty, err
+	}
+
+	return domain.AccountDeletionResponse{DeleteAfter: deleteAfter}, nil
+}
+
+func (s *UserServiceImpl) CancelDeletion(ctx context.Context, userID int64) error {
+	if err := s.userRepo.ScheduleDeletion(ctx, userID, nil); err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	return nil
+}
+
+func (s *UserServiceImpl) PurgeDeletedAccounts(ctx context.Context) error {
+	batch := s.deletion.PurgeBatchSize
+
+	for {
+		ids, err := s.userRepo.ListDueForDeletion(ctx, pkg.TimeNowUTC(), batch)
+		if err != nil {
+			return pkg.OrInternalError(err)
+		}
+
+		for _, id := range ids {
+			purged, err := s.userRepo.Purge(ctx, id)
+			if errors.Is(err, pkg.ErrNotFound) {
+				// The user logged in since they were listed.
+				continue
+			}
+			if err != nil {
+				return pkg.OrInternalError(err)
+			}
+			s.deleteFiles(ctx, id, purged.ObjectKeys)
+
+			// The reconcile job recounts them from Postgres, where the
+			// reactions of the user are gone.
+			if err := s.reactions.MarkDirty(ctx, purged.Reactions); err != nil {
+				pkg.Log().Errorw("[CACHE ERROR]", "from", "account_purge", "user_id", id, "error", err)
+			}
+		}
+
+		if len(ids) < batch {
+			return nil
+		}
+	}
+}
+
+func (s *UserServiceImpl) SetHidePresence(ctx context.Context, userID int64, hidden bool) error {
+	if err := s.userRepo.SetHidePresence(ctx, userID, hidden); err != nil {
+		return pkg.OrInternalError(err, pkg.ErrNotFound)
+	}
+	return nil
+}
+
+func (s *UserServiceImpl) ListHidingPresence(ctx context.Context, ids []int64) ([]int64, error) {
+	hidden, err := s.userRepo.ListHidingPresence(ctx, ids)
+	if err != nil {
+		return nil, pkg.OrInternalError(err)
+	}
+	return hidden, nil
+}
+
 func (s *UserServiceImpl) ConfirmImageUpload(ctx context.Context, input domain.ConfirmFileParams) (string, error) {
 	if input.Feature != domain.FeatureAvatar && input.Feature != domain.FeatureCover {
 		return "", pkg.ErrInvalidData
@@ -194,6 +364,16 @@ func (s *UserServiceImpl) mapToResponse(user *domain.User) domain.UserResponse {
 	return res
 }
 
+// deleteFiles removes the files of a purged user. The rows pointing at them
+// are gone already, so a failure only leaves an orphan object behind.
+func (s *UserServiceImpl) deleteFiles(ctx context.Context, userID int64, keys []string) {
+	for _, key := range keys {
+		if err := s.mediaSvc.DeleteFile(ctx, key); err != nil {
+			pkg.Log().Errorw("[STORAGE ERROR]", "from", "account_purge", "user_id", userID, "key", key, "error", err)
+		}
+	}
+}
+
 func (s *UserServiceImpl) updateUser(ctx context.Context, user *domain.User) error {
 	if err := s.userRepo.Update(ctx, user); err != nil {
 		return pkg.OrInternalError(err)
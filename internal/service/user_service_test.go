@@ -3,11 +3,13 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"air-social/internal/config"
 	"air-social/internal/domain"
 	"air-social/internal/mocks"
 	"air-social/pkg"
@@ -113,7 +115,7 @@ func (s *userServiceSuite) TestCreateUser() {
 		s.Run(tc.name, func() {
 			mockRepo := mocks.NewUserRepository(s.T())
 			mockMedia := mocks.NewMediaService(s.T())
-			userSvc := NewUserService(mockRepo, mockMedia)
+			userSvc := NewUserService(mockRepo, nil, mockMedia, nil, config.DeletionConfig{})
 
 			if tc.setupMock != nil {
 				tc.setupMock(mockRepo, mockMedia, tc.args)
@@ -200,7 +202,7 @@ func (s *userServiceSuite) TestGetByID() {
 		s.Run(tc.name, func() {
 			userRepo := mocks.NewUserRepository(s.T())
 			mediaSvc := mocks.NewMediaService(s.T())
-			userSvc := NewUserService(userRepo, mediaSvc)
+			userSvc := NewUserService(userRepo, nil, mediaSvc, nil, config.DeletionConfig{})
 
 			if tc.setupMock != nil {
 				tc.setupMock(userRepo, mediaSvc, tc.args)
@@ -285,7 +287,7 @@ func (s *userServiceSuite) TestGetByEmail() {
 		s.Run(tc.name, func() {
 			userRepo := mocks.NewUserRepository(s.T())
 			mediaSvc := mocks.NewMediaService(s.T())
-			userSvc := NewUserService(userRepo, mediaSvc)
+			userSvc := NewUserService(userRepo, nil, mediaSvc, nil, config.DeletionConfig{})
 
 			if tc.setupMock != nil {
 				tc.setupMock(userRepo, mediaSvc, tc.args)
@@ -378,7 +380,7 @@ func (s *userServiceSuite) TestGetProfile() {
 		s.Run(tc.name, func() {
 			userRepo := mocks.NewUserRepository(s.T())
 			mediaSvc := mocks.NewMediaService(s.T())
-			userSvc := NewUserService(userRepo, mediaSvc)
+			userSvc := NewUserService(userRepo, nil, mediaSvc, nil, config.DeletionConfig{})
 
 			if tc.setupMock != nil {
 				tc.setupMock(userRepo, mediaSvc, tc.args)
@@ -395,6 +397,55 @@ func (s *userServiceSuite) TestGetProfile() {
 	}
 }
 
+func (s *userServiceSuite) TestGetPublicProfile() {
+	user := &domain.User{
+		ID:           2,
+		Email:        "other@example.com",
+		Username:     "other",
+		Profile:      domain.Profile{Avatar: "user/2/avatar/a.jpg"},
+		FollowCounts: domain.FollowCounts{FollowersCount: 5, FollowingCount: 3},
+	}
+
+	s.Run("not_found", func() {
+		userRepo := mocks.NewUserRepository(s.T())
+		userSvc := NewUserService(userRepo, nil, mocks.NewMediaService(s.T()), nil, config.DeletionConfig{})
+
+		userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(nil, pkg.ErrNotFound).Once()
+
+		_, err := userSvc.GetPublicProfile(context.Background(), user.ID)
+		s.ErrorIs(err, pkg.ErrNotFound)
+	})
+
+	s.Run("pending_deletion", func() {
+		userRepo := mocks.NewUserRepository(s.T())
+		userSvc := NewUserService(userRepo, nil, mocks.NewMediaService(s.T()), nil, config.DeletionConfig{})
+
+		deleteAfter := time.Now().Add(time.Hour)
+		deleted := *user
+		deleted.DeleteAfter = &deleteAfter
+		userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(&deleted, nil).Once()
+
+		_, err := userSvc.GetPublicProfile(context.Background(), user.ID)
+		s.ErrorIs(err, pkg.ErrNotFound)
+	})
+
+	s.Run("success", func() {
+		userRepo := mocks.NewUserRepository(s.T())
+		mediaSvc := mocks.NewMediaService(s.T())
+		userSvc := NewUserService(userRepo, nil, mediaSvc, nil, config.DeletionConfig{})
+
+		userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+		mediaSvc.EXPECT().GetPublicURL(user.Avatar).Return("http://cdn/" + user.Avatar).Once()
+
+		got, err := userSvc.GetPublicProfile(context.Background(), user.ID)
+		s.NoError(err)
+		s.Equal("other", got.Username)
+		s.Equal("http://cdn/"+user.Avatar, got.Avatar)
+		s.Equal(5, got.FollowersCount)
+		s.Equal(3, got.FollowingCount)
+	})
+}
+
 func (s *userServiceSuite) TestResolveMediaURLs() {
 	avatarKey := "avatar.jpg"
 	coverKey := "cover.jpg"
@@ -447,7 +498,7 @@ func (s *userServiceSuite) TestResolveMediaURLs() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			mediaSvc := mocks.NewMediaService(s.T())
-			userSvc := NewUserService(nil, mediaSvc)
+			userSvc := NewUserService(nil, nil, mediaSvc, nil, config.DeletionConfig{})
 
 			if tc.setupMock != nil {
 				tc.setupMock(mediaSvc, tc.args)
@@ -549,7 +600,7 @@ func (s *userServiceSuite) TestUpdateProfile() {
 		s.Run(tc.name, func() {
 			userRepo := mocks.NewUserRepository(s.T())
 			mediaSvc := mocks.NewMediaService(s.T())
-			userSvc := NewUserService(userRepo, mediaSvc)
+			userSvc := NewUserService(userRepo, nil, mediaSvc, nil, config.DeletionConfig{})
 
 			if tc.setupMock != nil {
 				tc.setupMock(userRepo, mediaSvc, tc.args)
@@ -580,7 +631,7 @@ func (s *userServiceSuite) TestChangePassword() {
 	tests := []struct {
 		name      string
 		args      args
-		setupMock func(userRepo *mocks.UserRepository, mediaSvc *mocks.MediaService, a args)
+		setupMock func(userRepo *mocks.UserRepository, tokenSvc *mocks.TokenService, a args)
 		wantErr   error
 	}{
 		{
@@ -588,7 +639,7 @@ func (s *userServiceSuite) TestChangePassword() {
 			args: args{
 				input: domain.ChangePasswordParams{UserID: userID},
 			},
-			setupMock: func(userRepo *mocks.UserRepository, mediaSvc *mocks.MediaService, a args) {
+			setupMock: func(userRepo *mocks.UserRepository, tokenSvc *mocks.TokenService, a args) {
 				userRepo.EXPECT().GetByID(mock.Anything, a.input.UserID).Return(nil, pkg.ErrNotFound).Once()
 			},
 			wantErr: pkg.ErrNotFound,
@@ -602,7 +653,7 @@ func (s *userServiceSuite) TestChangePassword() {
 					NewPassword:     password,
 				},
 			},
-			setupMock: func(userRepo *mocks.UserRepository, mediaSvc *mocks.MediaService, a args) {
+			setupMock: func(userRepo *mocks.UserRepository, tokenSvc *mocks.TokenService, a args) {
 				userRepo.EXPECT().GetByID(mock.Anything, a.input.UserID).Return(&domain.User{PasswordHash: hashedPassword}, nil).Once()
 			},
 			wantErr: pkg.ErrSamePassword,
@@ -616,7 +667,7 @@ func (s *userServiceSuite) TestChangePassword() {
 					NewPassword:     "newpassword",
 				},
 			},
-			setupMock: func(userRepo *mocks.UserRepository, mediaSvc *mocks.MediaService, a args) {
+			setupMock: func(userRepo *mocks.UserRepository, tokenSvc *mocks.TokenService, a args) {
 				userRepo.EXPECT().GetByID(mock.Anything, a.input.UserID).Return(&domain.User{PasswordHash: hashedPassword}, nil).Once()
 			},
 			wantErr: pkg.ErrInvalidCredentials,
@@ -630,12 +681,13 @@ func (s *userServiceSuite) TestChangePassword() {
 					NewPassword:     "newpassword",
 				},
 			},
-			setupMock: func(userRepo *mocks.UserRepository, mediaSvc *mocks.MediaService, a args) {
-				userRepo.EXPECT().GetByID(mock.Anything, a.input.UserID).Return(&domain.User{PasswordHash: hashedPassword}, nil).Once()
+			setupMock: func(userRepo *mocks.UserRepository, tokenSvc *mocks.TokenService, a args) {
+				userRepo.EXPECT().GetByID(mock.Anything, a.input.UserID).Return(&domain.User{ID: userID, PasswordHash: hashedPassword}, nil).Once()
 				
 				userRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(u *domain.User) bool {
 					return verifyPassword(a.input.NewPassword, u.PasswordHash)
 				})).Return(nil).Once()
+				tokenSvc.EXPECT().RevokeAllUserSessions(mock.Anything, a.input.UserID).Return(nil).Once()
 			},
 			wantErr: nil,
 		},
@@ -644,11 +696,11 @@ func (s *userServiceSuite) TestChangePassword() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			userRepo := mocks.NewUserRepository(s.T())
-			mediaSvc := mocks.NewMediaService(s.T())
-			userSvc := NewUserService(userRepo, mediaSvc)
+			tokenSvc := mocks.NewTokenService(s.T())
+			userSvc := NewUserService(userRepo, tokenSvc, mocks.NewMediaService(s.T()), nil, config.DeletionConfig{})
 
 			if tc.setupMock != nil {
-				tc.setupMock(userRepo, mediaSvc, tc.args)
+				tc.setupMock(userRepo, tokenSvc, tc.args)
 			}
 
 			err := userSvc.ChangePassword(context.Background(), tc.args.input)
@@ -674,13 +726,13 @@ func (s *userServiceSuite) TestUpdatePassword() {
 	tests := []struct {
 		name      string
 		args      args
-		setupMock func(userRepo *mocks.UserRepository, mediaSvc *mocks.MediaService, a args)
+		setupMock func(userRepo *mocks.UserRepository, tokenSvc *mocks.TokenService, a args)
 		wantErr   error
 	}{
 		{
 			name: "user_not_found",
 			args: args{email: email, passwordHashed: newHash},
-			setupMock: func(userRepo *mocks.UserRepository, mediaSvc *mocks.MediaService, a args) {
+			setupMock: func(userRepo *mocks.UserRepository, tokenSvc *mocks.TokenService, a args) {
 				userRepo.EXPECT().GetByEmail(mock.Anything, a.email).Return(nil, pkg.ErrNotFound).Once()
 			},
 			wantErr: pkg.ErrNotFound,
@@ -688,12 +740,13 @@ func (s *userServiceSuite) TestUpdatePassword() {
 		{
 			name: "success",
 			args: args{email: email, passwordHashed: newHash},
-			setupMock: func(userRepo *mocks.UserRepository, mediaSvc *mocks.MediaService, a args) {
-				userRepo.EXPECT().GetByEmail(mock.Anything, a.email).Return(&domain.User{Email: email}, nil).Once()
+			setupMock: func(userRepo *mocks.UserRepository, tokenSvc *mocks.TokenService, a args) {
+				userRepo.EXPECT().GetByEmail(mock.Anything, a.email).Return(&domain.User{ID: 1, Email: email}, nil).Once()
 				
 				userRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(u *domain.User) bool {
 					return u.PasswordHash == a.passwordHashed
 				})).Return(nil).Once()
+				tokenSvc.EXPECT().RevokeAllUserSessions(mock.Anything, int64(1)).Return(nil).Once()
 			},
 			wantErr: nil,
 		},
@@ -702,11 +755,11 @@ func (s *userServiceSuite) TestUpdatePassword() {
 	for _, tc := range tests {
 		s.Run(tc.name, func() {
 			userRepo := mocks.NewUserRepository(s.T())
-			mediaSvc := mocks.NewMediaService(s.T())
-			userSvc := NewUserService(userRepo, mediaSvc)
+			tokenSvc := mocks.NewTokenService(s.T())
+			userSvc := NewUserService(userRepo, tokenSvc, mocks.NewMediaService(s.T()), nil, config.DeletionConfig{})
 
 			if tc.setupMock != nil {
-				tc.setupMock(userRepo, mediaSvc, tc.args)
+				tc.setupMock(userRepo, tokenSvc, tc.args)
 			}
 
 			err := userSvc.UpdatePassword(context.Background(), tc.args.email, tc.args.passwordHashed)
@@ -759,7 +812,7 @@ func (s *userServiceSuite) TestVerifyEmail() {
 		s.Run(tc.name, func() {
 			userRepo := mocks.NewUserRepository(s.T())
 			mediaSvc := mocks.NewMediaService(s.T())
-			userSvc := NewUserService(userRepo, mediaSvc)
+			userSvc := NewUserService(userRepo, nil, mediaSvc, nil, config.DeletionConfig{})
 
 			if tc.setupMock != nil {
 				tc.setupMock(userRepo, mediaSvc, tc.args)
@@ -776,6 +829,122 @@ func (s *userServiceSuite) TestVerifyEmail() {
 	}
 }
 
+func (s *userServiceSuite) TestChangeEmail() {
+	tests := []struct {
+		name    string
+		repoErr error
+		wantErr error
+	}{
+		{name: "success"},
+		{name: "email_changed_meanwhile", repoErr: pkg.ErrNotFound, wantErr: pkg.ErrNotFound},
+		{name: "email_taken", repoErr: pkg.ErrAlreadyExists, wantErr: pkg.ErrEmailTaken},
+		{name: "repo_error", repoErr: assert.AnError, wantErr: pkg.ErrInternal},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			userRepo := mocks.NewUserRepository(s.T())
+			userSvc := NewUserService(userRepo, nil, nil, nil, config.DeletionConfig{})
+			userRepo.EXPECT().UpdateEmail(mock.Anything, int64(1), "old@example.com", "new@example.com").Return(tc.repoErr).Once()
+
+			err := userSvc.ChangeEmail(context.Background(), 1, "old@example.com", "new@example.com")
+
+			if tc.wantErr != nil {
+				s.ErrorIs(err, tc.wantErr)
+			} else {
+				s.NoError(err)
+			}
+		})
+	}
+}
+
+func (s *userServiceSuite) TestDeleteAccount() {
+	hashedPwd, _ := hashPassword("password123")
+	user := &domain.User{ID: 1, PasswordHash: hashedPwd}
+	cfg := config.DeletionConfig{GracePeriod: 30 * 24 * time.Hour}
+
+	s.Run("wrong_password", func() {
+		userRepo := mocks.NewUserRepository(s.T())
+		userSvc := NewUserService(userRepo, mocks.NewTokenService(s.T()), nil, nil, cfg)
+		userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+
+		_, err := userSvc.DeleteAccount(context.Background(), domain.DeleteAccountParams{UserID: 1, Password: "wrong"})
+		s.ErrorIs(err, pkg.ErrInvalidCredentials)
+	})
+
+	s.Run("success", func() {
+		userRepo := mocks.NewUserRepository(s.T())
+		tokenSvc := mocks.NewTokenService(s.T())
+		userSvc := NewUserService(userRepo, tokenSvc, nil, nil, cfg)
+
+		var scheduled time.Time
+		userRepo.EXPECT().GetByID(mock.Anything, user.ID).Return(user, nil).Once()
+		userRepo.EXPECT().ScheduleDeletion(mock.Anything, user.ID, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ int64, at *time.Time) error {
+				s.Require().NotNil(at)
+				scheduled = *at
+				return nil
+			}).Once()
+		tokenSvc.EXPECT().RevokeAllUserSessions(mock.Anything, user.ID).Return(nil).Once()
+
+		got, err := userSvc.DeleteAccount(context.Background(), domain.DeleteAccountParams{UserID: 1, Password: "password123"})
+
+		s.Require().NoError(err)
+		s.Equal(scheduled, got.DeleteAfter)
+		s.WithinDuration(time.Now().Add(cfg.GracePeriod), got.DeleteAfter, time.Minute)
+	})
+}
+
+func (s *userServiceSuite) TestPurgeDeletedAccounts() {
+	cfg := config.DeletionConfig{PurgeBatchSize: 2}
+
+	s.Run("purges_in_batches", func() {
+		userRepo := mocks.NewUserRepository(s.T())
+		mediaSvc := mocks.NewMediaService(s.T())
+		reactions := mocks.NewReactionCounter(s.T())
+		userSvc := NewUserService(userRepo, nil, mediaSvc, reactions, cfg)
+
+		reacted := []domain.ReactionTarget{{Type: domain.ReactionTargetPost, ID: 7}, {Type: domain.ReactionTargetComment, ID: 8}}
+
+		userRepo.EXPECT().ListDueForDeletion(mock.Anything, mock.Anything, 2).Return([]int64{1, 2}, nil).Once()
+		userRepo.EXPECT().Purge(mock.Anything, int64(1)).Return(&domain.PurgedUser{
+			ObjectKeys: []string{"posts/1/feed_image/a.jpg", "users/1/avatar/b.jpg"},
+			Reactions:  reacted,
+		}, nil).Once()
+		// A failed delete only leaves the object behind.
+		mediaSvc.EXPECT().DeleteFile(mock.Anything, "posts/1/feed_image/a.jpg").Return(assert.AnError).Once()
+		mediaSvc.EXPECT().DeleteFile(mock.Anything, "users/1/avatar/b.jpg").Return(nil).Once()
+		reactions.EXPECT().MarkDirty(mock.Anything, reacted).Return(nil).Once()
+		// User 2 logged in since.
+		userRepo.EXPECT().Purge(mock.Anything, int64(2)).Return(nil, pkg.ErrNotFound).Once()
+		userRepo.EXPECT().ListDueForDeletion(mock.Anything, mock.Anything, 2).Return([]int64{3}, nil).Once()
+		userRepo.EXPECT().Purge(mock.Anything, int64(3)).Return(&domain.PurgedUser{}, nil).Once()
+		// A failed mark is only logged; the counters are rebuilt once they expire.
+		reactions.EXPECT().MarkDirty(mock.Anything, []domain.ReactionTarget(nil)).Return(assert.AnError).Once()
+
+		s.NoError(userSvc.PurgeDeletedAccounts(context.Background()))
+	})
+
+	s.Run("purge_error", func() {
+		userRepo := mocks.NewUserRepository(s.T())
+		userSvc := NewUserService(userRepo, nil, nil, nil, cfg)
+
+		userRepo.EXPECT().ListDueForDeletion(mock.Anything, mock.Anything, 2).Return([]int64{1, 2}, nil).Once()
+		userRepo.EXPECT().Purge(mock.Anything, int64(1)).Return(nil, assert.AnError).Once()
+
+		s.ErrorIs(userSvc.PurgeDeletedAccounts(context.Background()), pkg.ErrInternal)
+	})
+
+	s.Run("list_error", func() {
+		userRepo := mocks.NewUserRepository(s.T())
+		userSvc := NewUserService(userRepo, nil, nil, nil, cfg)
+
+		userRepo.EXPECT().ListDueForDeletion(mock.Anything, mock.Anything, 2).Return(nil, assert.AnError).Once()
+
+		s.ErrorIs(userSvc.PurgeDeletedAccounts(context.Background()), pkg.ErrInternal)
+	})
+}
+
 func (s *userServiceSuite) TestConfirmImageUpload() {
 	userID := int64(1)
 	objectKey := "user/1/avatar/image.jpg"
@@ -843,7 +1012,7 @@ func (s *userServiceSuite) TestConfirmImageUpload() {
 		s.Run(tc.name, func() {
 			userRepo := mocks.NewUserRepository(s.T())
 			mediaSvc := mocks.NewMediaService(s.T())
-			userSvc := NewUserService(userRepo, mediaSvc)
+			userSvc := NewUserService(userRepo, nil, mediaSvc, nil, config.DeletionConfig{})
 
 			if tc.setupMock != nil {
 				tc.setupMock(userRepo, mediaSvc, tc.args)
@@ -0,0 +1,443 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"air-social/internal/domain"
+	"air-social/internal/service"
+	"air-social/internal/transport/http/middleware"
+	"air-social/pkg"
+)
+
+type AdminHandler struct {
+	adminSvc service.AdminService
+}
+
+func NewAdminHandler(adminSvc service.AdminService) *AdminHandler {
+	return &AdminHandler{
+		adminSvc: adminSvc,
+	}
+}
+
+// SearchUsers godoc
+//
+//	@Summary		Search users
+//	@Description	Search users by part of their email, username or full name, newest first (users:read)
+//	@Tags			Admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			q		query		string	false	"Part of the email, username or full name"
+//	@Param			role	query		string	false	"Role"	Enums(user, moderator, admin)
+//	@Param			status	query		string	false	"Status"	Enums(active, suspended, banned)
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Param			limit	query		int		false	"Page size (1-100, default 20)"
+//	@Success		200		{object}	domain.Page{items=[]domain.AdminUserResponse}
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/admin/users [get]
+func (h *AdminHandler) SearchUsers(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	var req domain.AdminSearchUsersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.AdminSearchUsersParams{
+		Actor:  actorOf(claims),
+		Query:  req.Query,
+		Role:   req.Role,
+		Status: req.Status,
+		Page:   req.ToParams(),
+	}
+
+	page, err := h.adminSvc.SearchUsers(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, page)
+}
+
+// GetUser godoc
+//
+//	@Summary		Get a user
+//	@Description	Get a user with the account status (users:read)
+//	@Tags			Admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"User ID"
+//	@Success		200	{object}	domain.AdminUserResponse
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		403	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/admin/users/{id} [get]
+func (h *AdminHandler) GetUser(c *gin.Context) {
+	h.userAction(c, h.adminSvc.GetUser)
+}
+
+// ListUserSessions godoc
+//
+//	@Summary		List the sessions of a user
+//	@Description	List the devices the user is signed in on (users:read)
+//	@Tags			Admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"User ID"
+//	@Success		200	{array}		domain.SessionResponse
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		403	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/admin/users/{id}/sessions [get]
+func (h *AdminHandler) ListUserSessions(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	userID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	params := domain.AdminUserParams{Actor: actorOf(claims), UserID: userID}
+
+	sessions, err := h.adminSvc.ListUserSessions(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, sessions)
+}
+
+// ForceLogout godoc
+//
+//	@Summary		Sign a user out everywhere
+//	@Description	Revoke every session of the user and close their realtime connections (users:manage, outranking the user)
+//	@Tags			Admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"User ID"
+//	@Success		200	{object}	pkg.Response
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		403	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/admin/users/{id}/logout [post]
+func (h *AdminHandler) ForceLogout(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	userID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	params := domain.AdminUserParams{Actor: actorOf(claims), UserID: userID}
+
+	if err := h.adminSvc.ForceLogout(c.Request.Context(), params); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "user logged out from all devices")
+}
+
+// Suspend godoc
+//
+//	@Summary		Suspend a user
+//	@Description	Keep the user from signing in until the given time and sign them out everywhere (users:moderate, outranking the user)
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int							true	"User ID"
+//	@Param			request	body		domain.SuspendUserRequest	true	"Suspend User Request"
+//	@Success		200		{object}	domain.AdminUserResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		409		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/admin/users/{id}/suspend [post]
+func (h *AdminHandler) Suspend(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	userID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.SuspendUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.SuspendUserParams{
+		Actor:  actorOf(claims),
+		UserID: userID,
+		Until:  req.Until,
+		Reason: req.Reason,
+	}
+
+	user, err := h.adminSvc.Suspend(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, user)
+}
+
+// Ban godoc
+//
+//	@Summary		Ban a user
+//	@Description	Keep the user from signing in until the ban is lifted and sign them out everywhere (users:manage, outranking the user)
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int						true	"User ID"
+//	@Param			request	body		domain.BanUserRequest	true	"Ban User Request"
+//	@Success		200		{object}	domain.AdminUserResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		409		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/admin/users/{id}/ban [post]
+func (h *AdminHandler) Ban(c *gin.Context) {
+	var req domain.BanUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+	h.moderate(c, h.adminSvc.Ban, req.Reason)
+}
+
+// Unban godoc
+//
+//	@Summary		Lift a ban or suspension
+//	@Description	Let the user sign in again (users:manage, outranking the user)
+//	@Tags			Admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int						true	"User ID"
+//	@Param			request	body		domain.UnbanUserRequest	true	"Unban User Request"
+//	@Success		200		{object}	domain.AdminUserResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		409		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/admin/users/{id}/unban [post]
+func (h *AdminHandler) Unban(c *gin.Context) {
+	var req domain.UnbanUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+	h.moderate(c, h.adminSvc.Unban, req.Reason)
+}
+
+// VerifyEmail godoc
+//
+//	@Summary		Verify the email of a user
+//	@Description	Mark the email address of the user as verified (users:manage, outranking the user)
+//	@Tags			Admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"User ID"
+//	@Success		200	{object}	domain.AdminUserResponse
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		403	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/admin/users/{id}/verify-email [post]
+func (h *AdminHandler) VerifyEmail(c *gin.Context) {
+	h.userAction(c, h.adminSvc.VerifyEmail)
+}
+
+// ResetProfileImage godoc
+//
+//	@Summary		Reset the avatar or cover of a user
+//	@Description	Remove the avatar or cover image of the user and delete the file (users:moderate, outranking the user)
+//	@Tags			Admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int		true	"User ID"
+//	@Param			feature	query		string	true	"Image to reset"	Enums(avatar, cover)
+//	@Success		200		{object}	domain.AdminUserResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/admin/users/{id}/profile-image [delete]
+func (h *AdminHandler) ResetProfileImage(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	userID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.ResetProfileImageRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ResetProfileImageParams{
+		Actor:   actorOf(claims),
+		UserID:  userID,
+		Feature: req.Feature,
+	}
+
+	user, err := h.adminSvc.ResetProfileImage(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, user)
+}
+
+// ListAuditLogs godoc
+//
+//	@Summary		List the audit log
+//	@Description	List admin actions, newest first, optionally by actor or target user (users:manage)
+//	@Tags			Admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			actor_id	query		int		false	"Staff member who acted"
+//	@Param			user_id		query		int		false	"User acted on"
+//	@Param			cursor		query		string	false	"Cursor from the previous page"
+//	@Param			limit		query		int		false	"Page size (1-100, default 20)"
+//	@Success		200			{object}	domain.Page{items=[]domain.AuditLogResponse}
+//	@Failure		400			{object}	pkg.Response
+//	@Failure		401			{object}	pkg.Response
+//	@Failure		403			{object}	pkg.Response
+//	@Failure		500			{object}	pkg.Response
+//	@Router			/admin/audit-logs [get]
+func (h *AdminHandler) ListAuditLogs(c *gin.Context) {
+	var req domain.ListAuditLogsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ListAuditLogsParams{
+		ActorID:      req.ActorID,
+		TargetUserID: req.TargetUserID,
+		Page:         req.ToParams(),
+	}
+
+	page, err := h.adminSvc.ListAuditLogs(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, page)
+}
+
+// Internal helpers
+
+func actorOf(claims *domain.AuthClaims) domain.AdminActor {
+	return domain.AdminActor{ID: claims.UserID, Role: claims.Role}
+}
+
+// userAction runs an action of the caller on the :id user and responds with
+// the user.
+func (h *AdminHandler) userAction(
+	c *gin.Context,
+	action func(ctx context.Context, input domain.AdminUserParams) (domain.AdminUserResponse, error),
+) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	userID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	params := domain.AdminUserParams{Actor: actorOf(claims), UserID: userID}
+
+	user, err := action(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, user)
+}
+
+// moderate bans or unbans the :id user for reason and responds with the user.
+func (h *AdminHandler) moderate(
+	c *gin.Context,
+	action func(ctx context.Context, input domain.ModerateUserParams) (domain.AdminUserResponse, error),
+	reason string,
+) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	userID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	params := domain.ModerateUserParams{
+		Actor:  actorOf(claims),
+		UserID: userID,
+		Reason: reason,
+	}
+
+	user, err := action(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, user)
+}
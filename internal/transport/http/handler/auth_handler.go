@@ -30,6 +30,7 @@ func NewAuthHandler(authSvc service.AuthService) *AuthHandler {
 //	@Success		200		{object}	domain.UserResponse
 //	@Failure		400		{object}	pkg.ValidationResult
 //	@Failure		409		{object}	pkg.Response
+//	@Failure		429		{object}	pkg.Response	"Rate limited, see Retry-After"
 //	@Failure		500		{object}	pkg.Response
 //	@Router			/auth/register [post]
 func (h *AuthHandler) Register(c *gin.Context) {
@@ -57,7 +58,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 // Login godoc
 //
 //	@Summary		Login user
-//	@Description	Authenticate user credentials. Returns a JWT Access Token and a Refresh Token.
+//	@Description	Authenticate user credentials. Returns a JWT Access Token and a Refresh Token, or a challenge to complete at /auth/2fa/verify when the account has two-factor authentication on.
 //	@Tags			Auth
 //	@Accept			json
 //	@Produce		json
@@ -65,6 +66,9 @@ func (h *AuthHandler) Register(c *gin.Context) {
 //	@Success		200		{object}	domain.LoginResponse	"Returns user info and tokens"
 //	@Failure		400		{object}	pkg.ValidationResult
 //	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response	"Account suspended or banned"
+//	@Failure		423		{object}	pkg.Response	"Account locked after too many failed logins"
+//	@Failure		429		{object}	pkg.Response	"Rate limited, or delayed after failed logins"
 //	@Failure		500		{object}	pkg.Response
 //	@Router			/auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
@@ -75,9 +79,12 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	params := domain.LoginParams{
-		Email:    req.Email,
-		Password: req.Password,
-		DeviceID: req.DeviceID,
+		Email:      req.Email,
+		Password:   req.Password,
+		DeviceID:   req.DeviceID,
+		DeviceName: req.DeviceName,
+		UserAgent:  c.Request.UserAgent(),
+		IP:         c.ClientIP(),
 	}
 
 	res, err := h.authSvc.Login(c.Request.Context(), params)
@@ -89,6 +96,173 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	pkg.Success(c, res)
 }
 
+// VerifyTwoFactor godoc
+//
+//	@Summary		Complete a two-factor login
+//	@Description	Finish a login that returned a two-factor challenge, with a code from the authenticator app or a recovery code. The challenge ends after too many wrong codes.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		domain.VerifyTwoFactorRequest	true	"Verify Request"
+//	@Success		200		{object}	domain.LoginResponse			"Returns user info and tokens"
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response	"Wrong code, or unknown or expired challenge"
+//	@Failure		403		{object}	pkg.Response	"Account suspended or banned"
+//	@Failure		423		{object}	pkg.Response	"Account locked after too many failed logins"
+//	@Failure		429		{object}	pkg.Response	"Rate limited, or delayed after failed logins"
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/auth/2fa/verify [post]
+func (h *AuthHandler) VerifyTwoFactor(c *gin.Context) {
+	var req domain.VerifyTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.VerifyTwoFactorParams{
+		ChallengeToken: req.ChallengeToken,
+		Code:           req.Code,
+	}
+
+	res, err := h.authSvc.VerifyTwoFactor(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, res)
+}
+
+// OAuthAuthorize godoc
+//
+//	@Summary		Start a login with a provider
+//	@Description	Start signing in with an OpenID Connect provider such as Google. Send the user to the returned URL; the provider sends them back to the redirect URL of the app with a code and state, which go to the callback.
+//	@Tags			Auth
+//	@Produce		json
+//	@Param			provider	path		string	true	"Provider name, e.g. google"
+//	@Success		200			{object}	domain.OAuthAuthorizeResponse
+//	@Failure		404			{object}	pkg.Response	"Unknown provider"
+//	@Failure		429			{object}	pkg.Response
+//	@Failure		500			{object}	pkg.Response
+//	@Failure		503			{object}	pkg.Response	"Provider unavailable"
+//	@Router			/auth/oauth/{provider}/authorize [post]
+func (h *AuthHandler) OAuthAuthorize(c *gin.Context) {
+	res, err := h.authSvc.StartOAuth(c.Request.Context(), c.Param(paramProvider))
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, res)
+}
+
+// OAuthCallback godoc
+//
+//	@Summary		Complete a login with a provider
+//	@Description	Sign in with the code and state the provider sent back. The first login creates an account, or links the one with the same verified email. Accounts with two-factor authentication on get a challenge as with /auth/login.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			provider	path		string						true	"Provider name, e.g. google"
+//	@Param			request		body		domain.OAuthCallbackRequest	true	"Callback Request"
+//	@Success		200			{object}	domain.LoginResponse		"Returns user info and tokens"
+//	@Failure		400			{object}	pkg.ValidationResult
+//	@Failure		401			{object}	pkg.Response	"Unknown or used state, or code refused by the provider"
+//	@Failure		403			{object}	pkg.Response	"Email not verified by the provider, or account suspended or banned"
+//	@Failure		404			{object}	pkg.Response	"Unknown provider"
+//	@Failure		409			{object}	pkg.Response	"An unverified account has the email"
+//	@Failure		429			{object}	pkg.Response
+//	@Failure		500			{object}	pkg.Response
+//	@Failure		503			{object}	pkg.Response	"Provider unavailable"
+//	@Router			/auth/oauth/{provider}/callback [post]
+func (h *AuthHandler) OAuthCallback(c *gin.Context) {
+	var req domain.OAuthCallbackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.OAuthLoginParams{
+		Provider:   c.Param(paramProvider),
+		Code:       req.Code,
+		State:      req.State,
+		DeviceID:   req.DeviceID,
+		DeviceName: req.DeviceName,
+		UserAgent:  c.Request.UserAgent(),
+		IP:         c.ClientIP(),
+	}
+
+	res, err := h.authSvc.OAuthLogin(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, res)
+}
+
+// RequestMagicLink godoc
+//
+//	@Summary		Request a sign-in link
+//	@Description	Email a single-use link that signs in without a password, for the device that asked. Asking again voids the link sent before.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		domain.MagicLinkRequest	true	"Magic Link Request"
+//	@Success		200		{string}	string					"Instruction message"
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		429		{object}	pkg.Response	"Rate limited, see Retry-After"
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/auth/magic-link [post]
+func (h *AuthHandler) RequestMagicLink(c *gin.Context) {
+	var req domain.MagicLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	h.authSvc.RequestMagicLink(c.Request.Context(), domain.MagicLinkParams{
+		Email:      req.Email,
+		DeviceID:   req.DeviceID,
+		DeviceName: req.DeviceName,
+	})
+
+	pkg.Success(c, "If the email exists, we have sent a link to sign in.")
+}
+
+// MagicLinkLogin godoc
+//
+//	@Summary		Sign in with a link
+//	@Description	Sign in with the token of an emailed sign-in link, opening a session for the device that asked for it. The link works once.
+//	@Tags			Auth
+//	@Produce		json
+//	@Param			token	query		string					true	"Random Sign-in Token"
+//	@Success		200		{object}	domain.LoginResponse	"Returns user info and tokens"
+//	@Failure		401		{object}	pkg.Response			"Unknown, used or replaced link"
+//	@Failure		403		{object}	pkg.Response			"Account suspended or banned"
+//	@Failure		429		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/auth/magic-link [get]
+func (h *AuthHandler) MagicLinkLogin(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		pkg.HandleServiceError(c, pkg.ErrUnauthorized)
+		return
+	}
+
+	res, err := h.authSvc.MagicLinkLogin(c.Request.Context(), domain.MagicLinkLoginParams{
+		Token:     token,
+		UserAgent: c.Request.UserAgent(),
+		IP:        c.ClientIP(),
+	})
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, res)
+}
+
 // Refresh godoc
 //
 //	@Summary		Refresh access token
@@ -109,7 +283,13 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 		return
 	}
 
-	res, err := h.authSvc.RefreshToken(c.Request.Context(), req.RefreshToken)
+	params := domain.RefreshParams{
+		RefreshToken: req.RefreshToken,
+		UserAgent:    c.Request.UserAgent(),
+		IP:           c.ClientIP(),
+	}
+
+	res, err := h.authSvc.RefreshToken(c.Request.Context(), params)
 	if err != nil {
 		pkg.HandleServiceError(c, err)
 		return
@@ -121,7 +301,7 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 // Logout godoc
 //
 //	@Summary		Logout user
-//	@Description	Revoke current device session or all sessions
+//	@Description	Revoke current device session or all sessions. The access token of the request stops working at once; logging out of all devices also revokes every other access token of the user and closes their realtime connections.
 //	@Tags			Auth
 //	@Accept			json
 //	@Produce		json
@@ -142,9 +322,11 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 	}
 
 	params := domain.LogoutParams{
-		UserID:       claims.UserID,
-		DeviceID:     claims.DeviceID,
-		IsAllDevices: req.IsAllDevices,
+		UserID:         claims.UserID,
+		DeviceID:       claims.DeviceID,
+		IsAllDevices:   req.IsAllDevices,
+		TokenID:        claims.TokenID,
+		TokenExpiresAt: claims.ExpiresAt,
 	}
 
 	if err := h.authSvc.Logout(c.Request.Context(), params); err != nil {
@@ -179,6 +361,153 @@ func (h *AuthHandler) VerifyEmail(c *gin.Context) {
 	c.HTML(200, "verification.gohtml", gin.H{"Success": true})
 }
 
+// ResendVerification godoc
+//
+//	@Summary		Resend verification email
+//	@Description	Send another email verification link to the current user. Links expire after 30 minutes.
+//	@Tags			Auth
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Success		200	{string}	string			"Instruction message"
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		409	{object}	pkg.Response	"Email already verified"
+//	@Failure		429	{object}	pkg.Response	"Rate limited, see Retry-After"
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/auth/verify-email/resend [post]
+func (h *AuthHandler) ResendVerification(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	if err := h.authSvc.ResendVerification(c.Request.Context(), claims.UserID); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "We have sent a new verification link to your email.")
+}
+
+// ChangeEmail godoc
+//
+//	@Summary		Change email
+//	@Description	Start moving the account to a new email address. The new address gets a link that makes the change, valid for 30 minutes, and the old one a notice with a link that cancels it. Asking again voids the links sent before.
+//	@Tags			User
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		domain.ChangeEmailRequest	true	"Change Email Request"
+//	@Success		200		{string}	string						"Instruction message"
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		400		{object}	pkg.Response	"Same email as now"
+//	@Failure		401		{object}	pkg.Response	"Wrong password"
+//	@Failure		409		{object}	pkg.Response	"Email already in use"
+//	@Failure		429		{object}	pkg.Response	"Rate limited, see Retry-After"
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/users/me/email [post]
+func (h *AuthHandler) ChangeEmail(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	var req domain.ChangeEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ChangeEmailParams{
+		UserID:          claims.UserID,
+		NewEmail:        req.NewEmail,
+		CurrentPassword: req.CurrentPassword,
+	}
+
+	if err := h.authSvc.RequestEmailChange(c.Request.Context(), params); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "We have sent a link to the new address to confirm the change.")
+}
+
+// ConfirmEmailChange godoc
+//
+//	@Summary		Confirm email change
+//	@Description	Move the account to the new email address, using the random token sent to it.
+//	@Tags			Auth
+//	@Produce		html
+//	@Param			token	query		string	true	"Random Confirm Token"
+//	@Success		200		{string}	string	"HTML Page"
+//	@Failure		400		{string}	string	"HTML Page"
+//	@Router			/auth/email-change/confirm [get]
+func (h *AuthHandler) ConfirmEmailChange(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.HTML(400, "email_change_confirm.gohtml", gin.H{"Success": false})
+		return
+	}
+
+	if err := h.authSvc.ConfirmEmailChange(c.Request.Context(), token); err != nil {
+		c.HTML(400, "email_change_confirm.gohtml", gin.H{"Success": false})
+		return
+	}
+
+	c.HTML(200, "email_change_confirm.gohtml", gin.H{"Success": true})
+}
+
+// CancelEmailChange godoc
+//
+//	@Summary		Cancel email change
+//	@Description	Cancel a pending email change, using the random token sent to the old address.
+//	@Tags			Auth
+//	@Produce		html
+//	@Param			token	query		string	true	"Random Cancel Token"
+//	@Success		200		{string}	string	"HTML Page"
+//	@Failure		400		{string}	string	"HTML Page"
+//	@Router			/auth/email-change/cancel [get]
+func (h *AuthHandler) CancelEmailChange(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.HTML(400, "email_change_cancel.gohtml", gin.H{"Success": false})
+		return
+	}
+
+	if err := h.authSvc.CancelEmailChange(c.Request.Context(), token); err != nil {
+		c.HTML(400, "email_change_cancel.gohtml", gin.H{"Success": false})
+		return
+	}
+
+	c.HTML(200, "email_change_cancel.gohtml", gin.H{"Success": true})
+}
+
+// UnlockAccount godoc
+//
+//	@Summary		Unlock account
+//	@Description	Lift the lockout of an account using the random token sent when it was locked after too many failed logins.
+//	@Tags			Auth
+//	@Produce		html
+//	@Param			token	query		string	true	"Random Unlock Token"
+//	@Success		200		{string}	string	"HTML Page"
+//	@Failure		400		{string}	string	"HTML Page"
+//	@Router			/auth/unlock-account [get]
+func (h *AuthHandler) UnlockAccount(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.HTML(400, "unlock_account.gohtml", gin.H{"Success": false})
+		return
+	}
+
+	if err := h.authSvc.UnlockAccount(c.Request.Context(), token); err != nil {
+		c.HTML(400, "unlock_account.gohtml", gin.H{"Success": false})
+		return
+	}
+
+	c.HTML(200, "unlock_account.gohtml", gin.H{"Success": true})
+}
+
 // ForgotPassword godoc
 //
 //	@Summary		Request password reset
@@ -189,6 +518,7 @@ func (h *AuthHandler) VerifyEmail(c *gin.Context) {
 //	@Param			request	body		domain.ForgotPasswordRequest	true	"Forgot Password Request"
 //	@Success		200		{string}	string							"Instruction message"
 //	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		429		{object}	pkg.Response	"Rate limited, see Retry-After"
 //	@Failure		500		{object}	pkg.Response
 //	@Router			/auth/forgot-password [post]
 func (h *AuthHandler) ForgotPassword(c *gin.Context) {
@@ -0,0 +1,612 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"air-social/internal/domain"
+	"air-social/internal/service"
+	"air-social/internal/transport/http/middleware"
+	"air-social/pkg"
+)
+
+type ChatHandler struct {
+	chatSvc service.ChatService
+}
+
+func NewChatHandler(chatSvc service.ChatService) *ChatHandler {
+	return &ChatHandler{
+		chatSvc: chatSvc,
+	}
+}
+
+// CreateDirect godoc
+//
+//	@Summary		Open a direct conversation
+//	@Description	Return the 1:1 conversation with another user, creating it on first use
+//	@Tags			Chat
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		domain.CreateDirectConversationRequest	true	"Create Direct Conversation Request"
+//	@Success		200		{object}	domain.ConversationResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response	"Email not verified"
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/conversations/direct [post]
+func (h *ChatHandler) CreateDirect(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	var req domain.CreateDirectConversationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	conv, err := h.chatSvc.GetOrCreateDirect(c.Request.Context(), claims.UserID, req.UserID)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, conv)
+}
+
+// List godoc
+//
+//	@Summary		List conversations
+//	@Description	List the conversations of the current user, most recently active first
+//	@Tags			Chat
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Param			limit	query		int		false	"Page size (1-100, default 20)"
+//	@Success		200		{object}	domain.Page{items=[]domain.ConversationResponse}
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/conversations [get]
+func (h *ChatHandler) List(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	var req domain.PageRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ListConversationsParams{
+		UserID: claims.UserID,
+		Page:   req.ToParams(),
+	}
+
+	page, err := h.chatSvc.ListConversations(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, page)
+}
+
+// Get godoc
+//
+//	@Summary		Get a conversation
+//	@Description	Get a conversation of the current user with its members and last message
+//	@Tags			Chat
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"Conversation ID"
+//	@Success		200	{object}	domain.ConversationResponse
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/conversations/{id} [get]
+func (h *ChatHandler) Get(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	convID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	conv, err := h.chatSvc.GetConversation(c.Request.Context(), claims.UserID, convID)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, conv)
+}
+
+// SendMessage godoc
+//
+//	@Summary		Send a message
+//	@Description	Send a text message, optionally with an image ("feed_image") or voice ("voice_chat") attachment uploaded to the messages domain. Members receive it over the WebSocket as "message.new".
+//	@Tags			Chat
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int							true	"Conversation ID"
+//	@Param			request	body		domain.SendMessageRequest	true	"Send Message Request"
+//	@Success		201		{object}	domain.MessageResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/conversations/{id}/messages [post]
+func (h *ChatHandler) SendMessage(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	convID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.SendMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.SendMessageParams{
+		UserID:         claims.UserID,
+		ConversationID: convID,
+		Content:        req.Content,
+		Attachment:     req.Attachment,
+	}
+
+	msg, err := h.chatSvc.SendMessage(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Created(c, msg)
+}
+
+// ListMessages godoc
+//
+//	@Summary		List messages of a conversation
+//	@Description	Page through the history newest first. Pass "after_seq" instead of a cursor to fetch the messages after a known sequence number, oldest first, e.g. after detecting a gap; repeat with the last returned seq while has_more is true.
+//	@Tags			Chat
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		int		true	"Conversation ID"
+//	@Param			cursor		query		string	false	"Cursor from the previous page"
+//	@Param			limit		query		int		false	"Page size (1-100, default 20)"
+//	@Param			after_seq	query		int		false	"Return messages after this sequence number, oldest first"
+//	@Success		200			{object}	domain.Page{items=[]domain.MessageResponse}
+//	@Failure		400			{object}	pkg.Response
+//	@Failure		401			{object}	pkg.Response
+//	@Failure		404			{object}	pkg.Response
+//	@Failure		500			{object}	pkg.Response
+//	@Router			/conversations/{id}/messages [get]
+func (h *ChatHandler) ListMessages(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	convID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.ListMessagesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ListMessagesParams{
+		UserID:         claims.UserID,
+		ConversationID: convID,
+		AfterSeq:       req.AfterSeq,
+		Page:           req.ToParams(),
+	}
+
+	page, err := h.chatSvc.ListMessages(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, page)
+}
+
+// CreateGroup godoc
+//
+//	@Summary		Create a group conversation
+//	@Description	Create a group owned by the current user with the given members. The history starts with system messages recording the creation and each added member.
+//	@Tags			Chat
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		domain.CreateGroupChatRequest	true	"Create Group Chat Request"
+//	@Success		201		{object}	domain.ConversationResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response	"Email not verified"
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/conversations/groups [post]
+func (h *ChatHandler) CreateGroup(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	var req domain.CreateGroupChatRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.CreateGroupChatParams{
+		UserID:    claims.UserID,
+		Title:     req.Title,
+		MemberIDs: req.MemberIDs,
+	}
+
+	conv, err := h.chatSvc.CreateGroup(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Created(c, conv)
+}
+
+// UpdateGroup godoc
+//
+//	@Summary		Update a group conversation
+//	@Description	Rename the group and/or set its avatar (owner or admin). The avatar is uploaded through the presigned flow with domain "messages" and feature "avatar"; an empty string removes it.
+//	@Tags			Chat
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int							true	"Conversation ID"
+//	@Param			request	body		domain.UpdateGroupChatRequest	true	"Update Group Chat Request"
+//	@Success		200		{object}	domain.ConversationResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/conversations/{id} [patch]
+func (h *ChatHandler) UpdateGroup(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	convID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateGroupChatRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.UpdateGroupChatParams{
+		UserID:         claims.UserID,
+		ConversationID: convID,
+		Title:          req.Title,
+		Avatar:         req.Avatar,
+	}
+
+	conv, err := h.chatSvc.UpdateGroup(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, conv)
+}
+
+// AddMembers godoc
+//
+//	@Summary		Add members to a group
+//	@Description	Add users to the group as members (owner or admin). Users already in the group are skipped.
+//	@Tags			Chat
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int						true	"Conversation ID"
+//	@Param			request	body		domain.AddMembersRequest	true	"Add Members Request"
+//	@Success		200		{object}	domain.ConversationResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/conversations/{id}/members [post]
+func (h *ChatHandler) AddMembers(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	convID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.AddMembersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.AddMembersParams{
+		UserID:         claims.UserID,
+		ConversationID: convID,
+		UserIDs:        req.UserIDs,
+	}
+
+	conv, err := h.chatSvc.AddMembers(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, conv)
+}
+
+// RemoveMember godoc
+//
+//	@Summary		Remove a member from a group
+//	@Description	The owner can remove anyone, admins can remove members. Use leave to remove yourself.
+//	@Tags			Chat
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int	true	"Conversation ID"
+//	@Param			userId	path		int	true	"Member user ID"
+//	@Success		200		{object}	pkg.Response
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/conversations/{id}/members/{userId} [delete]
+func (h *ChatHandler) RemoveMember(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	convID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+	memberID, ok := parseIDParam(c, paramUserID)
+	if !ok {
+		return
+	}
+
+	params := domain.RemoveMemberParams{
+		UserID:         claims.UserID,
+		ConversationID: convID,
+		MemberID:       memberID,
+	}
+
+	if err := h.chatSvc.RemoveMember(c.Request.Context(), params); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "member removed successfully")
+}
+
+// UpdateMemberRole godoc
+//
+//	@Summary		Change the role of a group member
+//	@Description	Promote a member to admin or demote an admin to member (owner only)
+//	@Tags			Chat
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int								true	"Conversation ID"
+//	@Param			userId	path		int								true	"Member user ID"
+//	@Param			request	body		domain.UpdateMemberRoleRequest	true	"Update Member Role Request"
+//	@Success		200		{object}	pkg.Response
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/conversations/{id}/members/{userId} [patch]
+func (h *ChatHandler) UpdateMemberRole(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	convID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+	memberID, ok := parseIDParam(c, paramUserID)
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateMemberRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.UpdateMemberRoleParams{
+		UserID:         claims.UserID,
+		ConversationID: convID,
+		MemberID:       memberID,
+		Role:           req.Role,
+	}
+
+	if err := h.chatSvc.UpdateMemberRole(c.Request.Context(), params); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "member role updated successfully")
+}
+
+// Leave godoc
+//
+//	@Summary		Leave a group
+//	@Description	Leave a group conversation. If the owner leaves, ownership passes to the longest standing admin, or else member.
+//	@Tags			Chat
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"Conversation ID"
+//	@Success		200	{object}	pkg.Response
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/conversations/{id}/leave [post]
+func (h *ChatHandler) Leave(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	convID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	if err := h.chatSvc.Leave(c.Request.Context(), claims.UserID, convID); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "left conversation successfully")
+}
+
+// MarkDelivered godoc
+//
+//	@Summary		Mark messages as delivered
+//	@Description	Acknowledge that the messages up to seq reached this user's device. Also accepted over the WebSocket as "message.delivered"; members receive the new position as "message.delivered".
+//	@Tags			Chat
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int						true	"Conversation ID"
+//	@Param			request	body		domain.ReceiptRequest	true	"Receipt Request"
+//	@Success		200		{object}	pkg.Response
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/conversations/{id}/delivered [post]
+func (h *ChatHandler) MarkDelivered(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	convID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.ReceiptRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ReceiptParams{
+		UserID:         claims.UserID,
+		ConversationID: convID,
+		Seq:            req.Seq,
+	}
+
+	if err := h.chatSvc.MarkDelivered(c.Request.Context(), params); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "messages marked as delivered")
+}
+
+// MarkRead godoc
+//
+//	@Summary		Mark messages as read
+//	@Description	Mark the messages up to seq as read, which resets the unread count. Also accepted over the WebSocket as "message.read"; members and the user's other devices receive the new position as "message.read".
+//	@Tags			Chat
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int						true	"Conversation ID"
+//	@Param			request	body		domain.ReceiptRequest	true	"Receipt Request"
+//	@Success		200		{object}	pkg.Response
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/conversations/{id}/read [post]
+func (h *ChatHandler) MarkRead(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	convID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.ReceiptRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ReceiptParams{
+		UserID:         claims.UserID,
+		ConversationID: convID,
+		Seq:            req.Seq,
+	}
+
+	if err := h.chatSvc.MarkRead(c.Request.Context(), params); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "messages marked as read")
+}
@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"air-social/internal/domain"
+	"air-social/internal/service"
+	"air-social/internal/transport/http/middleware"
+	"air-social/pkg"
+)
+
+type CommentHandler struct {
+	commentSvc service.CommentService
+}
+
+func NewCommentHandler(commentSvc service.CommentService) *CommentHandler {
+	return &CommentHandler{
+		commentSvc: commentSvc,
+	}
+}
+
+// Create godoc
+//
+//	@Summary		Comment on a post
+//	@Description	Add a comment to a post visible to the current user. Set "parent_id" to reply to another comment of the same post.
+//	@Tags			Comment
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int							true	"Post ID"
+//	@Param			request	body		domain.CreateCommentRequest	true	"Create Comment Request"
+//	@Success		201		{object}	domain.CommentResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response	"Email not verified"
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/posts/{id}/comments [post]
+func (h *CommentHandler) Create(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	postID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.CreateCommentParams{
+		UserID:   claims.UserID,
+		PostID:   postID,
+		ParentID: req.ParentID,
+		Content:  req.Content,
+	}
+
+	comment, err := h.commentSvc.Create(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Created(c, comment)
+}
+
+// ListByPost godoc
+//
+//	@Summary		List comments of a post
+//	@Description	List top-level comments of a post using cursor pagination
+//	@Tags			Comment
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int		true	"Post ID"
+//	@Param			sort	query		string	false	"Sort order; top ranks by reply count, and its pages may skip or repeat comments whose reply count changes while paging"	Enums(newest, top)
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Param			limit	query		int		false	"Page size (1-100, default 20)"
+//	@Success		200		{object}	domain.Page{items=[]domain.CommentResponse}
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/posts/{id}/comments [get]
+func (h *CommentHandler) ListByPost(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	postID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.ListCommentsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ListCommentsParams{
+		ViewerID: claims.UserID,
+		PostID:   postID,
+		Sort:     req.Sort,
+		Page:     req.ToParams(),
+	}
+
+	page, err := h.commentSvc.ListByPost(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, page)
+}
+
+// ListReplies godoc
+//
+//	@Summary		List replies to a comment
+//	@Description	List direct replies to a comment using cursor pagination
+//	@Tags			Comment
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int		true	"Comment ID"
+//	@Param			sort	query		string	false	"Sort order; top ranks by reply count, and its pages may skip or repeat comments whose reply count changes while paging"	Enums(newest, top)
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Param			limit	query		int		false	"Page size (1-100, default 20)"
+//	@Success		200		{object}	domain.Page{items=[]domain.CommentResponse}
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/comments/{id}/replies [get]
+func (h *CommentHandler) ListReplies(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	commentID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.ListCommentsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ListRepliesParams{
+		ViewerID:  claims.UserID,
+		CommentID: commentID,
+		Sort:      req.Sort,
+		Page:      req.ToParams(),
+	}
+
+	page, err := h.commentSvc.ListReplies(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, page)
+}
+
+// Update godoc
+//
+//	@Summary		Edit a comment
+//	@Description	Edit the content of an own comment
+//	@Tags			Comment
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int							true	"Comment ID"
+//	@Param			request	body		domain.UpdateCommentRequest	true	"Update Comment Request"
+//	@Success		200		{object}	domain.CommentResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/comments/{id} [patch]
+func (h *CommentHandler) Update(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	commentID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.UpdateCommentParams{
+		UserID:    claims.UserID,
+		CommentID: commentID,
+		Content:   req.Content,
+	}
+
+	comment, err := h.commentSvc.Update(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, comment)
+}
+
+// Delete godoc
+//
+//	@Summary		Delete a comment
+//	@Description	Delete a comment and all replies to it. Allowed for the comment author and the post owner.
+//	@Tags			Comment
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int		true	"Comment ID"
+//	@Success		200	{string}	string	"comment deleted successfully"
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		403	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/comments/{id} [delete]
+func (h *CommentHandler) Delete(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	commentID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	if err := h.commentSvc.Delete(c.Request.Context(), claims.UserID, commentID); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "comment deleted successfully")
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"air-social/internal/domain"
+	"air-social/internal/service"
+	"air-social/internal/transport/http/middleware"
+	"air-social/pkg"
+)
+
+type FeedHandler struct {
+	feedSvc service.FeedService
+}
+
+func NewFeedHandler(feedSvc service.FeedService) *FeedHandler {
+	return &FeedHandler{
+		feedSvc: feedSvc,
+	}
+}
+
+// Home godoc
+//
+//	@Summary		Home timeline
+//	@Description	List posts of the current user and the accounts they follow, newest first, using cursor pagination
+//	@Tags			Feed
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Param			limit	query		int		false	"Page size (1-100, default 20)"
+//	@Success		200		{object}	domain.Page{items=[]domain.PostResponse}
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/feed [get]
+func (h *FeedHandler) Home(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	var req domain.PageRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ListFeedParams{
+		UserID: claims.UserID,
+		Page:   req.ToParams(),
+	}
+
+	page, err := h.feedSvc.GetHomeFeed(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, page)
+}
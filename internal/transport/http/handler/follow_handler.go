@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"air-social/internal/domain"
+	"air-social/internal/service"
+	"air-social/internal/transport/http/middleware"
+	"air-social/pkg"
+)
+
+type FollowHandler struct {
+	followSvc service.FollowService
+}
+
+func NewFollowHandler(followSvc service.FollowService) *FollowHandler {
+	return &FollowHandler{
+		followSvc: followSvc,
+	}
+}
+
+// Follow godoc
+//
+//	@Summary		Follow a user
+//	@Description	Start following a user. Following an already followed user is a no-op.
+//	@Tags			Follow
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int		true	"User ID"
+//	@Success		200	{string}	string	"followed successfully"
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/users/{id}/follow [post]
+func (h *FollowHandler) Follow(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	userID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	params := domain.FollowParams{
+		FollowerID: claims.UserID,
+		FolloweeID: userID,
+	}
+
+	if err := h.followSvc.Follow(c.Request.Context(), params); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "followed successfully")
+}
+
+// Unfollow godoc
+//
+//	@Summary		Unfollow a user
+//	@Description	Stop following a user. Unfollowing a user that is not followed is a no-op.
+//	@Tags			Follow
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int		true	"User ID"
+//	@Success		200	{string}	string	"unfollowed successfully"
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/users/{id}/follow [delete]
+func (h *FollowHandler) Unfollow(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	userID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	params := domain.FollowParams{
+		FollowerID: claims.UserID,
+		FolloweeID: userID,
+	}
+
+	if err := h.followSvc.Unfollow(c.Request.Context(), params); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "unfollowed successfully")
+}
+
+// Followers godoc
+//
+//	@Summary		List followers
+//	@Description	List accounts following the user, most recent first
+//	@Tags			Follow
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int		true	"User ID"
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Param			limit	query		int		false	"Page size (1-100, default 20)"
+//	@Success		200		{object}	domain.Page{items=[]domain.FollowUserResponse}
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/users/{id}/followers [get]
+func (h *FollowHandler) Followers(c *gin.Context) {
+	h.list(c, h.followSvc.ListFollowers)
+}
+
+// Following godoc
+//
+//	@Summary		List followed accounts
+//	@Description	List accounts the user follows, most recent first
+//	@Tags			Follow
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int		true	"User ID"
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Param			limit	query		int		false	"Page size (1-100, default 20)"
+//	@Success		200		{object}	domain.Page{items=[]domain.FollowUserResponse}
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/users/{id}/following [get]
+func (h *FollowHandler) Following(c *gin.Context) {
+	h.list(c, h.followSvc.ListFollowing)
+}
+
+func (h *FollowHandler) list(c *gin.Context, fetch func(ctx context.Context, input domain.ListFollowsParams) (domain.Page, error)) {
+	userID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.PageRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ListFollowsParams{
+		UserID: userID,
+		Page:   req.ToParams(),
+	}
+
+	page, err := fetch(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, page)
+}
@@ -0,0 +1,623 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"air-social/internal/domain"
+	"air-social/internal/service"
+	"air-social/internal/transport/http/middleware"
+	"air-social/pkg"
+)
+
+type GroupHandler struct {
+	groupSvc service.GroupService
+}
+
+func NewGroupHandler(groupSvc service.GroupService) *GroupHandler {
+	return &GroupHandler{
+		groupSvc: groupSvc,
+	}
+}
+
+// Create godoc
+//
+//	@Summary		Create a group
+//	@Description	Create a public, private or secret group owned by the current user. Visibility defaults to public.
+//	@Tags			Group
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		domain.CreateGroupRequest	true	"Create Group Request"
+//	@Success		201		{object}	domain.GroupResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/groups [post]
+func (h *GroupHandler) Create(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	var req domain.CreateGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.CreateGroupParams{
+		UserID:      claims.UserID,
+		Name:        req.Name,
+		Description: req.Description,
+		Visibility:  req.Visibility,
+	}
+
+	group, err := h.groupSvc.Create(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Created(c, group)
+}
+
+// List godoc
+//
+//	@Summary		List groups
+//	@Description	List public and private groups, newest first, or with joined=true the groups the current user is a member of
+//	@Tags			Group
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			joined	query		bool	false	"Only groups of the current user"
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Param			limit	query		int		false	"Page size (1-100, default 20)"
+//	@Success		200		{object}	domain.Page{items=[]domain.GroupResponse}
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/groups [get]
+func (h *GroupHandler) List(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	var req domain.ListGroupsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ListGroupsParams{
+		ViewerID: claims.UserID,
+		Joined:   req.Joined,
+		Page:     req.ToParams(),
+	}
+
+	page, err := h.groupSvc.List(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, page)
+}
+
+// Get godoc
+//
+//	@Summary		Get a group
+//	@Description	Get a group with the membership of the current user. Secret groups are only found by their members and invitees.
+//	@Tags			Group
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"Group ID"
+//	@Success		200	{object}	domain.GroupResponse
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/groups/{id} [get]
+func (h *GroupHandler) Get(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	groupID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	group, err := h.groupSvc.GetByID(c.Request.Context(), claims.UserID, groupID)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, group)
+}
+
+// Update godoc
+//
+//	@Summary		Update a group
+//	@Description	Change the name, description, visibility or cover of a group (owner or admin). The cover must be uploaded via the presigned flow (domain "groups", feature "cover") beforehand; an empty cover removes it.
+//	@Tags			Group
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int							true	"Group ID"
+//	@Param			request	body		domain.UpdateGroupRequest	true	"Update Group Request"
+//	@Success		200		{object}	domain.GroupResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/groups/{id} [patch]
+func (h *GroupHandler) Update(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	groupID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.UpdateGroupParams{
+		UserID:      claims.UserID,
+		GroupID:     groupID,
+		Name:        req.Name,
+		Description: req.Description,
+		Visibility:  req.Visibility,
+		Cover:       req.Cover,
+	}
+
+	group, err := h.groupSvc.Update(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, group)
+}
+
+// Delete godoc
+//
+//	@Summary		Delete a group
+//	@Description	Delete a group together with its posts (owner only)
+//	@Tags			Group
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"Group ID"
+//	@Success		200	{object}	pkg.Response
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		403	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/groups/{id} [delete]
+func (h *GroupHandler) Delete(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	groupID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	if err := h.groupSvc.Delete(c.Request.Context(), claims.UserID, groupID); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "group deleted successfully")
+}
+
+// Join godoc
+//
+//	@Summary		Join a group
+//	@Description	Join a public group or accept an invitation. For private groups a join request is filed instead and the status is pending.
+//	@Tags			Group
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"Group ID"
+//	@Success		200	{object}	domain.GroupMembershipResponse
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		403	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/groups/{id}/join [post]
+func (h *GroupHandler) Join(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	groupID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	membership, err := h.groupSvc.Join(c.Request.Context(), claims.UserID, groupID)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, membership)
+}
+
+// Leave godoc
+//
+//	@Summary		Leave a group
+//	@Description	Leave a group, withdraw a join request or decline an invitation. An owner hands the group over to the longest standing admin, moderator or member; the last member must delete the group instead.
+//	@Tags			Group
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"Group ID"
+//	@Success		200	{object}	pkg.Response
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		409	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/groups/{id}/leave [post]
+func (h *GroupHandler) Leave(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	groupID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	if err := h.groupSvc.Leave(c.Request.Context(), claims.UserID, groupID); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "left group successfully")
+}
+
+// Invite godoc
+//
+//	@Summary		Invite a user to a group
+//	@Description	Invite a user to the group, or approve their pending join request (moderator or above)
+//	@Tags			Group
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int								true	"Group ID"
+//	@Param			request	body		domain.InviteGroupMemberRequest	true	"Invite Request"
+//	@Success		200		{object}	pkg.Response
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		409		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/groups/{id}/invites [post]
+func (h *GroupHandler) Invite(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	groupID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.InviteGroupMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.GroupMemberParams{
+		UserID:   claims.UserID,
+		GroupID:  groupID,
+		MemberID: req.UserID,
+	}
+
+	if err := h.groupSvc.Invite(c.Request.Context(), params); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "invitation sent successfully")
+}
+
+// ListMembers godoc
+//
+//	@Summary		List group members
+//	@Description	List the active members of a group by user ID. Members of private and secret groups are visible to members only.
+//	@Tags			Group
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int		true	"Group ID"
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Param			limit	query		int		false	"Page size (1-100, default 20)"
+//	@Success		200		{object}	domain.Page{items=[]domain.GroupMemberResponse}
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/groups/{id}/members [get]
+func (h *GroupHandler) ListMembers(c *gin.Context) {
+	h.listMembers(c, h.groupSvc.ListMembers)
+}
+
+// ListRequests godoc
+//
+//	@Summary		List join requests
+//	@Description	List the pending join requests of a group (moderator or above)
+//	@Tags			Group
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int		true	"Group ID"
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Param			limit	query		int		false	"Page size (1-100, default 20)"
+//	@Success		200		{object}	domain.Page{items=[]domain.GroupMemberResponse}
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/groups/{id}/requests [get]
+func (h *GroupHandler) ListRequests(c *gin.Context) {
+	h.listMembers(c, h.groupSvc.ListRequests)
+}
+
+// ApproveRequest godoc
+//
+//	@Summary		Approve a join request
+//	@Description	Make the requesting user an active member (moderator or above)
+//	@Tags			Group
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int	true	"Group ID"
+//	@Param			userId	path		int	true	"Requesting user ID"
+//	@Success		200		{object}	pkg.Response
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/groups/{id}/requests/{userId} [post]
+func (h *GroupHandler) ApproveRequest(c *gin.Context) {
+	h.memberAction(c, h.groupSvc.ApproveRequest, "request approved successfully")
+}
+
+// RejectRequest godoc
+//
+//	@Summary		Reject a join request
+//	@Description	Reject a pending join request (moderator or above). The user may request again.
+//	@Tags			Group
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int	true	"Group ID"
+//	@Param			userId	path		int	true	"Requesting user ID"
+//	@Success		200		{object}	pkg.Response
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/groups/{id}/requests/{userId} [delete]
+func (h *GroupHandler) RejectRequest(c *gin.Context) {
+	h.memberAction(c, h.groupSvc.RejectRequest, "request rejected successfully")
+}
+
+// RemoveMember godoc
+//
+//	@Summary		Remove a group member
+//	@Description	Remove a member or withdraw an invitation. Moderators and above can remove members they outrank. Use leave to remove yourself.
+//	@Tags			Group
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int	true	"Group ID"
+//	@Param			userId	path		int	true	"Member user ID"
+//	@Success		200		{object}	pkg.Response
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/groups/{id}/members/{userId} [delete]
+func (h *GroupHandler) RemoveMember(c *gin.Context) {
+	h.memberAction(c, h.groupSvc.RemoveMember, "member removed successfully")
+}
+
+// UpdateMemberRole godoc
+//
+//	@Summary		Change the role of a group member
+//	@Description	The caller must outrank both the current and the new role, so the owner appoints admins and admins manage moderators. Setting "owner" transfers the ownership and makes the caller an admin.
+//	@Tags			Group
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int									true	"Group ID"
+//	@Param			userId	path		int									true	"Member user ID"
+//	@Param			request	body		domain.UpdateGroupMemberRoleRequest	true	"Update Member Role Request"
+//	@Success		200		{object}	pkg.Response
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/groups/{id}/members/{userId} [patch]
+func (h *GroupHandler) UpdateMemberRole(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	groupID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+	memberID, ok := parseIDParam(c, paramUserID)
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateGroupMemberRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.UpdateGroupMemberRoleParams{
+		UserID:   claims.UserID,
+		GroupID:  groupID,
+		MemberID: memberID,
+		Role:     req.Role,
+	}
+
+	if err := h.groupSvc.UpdateMemberRole(c.Request.Context(), params); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "member role updated successfully")
+}
+
+// Ban godoc
+//
+//	@Summary		Ban a user from a group
+//	@Description	Remove the user from the group and keep them from joining or requesting again (moderator or above, outranking the member)
+//	@Tags			Group
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int	true	"Group ID"
+//	@Param			userId	path		int	true	"User ID"
+//	@Success		200		{object}	pkg.Response
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/groups/{id}/bans/{userId} [put]
+func (h *GroupHandler) Ban(c *gin.Context) {
+	h.memberAction(c, h.groupSvc.Ban, "user banned successfully")
+}
+
+// Unban godoc
+//
+//	@Summary		Lift a group ban
+//	@Description	Allow a banned user to join or request again (moderator or above)
+//	@Tags			Group
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int	true	"Group ID"
+//	@Param			userId	path		int	true	"User ID"
+//	@Success		200		{object}	pkg.Response
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/groups/{id}/bans/{userId} [delete]
+func (h *GroupHandler) Unban(c *gin.Context) {
+	h.memberAction(c, h.groupSvc.Unban, "user unbanned successfully")
+}
+
+// Internal helpers
+
+// memberAction runs a moderation action of the caller on the :userId member
+// of the :id group and responds with message.
+func (h *GroupHandler) memberAction(
+	c *gin.Context,
+	action func(ctx context.Context, input domain.GroupMemberParams) error,
+	message string,
+) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	groupID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+	memberID, ok := parseIDParam(c, paramUserID)
+	if !ok {
+		return
+	}
+
+	params := domain.GroupMemberParams{
+		UserID:   claims.UserID,
+		GroupID:  groupID,
+		MemberID: memberID,
+	}
+
+	if err := action(c.Request.Context(), params); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, message)
+}
+
+func (h *GroupHandler) listMembers(
+	c *gin.Context,
+	list func(ctx context.Context, input domain.ListGroupMembersParams) (domain.Page, error),
+) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	groupID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.PageRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ListGroupMembersParams{
+		ViewerID: claims.UserID,
+		GroupID:  groupID,
+		Page:     req.ToParams(),
+	}
+
+	page, err := list(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, page)
+}
@@ -22,12 +22,13 @@ func NewHealthHandler(srv service.HealthService) *HealthHandler {
 // HealthCheck godoc
 //
 //	@Summary		Health check
-//	@Description	Check the health status of the application components
+//	@Description	Check the health status of the application components. Requires the system:health permission.
 //	@Tags			Health
 //	@Produce		json
-//	@Security		BasicAuth
+//	@Security		BearerAuth
 //	@Success		200	{object}	map[string]string
 //	@Failure		401	{object}	pkg.Response
+//	@Failure		403	{object}	pkg.Response
 //	@Router			/health [get]
 func (h *HealthHandler) HealthCheck(c *gin.Context) {
 	_, details := h.srv.Check(c.Request.Context())
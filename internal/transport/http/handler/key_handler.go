@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"air-social/internal/service"
+)
+
+// jwksMaxAge lets verifiers cache the keys, short enough that a key added
+// for rotation reaches them well before it starts signing.
+const jwksMaxAge = "public, max-age=300"
+
+type KeyHandler struct {
+	tokenSvc service.TokenService
+}
+
+func NewKeyHandler(tokenSvc service.TokenService) *KeyHandler {
+	return &KeyHandler{
+		tokenSvc: tokenSvc,
+	}
+}
+
+// JWKS serves the public keys that verify access tokens as a plain JWK Set
+// (RFC 7517), the format JWT libraries fetch, rather than a pkg.Response.
+func (h *KeyHandler) JWKS(c *gin.Context) {
+	c.Header("Cache-Control", jwksMaxAge)
+	c.JSON(http.StatusOK, h.tokenSvc.JWKS())
+}
@@ -29,6 +29,8 @@ func NewMediaHandler(srv service.MediaService) *MediaHandler {
 //	@Success		200		{object}	domain.PresignedFileResponse
 //	@Failure		400		{object}	pkg.ValidationResult
 //	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response	"Email not verified"
+//	@Failure		429		{object}	pkg.Response	"Rate limited, see Retry-After"
 //	@Failure		500		{object}	pkg.Response
 //	@Router			/media/presigned [post]
 func (h *MediaHandler) PresignedUpload(c *gin.Context) {
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"air-social/pkg"
+)
+
+const (
+	paramID       = "id"
+	paramUserID   = "userId"
+	paramProvider = "provider"
+)
+
+// parseIDParam reads a positive int64 path parameter.
+// On failure it writes a 400 response and returns false.
+func parseIDParam(c *gin.Context, key string) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param(key), 10, 64)
+	if err != nil || id <= 0 {
+		pkg.BadRequest(c, "invalid "+key)
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,337 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"air-social/internal/domain"
+	"air-social/internal/service"
+	"air-social/internal/transport/http/middleware"
+	"air-social/pkg"
+)
+
+type PostHandler struct {
+	postSvc service.PostService
+}
+
+func NewPostHandler(postSvc service.PostService) *PostHandler {
+	return &PostHandler{
+		postSvc: postSvc,
+	}
+}
+
+// Create godoc
+//
+//	@Summary		Create a post
+//	@Description	Create a post with text and up to 10 media attachments. Each attachment must be uploaded via the presigned flow (domain "posts") beforehand.
+//	@Tags			Post
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		domain.CreatePostRequest	true	"Create Post Request"
+//	@Success		201		{object}	domain.PostResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/posts [post]
+func (h *PostHandler) Create(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	var req domain.CreatePostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.CreatePostParams{
+		AuthorID:   claims.UserID,
+		Content:    req.Content,
+		Visibility: req.Visibility,
+		Media:      req.Media,
+	}
+
+	post, err := h.postSvc.Create(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Created(c, post)
+}
+
+// Get godoc
+//
+//	@Summary		Get a post
+//	@Description	Get a single post visible to the current user
+//	@Tags			Post
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"Post ID"
+//	@Success		200	{object}	domain.PostResponse
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/posts/{id} [get]
+func (h *PostHandler) Get(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	postID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	post, err := h.postSvc.GetByID(c.Request.Context(), claims.UserID, postID)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, post)
+}
+
+// Update godoc
+//
+//	@Summary		Edit a post
+//	@Description	Edit content, visibility or attachments of an own post. When "media" is sent it replaces the attachment list; keys already on the post are kept, new keys must be freshly uploaded. Returns 409 when the post was changed by a concurrent edit.
+//	@Tags			Post
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int							true	"Post ID"
+//	@Param			request	body		domain.UpdatePostRequest	true	"Update Post Request"
+//	@Success		200		{object}	domain.PostResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		409		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/posts/{id} [patch]
+func (h *PostHandler) Update(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	postID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.UpdatePostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.UpdatePostParams{
+		UserID:     claims.UserID,
+		PostID:     postID,
+		Content:    req.Content,
+		Visibility: req.Visibility,
+		Media:      req.Media,
+	}
+
+	post, err := h.postSvc.Update(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, post)
+}
+
+// Delete godoc
+//
+//	@Summary		Delete a post
+//	@Description	Delete an own post together with its attachments
+//	@Tags			Post
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int		true	"Post ID"
+//	@Success		200	{string}	string	"post deleted successfully"
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		403	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/posts/{id} [delete]
+func (h *PostHandler) Delete(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	postID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	if err := h.postSvc.Delete(c.Request.Context(), claims.UserID, postID); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "post deleted successfully")
+}
+
+// ListByAuthor godoc
+//
+//	@Summary		List posts of a user
+//	@Description	List posts written by a user, newest first, using cursor pagination
+//	@Tags			Post
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int		true	"User ID"
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Param			limit	query		int		false	"Page size (1-100, default 20)"
+//	@Success		200		{object}	domain.Page{items=[]domain.PostResponse}
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/users/{id}/posts [get]
+func (h *PostHandler) ListByAuthor(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	authorID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.PageRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ListPostsParams{
+		ViewerID: claims.UserID,
+		AuthorID: authorID,
+		Page:     req.ToParams(),
+	}
+
+	page, err := h.postSvc.ListByAuthor(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, page)
+}
+
+// CreateInGroup godoc
+//
+//	@Summary		Create a group post
+//	@Description	Post in a group the current user is an active member of. Group posts are public within the group and reach the members' home feeds. Attachments are uploaded via the presigned flow (domain "posts") beforehand.
+//	@Tags			Post
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int								true	"Group ID"
+//	@Param			request	body		domain.CreateGroupPostRequest	true	"Create Group Post Request"
+//	@Success		201		{object}	domain.PostResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/groups/{id}/posts [post]
+func (h *PostHandler) CreateInGroup(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	groupID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.CreateGroupPostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.CreatePostParams{
+		AuthorID: claims.UserID,
+		GroupID:  &groupID,
+		Content:  req.Content,
+		Media:    req.Media,
+	}
+
+	post, err := h.postSvc.Create(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Created(c, post)
+}
+
+// ListByGroup godoc
+//
+//	@Summary		List group posts
+//	@Description	List the posts of a group, newest first. Posts of private and secret groups are visible to members only.
+//	@Tags			Post
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int		true	"Group ID"
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Param			limit	query		int		false	"Page size (1-100, default 20)"
+//	@Success		200		{object}	domain.Page{items=[]domain.PostResponse}
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		403		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/groups/{id}/posts [get]
+func (h *PostHandler) ListByGroup(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	groupID, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.PageRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ListGroupPostsParams{
+		ViewerID: claims.UserID,
+		GroupID:  groupID,
+		Page:     req.ToParams(),
+	}
+
+	page, err := h.postSvc.ListByGroup(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, page)
+}
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"air-social/internal/domain"
+	"air-social/internal/service"
+	"air-social/internal/transport/http/middleware"
+	"air-social/pkg"
+)
+
+type PresenceHandler struct {
+	presenceSvc service.PresenceService
+}
+
+func NewPresenceHandler(presenceSvc service.PresenceService) *PresenceHandler {
+	return &PresenceHandler{
+		presenceSvc: presenceSvc,
+	}
+}
+
+// Lookup godoc
+//
+//	@Summary		Look up presence
+//	@Description	Return whether each user is online, or when they were last seen. Users hiding their presence read as offline with no last-seen time. Changes are also pushed over the WebSocket as "presence" to conversation peers and followers.
+//	@Tags			Presence
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			ids	query		[]int	true	"User IDs (1-100)"	collectionFormat(multi)
+//	@Success		200	{array}		domain.PresenceResponse
+//	@Failure		400	{object}	pkg.ValidationResult
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/presence [get]
+func (h *PresenceHandler) Lookup(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	var req domain.PresenceLookupRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	items, err := h.presenceSvc.GetPresence(c.Request.Context(), claims.UserID, req.IDs)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, items)
+}
+
+// UpdateSettings godoc
+//
+//	@Summary		Update presence settings
+//	@Description	Hide or show the current user's online status and last-seen time
+//	@Tags			Presence
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		domain.UpdatePresenceSettingsRequest	true	"Update Presence Settings Request"
+//	@Success		200		{object}	pkg.Response
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/presence/settings [put]
+func (h *PresenceHandler) UpdateSettings(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	var req domain.UpdatePresenceSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	if err := h.presenceSvc.SetHidden(c.Request.Context(), claims.UserID, *req.Hidden); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "presence settings updated successfully")
+}
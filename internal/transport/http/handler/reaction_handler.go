@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"air-social/internal/domain"
+	"air-social/internal/service"
+	"air-social/internal/transport/http/middleware"
+	"air-social/pkg"
+)
+
+type ReactionHandler struct {
+	reactionSvc service.ReactionService
+}
+
+func NewReactionHandler(reactionSvc service.ReactionService) *ReactionHandler {
+	return &ReactionHandler{
+		reactionSvc: reactionSvc,
+	}
+}
+
+// ReactToPost godoc
+//
+//	@Summary		React to a post
+//	@Description	Set the current user's reaction to a post. Reacting again with another type replaces the previous reaction.
+//	@Tags			Reaction
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int					true	"Post ID"
+//	@Param			request	body		domain.ReactRequest	true	"React Request"
+//	@Success		200		{string}	string				"reaction saved successfully"
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/posts/{id}/reactions [put]
+func (h *ReactionHandler) ReactToPost(c *gin.Context) {
+	h.react(c, domain.ReactionTargetPost)
+}
+
+// UnreactToPost godoc
+//
+//	@Summary		Remove a reaction from a post
+//	@Description	Remove the current user's reaction to a post
+//	@Tags			Reaction
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int		true	"Post ID"
+//	@Success		200	{string}	string	"reaction removed successfully"
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/posts/{id}/reactions [delete]
+func (h *ReactionHandler) UnreactToPost(c *gin.Context) {
+	h.unreact(c, domain.ReactionTargetPost)
+}
+
+// ListPostReactors godoc
+//
+//	@Summary		List who reacted to a post
+//	@Description	List users who reacted to a post, newest first, optionally filtered by reaction type
+//	@Tags			Reaction
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int		true	"Post ID"
+//	@Param			type	query		string	false	"Reaction type"	Enums(like, love, haha, wow, sad, angry)
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Param			limit	query		int		false	"Page size (1-100, default 20)"
+//	@Success		200		{object}	domain.Page{items=[]domain.ReactorResponse}
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/posts/{id}/reactions [get]
+func (h *ReactionHandler) ListPostReactors(c *gin.Context) {
+	h.listReactors(c, domain.ReactionTargetPost)
+}
+
+// ReactToComment godoc
+//
+//	@Summary		React to a comment
+//	@Description	Set the current user's reaction to a comment. Reacting again with another type replaces the previous reaction.
+//	@Tags			Reaction
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int					true	"Comment ID"
+//	@Param			request	body		domain.ReactRequest	true	"React Request"
+//	@Success		200		{string}	string				"reaction saved successfully"
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/comments/{id}/reactions [put]
+func (h *ReactionHandler) ReactToComment(c *gin.Context) {
+	h.react(c, domain.ReactionTargetComment)
+}
+
+// UnreactToComment godoc
+//
+//	@Summary		Remove a reaction from a comment
+//	@Description	Remove the current user's reaction to a comment
+//	@Tags			Reaction
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int		true	"Comment ID"
+//	@Success		200	{string}	string	"reaction removed successfully"
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/comments/{id}/reactions [delete]
+func (h *ReactionHandler) UnreactToComment(c *gin.Context) {
+	h.unreact(c, domain.ReactionTargetComment)
+}
+
+// ListCommentReactors godoc
+//
+//	@Summary		List who reacted to a comment
+//	@Description	List users who reacted to a comment, newest first, optionally filtered by reaction type
+//	@Tags			Reaction
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		int		true	"Comment ID"
+//	@Param			type	query		string	false	"Reaction type"	Enums(like, love, haha, wow, sad, angry)
+//	@Param			cursor	query		string	false	"Cursor from the previous page"
+//	@Param			limit	query		int		false	"Page size (1-100, default 20)"
+//	@Success		200		{object}	domain.Page{items=[]domain.ReactorResponse}
+//	@Failure		400		{object}	pkg.Response
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/comments/{id}/reactions [get]
+func (h *ReactionHandler) ListCommentReactors(c *gin.Context) {
+	h.listReactors(c, domain.ReactionTargetComment)
+}
+
+func (h *ReactionHandler) react(c *gin.Context, targetType domain.ReactionTargetType) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	id, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.ReactRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ReactParams{
+		UserID: claims.UserID,
+		Target: domain.ReactionTarget{Type: targetType, ID: id},
+		Type:   req.Type,
+	}
+
+	if err := h.reactionSvc.React(c.Request.Context(), params); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "reaction saved successfully")
+}
+
+func (h *ReactionHandler) unreact(c *gin.Context, targetType domain.ReactionTargetType) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	id, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	target := domain.ReactionTarget{Type: targetType, ID: id}
+	if err := h.reactionSvc.Unreact(c.Request.Context(), claims.UserID, target); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "reaction removed successfully")
+}
+
+func (h *ReactionHandler) listReactors(c *gin.Context, targetType domain.ReactionTargetType) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	id, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	var req domain.ListReactorsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.ListReactorsParams{
+		ViewerID: claims.UserID,
+		Target:   domain.ReactionTarget{Type: targetType, ID: id},
+		Type:     req.Type,
+		Page:     req.ToParams(),
+	}
+
+	page, err := h.reactionSvc.ListReactors(c.Request.Context(), params)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, page)
+}
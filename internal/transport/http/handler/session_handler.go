@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"air-social/internal/service"
+	"air-social/internal/transport/http/middleware"
+	"air-social/pkg"
+)
+
+type SessionHandler struct {
+	tokenSvc service.TokenService
+}
+
+func NewSessionHandler(tokenSvc service.TokenService) *SessionHandler {
+	return &SessionHandler{
+		tokenSvc: tokenSvc,
+	}
+}
+
+// List godoc
+//
+//	@Summary		List my sessions
+//	@Description	List the devices the current user is signed in on, most recently used first. The session of the calling device is marked as current.
+//	@Tags			User
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		domain.SessionResponse
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/users/me/sessions [get]
+func (h *SessionHandler) List(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	sessions, err := h.tokenSvc.ListSessions(c.Request.Context(), claims.UserID, claims.DeviceID)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, sessions)
+}
+
+// Revoke godoc
+//
+//	@Summary		Revoke one of my sessions
+//	@Description	Sign the device of the session out, revoking its access token too.
+//	@Tags			User
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Session ID"
+//	@Success		200	{object}	pkg.Response
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/users/me/sessions/{id} [delete]
+func (h *SessionHandler) Revoke(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	sessionID, err := uuid.Parse(c.Param(paramID))
+	if err != nil {
+		pkg.BadRequest(c, "invalid "+paramID)
+		return
+	}
+
+	if err := h.tokenSvc.RevokeSession(c.Request.Context(), claims.UserID, sessionID.String()); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "session revoked successfully")
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"air-social/internal/domain"
+	"air-social/internal/service"
+	"air-social/internal/transport/http/middleware"
+	"air-social/pkg"
+)
+
+type TwoFactorHandler struct {
+	twoFactorSvc service.TwoFactorService
+}
+
+func NewTwoFactorHandler(twoFactorSvc service.TwoFactorService) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		twoFactorSvc: twoFactorSvc,
+	}
+}
+
+// Enroll godoc
+//
+//	@Summary		Start two-factor enrollment
+//	@Description	Create a new TOTP secret for the current user, as an otpauth:// URI and a QR code to scan with an authenticator app. Two-factor authentication stays off until the first code is confirmed; enrolling again replaces an unconfirmed secret.
+//	@Tags			User
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	domain.TwoFactorEnrollResponse
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		409	{object}	pkg.Response	"Two-factor authentication is already on"
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/users/me/2fa/enroll [post]
+func (h *TwoFactorHandler) Enroll(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	res, err := h.twoFactorSvc.Enroll(c.Request.Context(), claims.UserID)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, res)
+}
+
+// Confirm godoc
+//
+//	@Summary		Confirm two-factor enrollment
+//	@Description	Turn two-factor authentication on with a first code from the authenticator app. Returns one-time recovery codes, which are not shown again.
+//	@Tags			User
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		domain.ConfirmTwoFactorRequest	true	"Confirm Request"
+//	@Success		200		{object}	domain.RecoveryCodesResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response	"Wrong code"
+//	@Failure		404		{object}	pkg.Response	"No enrollment started"
+//	@Failure		409		{object}	pkg.Response	"Two-factor authentication is already on"
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/users/me/2fa/confirm [post]
+func (h *TwoFactorHandler) Confirm(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	var req domain.ConfirmTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	res, err := h.twoFactorSvc.Confirm(c.Request.Context(), claims.UserID, req.Code)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, res)
+}
+
+// Disable godoc
+//
+//	@Summary		Turn two-factor authentication off
+//	@Description	Turn two-factor authentication off, given the password and a code from the authenticator app or a recovery code. The remaining recovery codes are dropped.
+//	@Tags			User
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		domain.DisableTwoFactorRequest	true	"Disable Request"
+//	@Success		200		{string}	string							"two-factor authentication disabled"
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response	"Wrong password or code"
+//	@Failure		404		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/users/me/2fa/disable [post]
+func (h *TwoFactorHandler) Disable(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	var req domain.DisableTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.DisableTwoFactorParams{
+		UserID:   claims.UserID,
+		Password: req.Password,
+		Code:     req.Code,
+	}
+
+	if err := h.twoFactorSvc.Disable(c.Request.Context(), params); err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, "two-factor authentication disabled")
+}
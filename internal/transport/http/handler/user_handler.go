@@ -53,6 +53,35 @@ func (h *UserHandler) Profile(c *gin.Context) {
 	pkg.Success(c, user)
 }
 
+// PublicProfile godoc
+//
+//	@Summary		Get a user's public profile
+//	@Description	Get the public profile of any user, including follower and following counts
+//	@Tags			User
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		int	true	"User ID"
+//	@Success		200	{object}	domain.PublicProfileResponse
+//	@Failure		400	{object}	pkg.Response
+//	@Failure		401	{object}	pkg.Response
+//	@Failure		404	{object}	pkg.Response
+//	@Failure		500	{object}	pkg.Response
+//	@Router			/users/{id} [get]
+func (h *UserHandler) PublicProfile(c *gin.Context) {
+	id, ok := parseIDParam(c, paramID)
+	if !ok {
+		return
+	}
+
+	user, err := h.userSvc.GetPublicProfile(c.Request.Context(), id)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, user)
+}
+
 // UpdateProfile godoc
 //
 //	@Summary		Update user profile
@@ -146,6 +175,51 @@ func (h *UserHandler) ChangePassword(c *gin.Context) {
 	pkg.Success(c, "password changed successfully")
 }
 
+// DeleteAccount godoc
+//
+//	@Summary		Delete account
+//	@Description	Schedule the deletion of the account after the grace period. All sessions are revoked and the profile is hidden right away; logging in before the returned date keeps the account.
+//	@Tags			User
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		domain.DeleteAccountRequest	true	"Delete Account Request"
+//	@Success		200		{object}	domain.AccountDeletionResponse
+//	@Failure		400		{object}	pkg.ValidationResult
+//	@Failure		401		{object}	pkg.Response
+//	@Failure		500		{object}	pkg.Response
+//	@Router			/users/me [delete]
+func (h *UserHandler) DeleteAccount(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.Unauthorized(c, err.Error())
+		return
+	}
+
+	var req domain.DeleteAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		pkg.HandleValidateError(c, err)
+		return
+	}
+
+	params := domain.DeleteAccountParams{
+		UserID:   claims.UserID,
+		Password: req.Password,
+	}
+
+	res, err := h.userSvc.DeleteAccount(c.Request.Context(), params)
+	if err != nil {
+		if errors.Is(err, pkg.ErrNotFound) {
+			pkg.Unauthorized(c, "account has been deleted or suspended")
+			return
+		}
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	pkg.Success(c, res)
+}
+
 // ConfirmFileUpload godoc
 //
 //	@Summary		Confirm file upload
@@ -4,7 +4,6 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 
-	"air-social/internal/config"
 	"air-social/internal/domain"
 	"air-social/internal/service"
 	"air-social/pkg"
@@ -15,9 +14,19 @@ type authContextKey string
 const AuthPayloadKey authContextKey = "auth_payload"
 
 func Auth(tokenService service.TokenService) gin.HandlerFunc {
+	return authenticate(tokenService, pkg.ExtractTokenFromHeader)
+}
+
+// WSAuth authenticates a WebSocket upgrade. Browsers cannot set headers on
+// the handshake, so the token may also be passed as a query parameter.
+func WSAuth(tokenService service.TokenService) gin.HandlerFunc {
+	return authenticate(tokenService, pkg.ExtractTokenFromHeaderOrQuery)
+}
+
+func authenticate(tokenService service.TokenService, extract func(c *gin.Context) (string, error)) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Get raw token string
-		tokenString, err := pkg.ExtractTokenFromHeader(c)
+		tokenString, err := extract(c)
 		if err != nil {
 			pkg.Unauthorized(c, err.Error())
 			c.Abort()
@@ -49,9 +58,33 @@ func Auth(tokenService service.TokenService) gin.HandlerFunc {
 
 		deviceID := pkg.GetStringClaims(clams, pkg.JWTClaimDevice)
 
+		// Tokens issued before roles existed carry none.
+		role := domain.UserRole(pkg.GetStringClaims(clams, pkg.JWTClaimRole))
+		if role == "" {
+			role = domain.UserRoleUser
+		}
+
 		payload := &domain.AuthClaims{
-			UserID:   userID,
-			DeviceID: deviceID,
+			UserID:    userID,
+			DeviceID:  deviceID,
+			Role:      role,
+			TokenID:   pkg.GetStringClaims(clams, pkg.JWTClaimID),
+			SessionID: pkg.GetStringClaims(clams, pkg.JWTClaimSession),
+			IssuedAt:  pkg.GetTimeClaims(clams, pkg.JWTClaimIssuedAt),
+		}
+		if exp, _ := clams.GetExpirationTime(); exp != nil {
+			payload.ExpiresAt = exp.Time
+		}
+
+		// A denylist outage must not sign everybody out, so it fails open.
+		revoked, err := tokenService.IsAccessTokenRevoked(c.Request.Context(), payload)
+		if err != nil {
+			pkg.Log().Errorw("[CACHE ERROR]", "from", "token_denylist", "user_id", userID, "error", err)
+		}
+		if revoked {
+			pkg.Unauthorized(c, "token has been revoked")
+			c.Abort()
+			return
 		}
 
 		// Set context
@@ -60,14 +93,6 @@ func Auth(tokenService service.TokenService) gin.HandlerFunc {
 	}
 }
 
-func Basic(cfg config.ServerConfig) gin.HandlerFunc {
-	return gin.BasicAuth(
-		gin.Accounts{
-			cfg.AuthUsername: cfg.AuthPassword,
-		},
-	)
-}
-
 func GetAuthClaims(c *gin.Context) (*domain.AuthClaims, error) {
 	value, exists := c.Get(AuthPayloadKey)
 	if !exists {
@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+
+	"air-social/internal/config"
+	"air-social/internal/domain"
+	redisInfra "air-social/internal/infrastructure/redis"
+	"air-social/internal/service"
+	"air-social/pkg"
+)
+
+type authSuite struct {
+	suite.Suite
+	redis    *miniredis.Miniredis
+	cfg      config.TokenConfig
+	keys     *pkg.KeySet
+	denylist domain.TokenDenylist
+	tokenSvc *service.TokenServiceImpl
+}
+
+func TestAuthSuite(t *testing.T) {
+	suite.Run(t, new(authSuite))
+}
+
+func (s *authSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	s.redis = miniredis.RunT(s.T())
+	s.cfg = config.TokenConfig{Aud: "aud", Iss: "iss", AccessTokenTTL: 15 * time.Minute}
+
+	keys, err := pkg.GenerateKeySet("key-1")
+	s.Require().NoError(err)
+	s.keys = keys
+
+	denylist, err := redisInfra.NewTokenDenylist(redis.NewClient(&redis.Options{Addr: s.redis.Addr(), MaxRetries: -1}))
+	s.Require().NoError(err)
+	s.denylist = denylist
+	s.tokenSvc = service.NewTokenService(nil, denylist, nil, keys, s.cfg)
+}
+
+func (s *authSuite) sign(jti string, issuedAt time.Time) string {
+	token, err := s.keys.Sign(jwt.MapClaims{
+		pkg.JWTClaimID:        jti,
+		pkg.JWTClaimSubject:   "1",
+		pkg.JWTClaimDevice:    "device-1",
+		pkg.JWTClaimSession:   "session-1",
+		pkg.JWTClaimAudience:  s.cfg.Aud,
+		pkg.JWTClaimIssuer:    s.cfg.Iss,
+		pkg.JWTClaimIssuedAt:  pkg.NumericDateMillis(issuedAt),
+		pkg.JWTClaimExpiresAt: issuedAt.Add(s.cfg.AccessTokenTTL).Unix(),
+	})
+	s.Require().NoError(err)
+	return token
+}
+
+func (s *authSuite) do(token string) int {
+	e := gin.New()
+	e.GET("/", Auth(s.tokenSvc), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, req)
+	return w.Code
+}
+
+func (s *authSuite) TestDeniedToken() {
+	now := time.Now()
+	token := s.sign("jti-1", now)
+	s.Equal(http.StatusOK, s.do(token))
+
+	s.Require().NoError(s.tokenSvc.RevokeAccessToken(context.Background(), "jti-1", now.Add(s.cfg.AccessTokenTTL)))
+
+	s.Equal(http.StatusUnauthorized, s.do(token))
+	s.Equal(http.StatusOK, s.do(s.sign("jti-2", now)))
+}
+
+func (s *authSuite) TestDeniedSession() {
+	now := time.Now()
+	token := s.sign("jti-1", now)
+
+	s.Require().NoError(s.denylist.DenySession(context.Background(), "session-2", s.cfg.AccessTokenTTL))
+	s.Equal(http.StatusOK, s.do(token))
+
+	s.Require().NoError(s.denylist.DenySession(context.Background(), "session-1", s.cfg.AccessTokenTTL))
+	s.Equal(http.StatusUnauthorized, s.do(token))
+	s.Equal(http.StatusUnauthorized, s.do(s.sign("jti-2", now)))
+}
+
+func (s *authSuite) TestRevokedBefore() {
+	now := time.Now()
+	s.Require().NoError(s.denylist.RevokeBefore(context.Background(), 1, now, s.cfg.AccessTokenTTL))
+
+	s.Equal(http.StatusUnauthorized, s.do(s.sign("old", now.Add(-time.Minute))))
+	// Minted in the same second, or even millisecond, as the revocation.
+	s.Equal(http.StatusUnauthorized, s.do(s.sign("racing", now)))
+	s.Equal(http.StatusOK, s.do(s.sign("new", now.Add(time.Millisecond))))
+	// Tokens issued before jti existed are still caught by the mark.
+	s.Equal(http.StatusUnauthorized, s.do(s.sign("", now.Add(-time.Minute))))
+}
+
+func (s *authSuite) TestRevokedBeforeSecondsIssuedAt() {
+	now := time.Now()
+	s.Require().NoError(s.denylist.RevokeBefore(context.Background(), 1, now, s.cfg.AccessTokenTTL))
+
+	// Tokens issued before iat carried milliseconds count from the start of
+	// their second.
+	token, err := s.keys.Sign(jwt.MapClaims{
+		pkg.JWTClaimID:        "seconds",
+		pkg.JWTClaimSubject:   "1",
+		pkg.JWTClaimAudience:  s.cfg.Aud,
+		pkg.JWTClaimIssuer:    s.cfg.Iss,
+		pkg.JWTClaimIssuedAt:  now.Unix(),
+		pkg.JWTClaimExpiresAt: now.Add(s.cfg.AccessTokenTTL).Unix(),
+	})
+	s.Require().NoError(err)
+	s.Equal(http.StatusUnauthorized, s.do(token))
+}
+
+func (s *authSuite) TestRedisDownFailsOpen() {
+	s.redis.Close()
+
+	s.Equal(http.StatusOK, s.do(s.sign("jti-1", time.Now())))
+}
@@ -1,24 +1,85 @@
 package middleware
 
 import (
+	"slices"
+
 	"github.com/gin-gonic/gin"
 
 	"air-social/internal/config"
+	"air-social/internal/domain"
 	"air-social/internal/service"
+	"air-social/pkg"
 )
 
 type Manager struct {
-	Basic         gin.HandlerFunc
 	Auth          gin.HandlerFunc
+	WSAuth        gin.HandlerFunc
 	JSONOnly      gin.HandlerFunc
 	MultipartOnly gin.HandlerFunc
+
+	LoginLimit           gin.HandlerFunc
+	RegisterLimit        gin.HandlerFunc
+	ForgotPasswordLimit  gin.HandlerFunc
+	MagicLinkLimit       gin.HandlerFunc
+	PresignedUploadLimit gin.HandlerFunc
+	VerifyEmailLimit     gin.HandlerFunc
+	EmailChangeLimit     gin.HandlerFunc
+
+	requireVerified gin.HandlerFunc
+	gated           []domain.GatedAction
 }
 
-func NewManager(cfg config.ServerConfig, tokens service.TokenService) *Manager {
+func NewManager(cfg config.Config, tokens service.TokenService, users service.UserService, limiter domain.RateLimiter) *Manager {
+	// A nil limiter makes every policy pass requests through.
+	if !cfg.Limiter.Enabled {
+		limiter = nil
+	}
+
 	return &Manager{
-		Basic:         Basic(cfg),
 		Auth:          Auth(tokens),
+		WSAuth:        WSAuth(tokens),
 		JSONOnly:      JSONOnly(),
 		MultipartOnly: MultipartOnly(),
+
+		LoginLimit:           RateLimit(limiter, "login", cfg.Limiter.Login),
+		RegisterLimit:        RateLimit(limiter, "register", cfg.Limiter.Register),
+		ForgotPasswordLimit:  RateLimit(limiter, "forgot_password", cfg.Limiter.ForgotPassword),
+		MagicLinkLimit:       RateLimit(limiter, "magic_link", cfg.Limiter.MagicLink),
+		PresignedUploadLimit: RateLimit(limiter, "presigned_upload", cfg.Limiter.PresignedUpload),
+		VerifyEmailLimit:     RateLimit(limiter, "verify_email", cfg.Limiter.VerifyEmail),
+		EmailChangeLimit:     RateLimit(limiter, "email_change", cfg.Limiter.EmailChange),
+
+		requireVerified: RequireVerified(users),
+		gated:           gatedActions(cfg.Verify.RequiredFor),
+	}
+}
+
+func (m *Manager) RequireRole(roles ...domain.UserRole) gin.HandlerFunc {
+	return RequireRole(roles...)
+}
+
+func (m *Manager) RequirePermission(p domain.Permission) gin.HandlerFunc {
+	return RequirePermission(p)
+}
+
+// RequireVerified guards the routes of action with RequireVerified when the
+// action is gated, and lets every caller through otherwise.
+func (m *Manager) RequireVerified(action domain.GatedAction) gin.HandlerFunc {
+	if !slices.Contains(m.gated, action) {
+		return func(c *gin.Context) { c.Next() }
+	}
+	return m.requireVerified
+}
+
+func gatedActions(names []string) []domain.GatedAction {
+	var actions []domain.GatedAction
+	for _, name := range names {
+		action := domain.GatedAction(name)
+		if !slices.Contains(domain.GatedActions, action) {
+			pkg.Log().Warnw("[CONFIG] unknown action in VERIFIED_EMAIL_REQUIRED_FOR", "action", name)
+			continue
+		}
+		actions = append(actions, action)
 	}
+	return actions
 }
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"air-social/internal/config"
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+	headerRetryAfter         = "Retry-After"
+)
+
+// RateLimit allows policy.Limit requests per policy.Window to every caller of
+// the routes it guards, which share the named bucket. Callers are told apart
+// by user ID behind Auth and by client IP otherwise.
+//
+// Requests pass when the limiter fails: it guards against abuse and must not
+// take the API down when Redis does.
+func RateLimit(limiter domain.RateLimiter, name string, policy config.RateLimitPolicy) gin.HandlerFunc {
+	if limiter == nil || !policy.Enabled() {
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	return func(c *gin.Context) {
+		key := domain.GetRateLimitKey(name, rateLimitSubject(c))
+		res, err := limiter.Allow(c.Request.Context(), key, policy.Limit, policy.Window)
+		if err != nil {
+			pkg.Log().Errorw("[STORAGE ERROR]", "from", "rate_limit", "key", key, "error", err)
+			c.Next()
+			return
+		}
+
+		h := c.Writer.Header()
+		h.Set(headerRateLimitLimit, strconv.Itoa(res.Limit))
+		h.Set(headerRateLimitRemaining, strconv.Itoa(res.Remaining))
+		h.Set(headerRateLimitReset, seconds(res.ResetAfter))
+
+		if !res.Allowed {
+			h.Set(headerRetryAfter, seconds(res.RetryAfter))
+			pkg.HandleServiceError(c, pkg.ErrTooManyRequests)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+func rateLimitSubject(c *gin.Context) string {
+	if claims, err := GetAuthClaims(c); err == nil {
+		return "user:" + strconv.FormatInt(claims.UserID, 10)
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// seconds rounds d up, so clients retrying after it are never too early.
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+
+	"air-social/internal/config"
+	"air-social/internal/domain"
+	redisInfra "air-social/internal/infrastructure/redis"
+)
+
+type rateLimitSuite struct {
+	suite.Suite
+	redis   *miniredis.Miniredis
+	limiter domain.RateLimiter
+}
+
+func TestRateLimitSuite(t *testing.T) {
+	suite.Run(t, new(rateLimitSuite))
+}
+
+func (s *rateLimitSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	s.redis = miniredis.RunT(s.T())
+	s.redis.SetTime(time.Now())
+
+	limiter, err := redisInfra.NewRateLimiter(redis.NewClient(&redis.Options{Addr: s.redis.Addr(), MaxRetries: -1}))
+	s.Require().NoError(err)
+	s.limiter = limiter
+}
+
+func (s *rateLimitSuite) newEngine(policy config.RateLimitPolicy, userID int64) *gin.Engine {
+	e := gin.New()
+	e.GET("/", func(c *gin.Context) {
+		if userID > 0 {
+			c.Set(AuthPayloadKey, &domain.AuthClaims{UserID: userID})
+		}
+		c.Next()
+	}, RateLimit(s.limiter, "test", policy), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return e
+}
+
+func (s *rateLimitSuite) do(e *gin.Engine, ip string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = ip + ":1234"
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, req)
+	return w
+}
+
+func (s *rateLimitSuite) TestLimitByIP() {
+	e := s.newEngine(config.RateLimitPolicy{Limit: 3, Window: time.Minute}, 0)
+
+	for i := 2; i >= 0; i-- {
+		w := s.do(e, "10.0.0.1")
+		s.Equal(http.StatusOK, w.Code)
+		s.Equal("3", w.Header().Get(headerRateLimitLimit))
+		s.Equal(strconv.Itoa(i), w.Header().Get(headerRateLimitRemaining))
+	}
+
+	w := s.do(e, "10.0.0.1")
+	s.Equal(http.StatusTooManyRequests, w.Code)
+	s.Equal("0", w.Header().Get(headerRateLimitRemaining))
+	s.Equal("20", w.Header().Get(headerRetryAfter))
+	s.Equal("60", w.Header().Get(headerRateLimitReset))
+
+	s.Run("other_ip", func() {
+		s.Equal(http.StatusOK, s.do(e, "10.0.0.2").Code)
+	})
+
+	s.Run("refills", func() {
+		s.redis.SetTime(time.Now().Add(21 * time.Second))
+		w := s.do(e, "10.0.0.1")
+		s.Equal(http.StatusOK, w.Code)
+		s.Equal("0", w.Header().Get(headerRateLimitRemaining))
+	})
+}
+
+func (s *rateLimitSuite) TestLimitByUser() {
+	e := s.newEngine(config.RateLimitPolicy{Limit: 1, Window: time.Minute}, 7)
+
+	s.Equal(http.StatusOK, s.do(e, "10.0.0.1").Code)
+	s.Equal(http.StatusTooManyRequests, s.do(e, "10.0.0.2").Code)
+	s.True(s.redis.Exists(domain.GetRateLimitKey("test", "user:7")))
+}
+
+func (s *rateLimitSuite) TestFailOpen() {
+	e := s.newEngine(config.RateLimitPolicy{Limit: 1, Window: time.Minute}, 0)
+	s.redis.Close()
+
+	for range 3 {
+		w := s.do(e, "10.0.0.1")
+		s.Equal(http.StatusOK, w.Code)
+		s.Empty(w.Header().Get(headerRateLimitLimit))
+	}
+}
+
+func (s *rateLimitSuite) TestDisabledPolicy() {
+	e := s.newEngine(config.RateLimitPolicy{}, 0)
+
+	for range 3 {
+		s.Equal(http.StatusOK, s.do(e, "10.0.0.1").Code)
+	}
+	s.Empty(s.redis.Keys())
+}
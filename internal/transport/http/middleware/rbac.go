@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+// RequireRole lets through callers holding one of roles. It must run after
+// Auth.
+func RequireRole(roles ...domain.UserRole) gin.HandlerFunc {
+	return authorize(func(role domain.UserRole) bool {
+		return slices.Contains(roles, role)
+	})
+}
+
+// RequirePermission lets through callers whose role grants p. It must run
+// after Auth.
+func RequirePermission(p domain.Permission) gin.HandlerFunc {
+	return authorize(func(role domain.UserRole) bool {
+		return role.Can(p)
+	})
+}
+
+func authorize(allowed func(role domain.UserRole) bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, err := GetAuthClaims(c)
+		if err != nil {
+			pkg.Unauthorized(c, err.Error())
+			c.Abort()
+			return
+		}
+
+		if !allowed(claims.Role) {
+			pkg.Forbidden(c, "insufficient permissions")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
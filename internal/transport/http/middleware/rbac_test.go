@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"air-social/internal/domain"
+)
+
+type rbacSuite struct {
+	suite.Suite
+}
+
+func TestRBACSuite(t *testing.T) {
+	suite.Run(t, new(rbacSuite))
+}
+
+func (s *rbacSuite) do(claims *domain.AuthClaims, guard gin.HandlerFunc) int {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	e.GET("/", func(c *gin.Context) {
+		if claims != nil {
+			c.Set(AuthPayloadKey, claims)
+		}
+		c.Next()
+	}, guard, func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	return w.Code
+}
+
+func (s *rbacSuite) TestRequireRole() {
+	guard := RequireRole(domain.UserRoleModerator, domain.UserRoleAdmin)
+
+	s.Equal(http.StatusUnauthorized, s.do(nil, guard))
+	s.Equal(http.StatusForbidden, s.do(&domain.AuthClaims{UserID: 1, Role: domain.UserRoleUser}, guard))
+	s.Equal(http.StatusOK, s.do(&domain.AuthClaims{UserID: 1, Role: domain.UserRoleModerator}, guard))
+	s.Equal(http.StatusOK, s.do(&domain.AuthClaims{UserID: 1, Role: domain.UserRoleAdmin}, guard))
+}
+
+func (s *rbacSuite) TestRequirePermission() {
+	tests := []struct {
+		role       domain.UserRole
+		permission domain.Permission
+		want       int
+	}{
+		{domain.UserRoleUser, domain.PermissionUsersRead, http.StatusForbidden},
+		{domain.UserRoleModerator, domain.PermissionUsersRead, http.StatusOK},
+		{domain.UserRoleModerator, domain.PermissionUsersManage, http.StatusForbidden},
+		{domain.UserRoleModerator, domain.PermissionSystemHealth, http.StatusForbidden},
+		{domain.UserRoleAdmin, domain.PermissionUsersManage, http.StatusOK},
+		{domain.UserRoleAdmin, domain.PermissionSystemHealth, http.StatusOK},
+		{"", domain.PermissionUsersRead, http.StatusForbidden},
+	}
+
+	for _, tc := range tests {
+		s.Run(string(tc.role)+"_"+string(tc.permission), func() {
+			claims := &domain.AuthClaims{UserID: 1, Role: tc.role}
+			s.Equal(tc.want, s.do(claims, RequirePermission(tc.permission)))
+		})
+	}
+}
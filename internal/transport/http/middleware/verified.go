@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+
+	"air-social/internal/service"
+	"air-social/pkg"
+)
+
+// RequireVerified lets through callers whose email is verified. It looks the
+// user up rather than trusting the token, so that a verification takes effect
+// at once. It must run after Auth.
+func RequireVerified(users service.UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, err := GetAuthClaims(c)
+		if err != nil {
+			pkg.Unauthorized(c, err.Error())
+			c.Abort()
+			return
+		}
+
+		user, err := users.GetByID(c.Request.Context(), claims.UserID)
+		if err != nil {
+			if errors.Is(err, pkg.ErrNotFound) {
+				err = pkg.ErrUnauthorized
+			}
+			pkg.HandleServiceError(c, err)
+			c.Abort()
+			return
+		}
+
+		if !user.Verified {
+			pkg.HandleServiceError(c, pkg.ErrEmailNotVerified)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
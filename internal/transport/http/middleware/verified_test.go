@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"air-social/internal/config"
+	"air-social/internal/domain"
+	"air-social/internal/mocks"
+	"air-social/pkg"
+)
+
+type verifiedSuite struct {
+	suite.Suite
+}
+
+func TestVerifiedSuite(t *testing.T) {
+	suite.Run(t, new(verifiedSuite))
+}
+
+func (s *verifiedSuite) do(claims *domain.AuthClaims, guard gin.HandlerFunc) int {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	e.POST("/", func(c *gin.Context) {
+		if claims != nil {
+			c.Set(AuthPayloadKey, claims)
+		}
+		c.Next()
+	}, guard, func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", nil))
+	return w.Code
+}
+
+func (s *verifiedSuite) TestRequireVerified() {
+	claims := &domain.AuthClaims{UserID: 1, Role: domain.UserRoleUser}
+
+	tests := []struct {
+		name   string
+		claims *domain.AuthClaims
+		user   *domain.User
+		err    error
+		want   int
+	}{
+		{name: "no_claims", want: http.StatusUnauthorized},
+		{name: "verified", claims: claims, user: &domain.User{ID: 1, Verified: true}, want: http.StatusOK},
+		{name: "unverified", claims: claims, user: &domain.User{ID: 1}, want: http.StatusForbidden},
+		{name: "user_gone", claims: claims, err: pkg.ErrNotFound, want: http.StatusUnauthorized},
+		{name: "lookup_error", claims: claims, err: assert.AnError, want: http.StatusInternalServerError},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			users := mocks.NewUserService(s.T())
+			if tc.claims != nil {
+				users.EXPECT().GetByID(mock.Anything, tc.claims.UserID).Return(tc.user, tc.err).Once()
+			}
+
+			s.Equal(tc.want, s.do(tc.claims, RequireVerified(users)))
+		})
+	}
+}
+
+func (s *verifiedSuite) TestManagerGatesConfiguredActions() {
+	cfg := config.Config{Verify: config.VerificationConfig{RequiredFor: []string{"posting", "unknown"}}}
+	users := mocks.NewUserService(s.T())
+	m := NewManager(cfg, nil, users, nil)
+	claims := &domain.AuthClaims{UserID: 1}
+
+	users.EXPECT().GetByID(mock.Anything, int64(1)).Return(&domain.User{ID: 1}, nil).Once()
+	s.Equal(http.StatusForbidden, s.do(claims, m.RequireVerified(domain.GatedActionPosting)))
+
+	s.Equal(http.StatusOK, s.do(claims, m.RequireVerified(domain.GatedActionMessaging)))
+	s.Equal(http.StatusOK, s.do(claims, m.RequireVerified(domain.GatedActionUploads)))
+}
@@ -14,6 +14,7 @@ import (
 	"air-social/internal/domain"
 	"air-social/internal/transport/http/handler"
 	"air-social/internal/transport/http/middleware"
+	"air-social/internal/transport/ws"
 	"air-social/pkg"
 	"air-social/templates"
 )
@@ -21,6 +22,7 @@ import (
 const (
 	Health     = "/health"
 	SwaggerAny = "/swagger/*any"
+	JWKS       = "/.well-known/jwks.json"
 )
 
 const (
@@ -31,7 +33,15 @@ const (
 	ResetPassword  = "/reset-password"
 	ForgotPassword = "/forgot-password"
 	VerifyEmail    = "/verify-email"
+	UnlockAccount  = "/unlock-account"
 	Logout         = "/logout"
+	TwoFAVerify    = "/2fa/verify"
+	OAuthAuthorize = "/oauth/:provider/authorize"
+	OAuthCallback  = "/oauth/:provider/callback"
+	MagicLink      = "/magic-link"
+	ResendVerify   = "/verify-email/resend"
+	EmailConfirm   = "/email-change/confirm"
+	EmailCancel    = "/email-change/cancel"
 )
 
 const (
@@ -39,6 +49,15 @@ const (
 	Me           = "/me"
 	Password     = "/password"
 	ProfileImage = "/profile-image"
+	MySessions   = "/me/sessions"
+	MySession    = "/me/sessions/:id"
+	MyEmail      = "/me/email"
+)
+
+const (
+	TwoFAEnroll  = "/me/2fa/enroll"
+	TwoFAConfirm = "/me/2fa/confirm"
+	TwoFADisable = "/me/2fa/disable"
 )
 
 const (
@@ -47,6 +66,80 @@ const (
 	ConfirmUpload   = "/confirm"
 )
 
+const (
+	PostGroup = "/posts"
+	ByID      = "/:id"
+	UserPosts = "/:id/posts"
+)
+
+const (
+	UserFollow    = "/:id/follow"
+	UserFollowers = "/:id/followers"
+	UserFollowing = "/:id/following"
+)
+
+const (
+	FeedGroup = "/feed"
+)
+
+const (
+	CommentGroup   = "/comments"
+	PostComments   = "/:id/comments"
+	CommentReplies = "/:id/replies"
+)
+
+const (
+	Reactions = "/:id/reactions"
+)
+
+const (
+	CommunityGroup = "/groups"
+	GroupJoin      = "/:id/join"
+	GroupLeave     = "/:id/leave"
+	GroupInvites   = "/:id/invites"
+	GroupMembers   = "/:id/members"
+	GroupMember    = "/:id/members/:userId"
+	GroupRequests  = "/:id/requests"
+	GroupRequest   = "/:id/requests/:userId"
+	GroupBan       = "/:id/bans/:userId"
+	GroupPosts     = "/:id/posts"
+)
+
+const (
+	AdminGroup        = "/admin"
+	AdminUsers        = "/users"
+	AdminUser         = "/users/:id"
+	AdminUserSessions = "/users/:id/sessions"
+	AdminUserLogout   = "/users/:id/logout"
+	AdminUserSuspend  = "/users/:id/suspend"
+	AdminUserBan      = "/users/:id/ban"
+	AdminUserUnban    = "/users/:id/unban"
+	AdminUserVerify   = "/users/:id/verify-email"
+	AdminUserImage    = "/users/:id/profile-image"
+	AuditLogs         = "/audit-logs"
+)
+
+const (
+	WSGroup = "/ws"
+)
+
+const (
+	PresenceGroup    = "/presence"
+	PresenceSettings = "/settings"
+)
+
+const (
+	ConversationGroup     = "/conversations"
+	DirectConversation    = "/direct"
+	ConversationMessages  = "/:id/messages"
+	GroupConversation     = "/groups"
+	ConversationMembers   = "/:id/members"
+	ConversationMember    = "/:id/members/:userId"
+	ConversationLeave     = "/:id/leave"
+	ConversationRead      = "/:id/read"
+	ConversationDelivered = "/:id/delivered"
+)
+
 func NewServer(
 	cfg config.Config,
 	urls domain.URLFactory,
@@ -54,16 +147,42 @@ func NewServer(
 	authH *handler.AuthHandler,
 	userH *handler.UserHandler,
 	mediaH *handler.MediaHandler,
+	postH *handler.PostHandler,
+	followH *handler.FollowHandler,
+	feedH *handler.FeedHandler,
+	commentH *handler.CommentHandler,
+	reactionH *handler.ReactionHandler,
+	groupH *handler.GroupHandler,
+	chatH *handler.ChatHandler,
+	presenceH *handler.PresenceHandler,
+	sessionH *handler.SessionHandler,
+	twoFAH *handler.TwoFactorHandler,
+	adminH *handler.AdminHandler,
+	keyH *handler.KeyHandler,
 	healthH *handler.HealthHandler,
+	hub *ws.Hub,
 ) *http.Server {
-	e := setupEngine()
+	e := setupEngine(cfg.Server)
+	wellKnownRoutes(e, keyH)
 
 	v := e.Group(urls.APIRouterPath())
 	{
 		commonRoutes(v, healthH, mw)
 		authRoutes(v, authH, mw)
 		userRoutes(v, userH, mw)
+		sessionRoutes(v, sessionH, mw)
+		twoFactorRoutes(v, twoFAH, mw)
 		mediaRoutes(v, mediaH, mw)
+		postRoutes(v, postH, mw)
+		followRoutes(v, followH, mw)
+		feedRoutes(v, feedH, mw)
+		commentRoutes(v, commentH, mw)
+		reactionRoutes(v, reactionH, mw)
+		groupRoutes(v, groupH, mw)
+		chatRoutes(v, chatH, mw)
+		presenceRoutes(v, presenceH, mw)
+		adminRoutes(v, adminH, mw)
+		wsRoutes(v, hub, mw)
 	}
 
 	return &http.Server{
@@ -75,11 +194,16 @@ func NewServer(
 	}
 }
 
-func setupEngine() *gin.Engine {
+func setupEngine(cfg config.ServerConfig) *gin.Engine {
 	e := gin.New()
 	e.Use(gin.Logger())
 	e.Use(gin.Recovery())
-	e.SetTrustedProxies(nil)
+	if err := e.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		// Trust nobody rather than gin's default of everybody, which would
+		// let clients pick their own IP and slip past the rate limits.
+		pkg.Log().Errorw("[CONFIG ERROR]", "from", "trusted_proxies", "error", err)
+		e.SetTrustedProxies(nil)
+	}
 	e.HandleMethodNotAllowed = true
 
 	e.SetHTMLTemplate(
@@ -106,49 +230,285 @@ func setupEngine() *gin.Engine {
 func commonRoutes(rg *gin.RouterGroup, h *handler.HealthHandler, mw *middleware.Manager) {
 	{
 		rg.GET("", h.Welcome)
-		rg.GET(Health, mw.Basic, h.HealthCheck)
+		rg.GET(Health, mw.Auth, mw.RequirePermission(domain.PermissionSystemHealth), h.HealthCheck)
 		rg.GET(SwaggerAny, ginSwagger.WrapHandler(swaggerFiles.Handler))
 	}
 }
 
+// wellKnownRoutes sit at the root, where clients look for them, rather than
+// under the API path.
+func wellKnownRoutes(e *gin.Engine, h *handler.KeyHandler) {
+	e.GET(JWKS, h.JWKS)
+}
+
 func authRoutes(rg *gin.RouterGroup, h *handler.AuthHandler, mw *middleware.Manager) {
 	a := rg.Group(AuthGroup)
 	{
 		a.GET(ResetPassword, h.ShowResetPasswordPage)
 		a.GET(VerifyEmail, h.VerifyEmail)
+		a.GET(UnlockAccount, h.UnlockAccount)
+		a.GET(MagicLink, mw.LoginLimit, h.MagicLinkLogin)
+		a.GET(EmailConfirm, h.ConfirmEmailChange)
+		a.GET(EmailCancel, h.CancelEmailChange)
+		a.POST(OAuthAuthorize, mw.LoginLimit, h.OAuthAuthorize)
 
 		j := a.Group("").Use(mw.JSONOnly)
 		{
-			j.POST(Register, h.Register)
-			j.POST(Login, h.Login)
+			j.POST(Register, mw.RegisterLimit, h.Register)
+			j.POST(Login, mw.LoginLimit, h.Login)
+			j.POST(TwoFAVerify, mw.LoginLimit, h.VerifyTwoFactor)
+			j.POST(OAuthCallback, mw.LoginLimit, h.OAuthCallback)
 			j.POST(Refresh, h.Refresh)
-			j.POST(ForgotPassword, h.ForgotPassword)
+			j.POST(ForgotPassword, mw.ForgotPasswordLimit, h.ForgotPassword)
+			j.POST(MagicLink, mw.MagicLinkLimit, h.RequestMagicLink)
 			j.POST(ResetPassword, h.ResetPassword)
 		}
 		p := a.Group("").Use(mw.Auth)
 		{
 			p.POST(Logout, h.Logout)
+			p.POST(ResendVerify, mw.VerifyEmailLimit, h.ResendVerification)
 		}
 	}
+
+	u := rg.Group(UserGroup, mw.Auth).Use(mw.JSONOnly)
+	{
+		u.POST(MyEmail, mw.EmailChangeLimit, h.ChangeEmail)
+	}
 }
 
 func userRoutes(rg *gin.RouterGroup, h *handler.UserHandler, mw *middleware.Manager) {
 	p := rg.Group(UserGroup, mw.Auth)
 	{
 		p.GET(Me, h.Profile)
+		p.GET(ByID, h.PublicProfile)
 
 		j := p.Group("").Use(mw.JSONOnly)
 		{
 			j.PUT(Password, h.ChangePassword)
 			j.PATCH(Me, h.UpdateProfile)
+			j.DELETE(Me, h.DeleteAccount)
 			j.POST(ProfileImage+ConfirmUpload, h.ConfirmFileUpload)
 		}
 	}
 }
 
+func sessionRoutes(rg *gin.RouterGroup, h *handler.SessionHandler, mw *middleware.Manager) {
+	u := rg.Group(UserGroup, mw.Auth)
+	{
+		u.GET(MySessions, h.List)
+		u.DELETE(MySession, h.Revoke)
+	}
+}
+
+func twoFactorRoutes(rg *gin.RouterGroup, h *handler.TwoFactorHandler, mw *middleware.Manager) {
+	u := rg.Group(UserGroup, mw.Auth)
+	{
+		u.POST(TwoFAEnroll, h.Enroll)
+
+		j := u.Group("").Use(mw.JSONOnly)
+		{
+			j.POST(TwoFAConfirm, h.Confirm)
+			j.POST(TwoFADisable, h.Disable)
+		}
+	}
+}
+
 func mediaRoutes(rg *gin.RouterGroup, h *handler.MediaHandler, mw *middleware.Manager) {
 	m := rg.Group(MediaGroup, mw.Auth)
 	{
-		m.POST(PresignedUpload, h.PresignedUpload)
+		m.POST(PresignedUpload, mw.RequireVerified(domain.GatedActionUploads), mw.PresignedUploadLimit, h.PresignedUpload)
+	}
+}
+
+func postRoutes(rg *gin.RouterGroup, h *handler.PostHandler, mw *middleware.Manager) {
+	p := rg.Group(PostGroup, mw.Auth)
+	{
+		p.GET(ByID, h.Get)
+		p.DELETE(ByID, h.Delete)
+
+		j := p.Group("").Use(mw.JSONOnly)
+		{
+			j.POST("", mw.RequireVerified(domain.GatedActionPosting), h.Create)
+			j.PATCH(ByID, h.Update)
+		}
+	}
+
+	u := rg.Group(UserGroup, mw.Auth)
+	{
+		u.GET(UserPosts, h.ListByAuthor)
+	}
+
+	g := rg.Group(CommunityGroup, mw.Auth)
+	{
+		g.GET(GroupPosts, h.ListByGroup)
+
+		j := g.Group("").Use(mw.JSONOnly)
+		{
+			j.POST(GroupPosts, mw.RequireVerified(domain.GatedActionPosting), h.CreateInGroup)
+		}
+	}
+}
+
+func followRoutes(rg *gin.RouterGroup, h *handler.FollowHandler, mw *middleware.Manager) {
+	u := rg.Group(UserGroup, mw.Auth)
+	{
+		u.POST(UserFollow, h.Follow)
+		u.DELETE(UserFollow, h.Unfollow)
+		u.GET(UserFollowers, h.Followers)
+		u.GET(UserFollowing, h.Following)
 	}
 }
+
+func feedRoutes(rg *gin.RouterGroup, h *handler.FeedHandler, mw *middleware.Manager) {
+	f := rg.Group(FeedGroup, mw.Auth)
+	{
+		f.GET("", h.Home)
+	}
+}
+
+func commentRoutes(rg *gin.RouterGroup, h *handler.CommentHandler, mw *middleware.Manager) {
+	p := rg.Group(PostGroup, mw.Auth)
+	{
+		p.GET(PostComments, h.ListByPost)
+
+		j := p.Group("").Use(mw.JSONOnly)
+		{
+			j.POST(PostComments, mw.RequireVerified(domain.GatedActionPosting), h.Create)
+		}
+	}
+
+	c := rg.Group(CommentGroup, mw.Auth)
+	{
+		c.GET(CommentReplies, h.ListReplies)
+		c.DELETE(ByID, h.Delete)
+
+		j := c.Group("").Use(mw.JSONOnly)
+		{
+			j.PATCH(ByID, h.Update)
+		}
+	}
+}
+
+func reactionRoutes(rg *gin.RouterGroup, h *handler.ReactionHandler, mw *middleware.Manager) {
+	p := rg.Group(PostGroup, mw.Auth)
+	{
+		p.GET(Reactions, h.ListPostReactors)
+		p.DELETE(Reactions, h.UnreactToPost)
+
+		j := p.Group("").Use(mw.JSONOnly)
+		{
+			j.PUT(Reactions, h.ReactToPost)
+		}
+	}
+
+	c := rg.Group(CommentGroup, mw.Auth)
+	{
+		c.GET(Reactions, h.ListCommentReactors)
+		c.DELETE(Reactions, h.UnreactToComment)
+
+		j := c.Group("").Use(mw.JSONOnly)
+		{
+			j.PUT(Reactions, h.ReactToComment)
+		}
+	}
+}
+
+func groupRoutes(rg *gin.RouterGroup, h *handler.GroupHandler, mw *middleware.Manager) {
+	g := rg.Group(CommunityGroup, mw.Auth)
+	{
+		g.GET("", h.List)
+		g.GET(ByID, h.Get)
+		g.DELETE(ByID, h.Delete)
+		g.POST(GroupJoin, h.Join)
+		g.POST(GroupLeave, h.Leave)
+		g.GET(GroupMembers, h.ListMembers)
+		g.DELETE(GroupMember, h.RemoveMember)
+		g.GET(GroupRequests, h.ListRequests)
+		g.POST(GroupRequest, h.ApproveRequest)
+		g.DELETE(GroupRequest, h.RejectRequest)
+		g.PUT(GroupBan, h.Ban)
+		g.DELETE(GroupBan, h.Unban)
+
+		j := g.Group("").Use(mw.JSONOnly)
+		{
+			j.POST("", h.Create)
+			j.PATCH(ByID, h.Update)
+			j.POST(GroupInvites, h.Invite)
+			j.PATCH(GroupMember, h.UpdateMemberRole)
+		}
+	}
+}
+
+func chatRoutes(rg *gin.RouterGroup, h *handler.ChatHandler, mw *middleware.Manager) {
+	c := rg.Group(ConversationGroup, mw.Auth)
+	{
+		c.GET("", h.List)
+		c.GET(ByID, h.Get)
+		c.GET(ConversationMessages, h.ListMessages)
+		c.POST(ConversationLeave, h.Leave)
+		c.DELETE(ConversationMember, h.RemoveMember)
+
+		j := c.Group("").Use(mw.JSONOnly)
+		{
+			j.POST(DirectConversation, mw.RequireVerified(domain.GatedActionMessaging), h.CreateDirect)
+			j.POST(GroupConversation, mw.RequireVerified(domain.GatedActionMessaging), h.CreateGroup)
+			j.PATCH(ByID, h.UpdateGroup)
+			j.POST(ConversationMessages, mw.RequireVerified(domain.GatedActionMessaging), h.SendMessage)
+			j.POST(ConversationMembers, h.AddMembers)
+			j.PATCH(ConversationMember, h.UpdateMemberRole)
+			j.POST(ConversationDelivered, h.MarkDelivered)
+			j.POST(ConversationRead, h.MarkRead)
+		}
+	}
+}
+
+func presenceRoutes(rg *gin.RouterGroup, h *handler.PresenceHandler, mw *middleware.Manager) {
+	p := rg.Group(PresenceGroup, mw.Auth)
+	{
+		p.GET("", h.Lookup)
+
+		j := p.Group("").Use(mw.JSONOnly)
+		{
+			j.PUT(PresenceSettings, h.UpdateSettings)
+		}
+	}
+}
+
+func adminRoutes(rg *gin.RouterGroup, h *handler.AdminHandler, mw *middleware.Manager) {
+	a := rg.Group(AdminGroup, mw.Auth)
+	{
+		r := a.Group("", mw.RequirePermission(domain.PermissionUsersRead))
+		{
+			r.GET(AdminUsers, h.SearchUsers)
+			r.GET(AdminUser, h.GetUser)
+			r.GET(AdminUserSessions, h.ListUserSessions)
+		}
+
+		m := a.Group("", mw.RequirePermission(domain.PermissionUsersModerate))
+		{
+			m.DELETE(AdminUserImage, h.ResetProfileImage)
+
+			j := m.Group("").Use(mw.JSONOnly)
+			{
+				j.POST(AdminUserSuspend, h.Suspend)
+			}
+		}
+
+		g := a.Group("", mw.RequirePermission(domain.PermissionUsersManage))
+		{
+			g.GET(AuditLogs, h.ListAuditLogs)
+			g.POST(AdminUserLogout, h.ForceLogout)
+			g.POST(AdminUserVerify, h.VerifyEmail)
+
+			j := g.Group("").Use(mw.JSONOnly)
+			{
+				j.POST(AdminUserBan, h.Ban)
+				j.POST(AdminUserUnban, h.Unban)
+			}
+		}
+	}
+}
+
+func wsRoutes(rg *gin.RouterGroup, hub *ws.Hub, mw *middleware.Manager) {
+	rg.GET(WSGroup, mw.WSAuth, hub.Serve)
+}
@@ -44,6 +44,22 @@ func (r *URLFactoryImpl) ResetPasswordLink(token string) string {
 	return fmt.Sprintf("%s%s%s?token=%s", r.apiBaseURL(), AuthGroup, ResetPassword, token)
 }
 
+func (r *URLFactoryImpl) UnlockAccountLink(token string) string {
+	return fmt.Sprintf("%s%s%s?token=%s", r.apiBaseURL(), AuthGroup, UnlockAccount, token)
+}
+
+func (r *URLFactoryImpl) MagicLoginLink(token string) string {
+	return fmt.Sprintf("%s%s%s?token=%s", r.apiBaseURL(), AuthGroup, MagicLink, token)
+}
+
+func (r *URLFactoryImpl) ConfirmEmailChangeLink(token string) string {
+	return fmt.Sprintf("%s%s%s?token=%s", r.apiBaseURL(), AuthGroup, EmailConfirm, token)
+}
+
+func (r *URLFactoryImpl) CancelEmailChangeLink(token string) string {
+	return fmt.Sprintf("%s%s%s?token=%s", r.apiBaseURL(), AuthGroup, EmailCancel, token)
+}
+
 func (r *URLFactoryImpl) SwaggerUI() string {
 	return fmt.Sprintf("%s/swagger/index.html", r.apiBaseURL())
 }
@@ -45,28 +45,7 @@ func (w *Worker) Start(ctx context.Context, wg *sync.WaitGroup) error {
 		return err
 	}
 
-	if err := setupExchange(ch, w.eCfg); err != nil {
-		ch.Close()
-		return err
-	}
-
-	queueName, err := setupQueue(ch, w.qCfg)
-	if err != nil {
-		ch.Close()
-		return err
-	}
-
-	if err := bindQueue(ch, queueName, w.eCfg, w.qCfg); err != nil {
-		ch.Close()
-		return err
-	}
-
-	if err := setupQos(ch); err != nil {
-		ch.Close()
-		return err
-	}
-
-	msgs, err := startConsume(ch, queueName)
+	msgs, err := rabbitmq.Consume(ch, w.eCfg, w.qCfg)
 	if err != nil {
 		ch.Close()
 		return err
@@ -0,0 +1,54 @@
+package feed
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+func consumeLoop(
+	ctx context.Context,
+	msgs <-chan amqp.Delivery,
+	disp domain.EventHandler,
+	done <-chan struct{},
+	wg *sync.WaitGroup,
+) {
+	defer wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-done:
+			return
+		case msg, ok := <-msgs:
+			if !ok {
+				return
+			}
+			handleMessage(ctx, msg, disp)
+		}
+	}
+}
+
+func handleMessage(ctx context.Context, msg amqp.Delivery, disp domain.EventHandler) {
+	var evt domain.EventPayload
+	if err := json.Unmarshal(msg.Body, &evt); err != nil {
+		pkg.Log().Errorw("failed to unmarshal event", "error", err, "msg_id", msg.MessageId)
+		msg.Nack(false, false)
+		return
+	}
+
+	if err := disp.Handle(ctx, evt); err != nil {
+		// Requeue once for transient failures, then leave it to the dead letter queue.
+		requeue := !msg.Redelivered && !pkg.IsPermanentError(err)
+		pkg.Log().Errorw("fan-out failed", "error", err, "msg_id", msg.MessageId, "requeue", requeue)
+		msg.Nack(false, requeue)
+		return
+	}
+
+	msg.Ack(false)
+}
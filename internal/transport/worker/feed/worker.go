@@ -0,0 +1,69 @@
+package feed
+
+import (
+	"context"
+	"sync"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"air-social/internal/domain"
+	"air-social/internal/infrastructure/rabbitmq"
+)
+
+// Worker fans post.created events out into home timelines.
+// Pushing a post ID into a sorted set is idempotent, so redeliveries are safe
+// and no processed-message bookkeeping is needed.
+type Worker struct {
+	conn *amqp.Connection
+	eCfg rabbitmq.ExchangeConfig
+	qCfg rabbitmq.QueueConfig
+	disp domain.EventHandler
+
+	ch   *amqp.Channel
+	done chan struct{}
+	once sync.Once
+}
+
+func NewFanoutWorker(
+	conn *amqp.Connection,
+	disp domain.EventHandler,
+	eCfg rabbitmq.ExchangeConfig,
+	qCfg rabbitmq.QueueConfig,
+) *Worker {
+	return &Worker{
+		conn: conn,
+		eCfg: eCfg,
+		qCfg: qCfg,
+		disp: disp,
+		done: make(chan struct{}),
+	}
+}
+
+func (w *Worker) Start(ctx context.Context, wg *sync.WaitGroup) error {
+	ch, err := w.conn.Channel()
+	if err != nil {
+		return err
+	}
+
+	msgs, err := rabbitmq.Consume(ch, w.eCfg, w.qCfg)
+	if err != nil {
+		ch.Close()
+		return err
+	}
+
+	w.ch = ch
+	wg.Add(1)
+	go consumeLoop(ctx, msgs, w.disp, w.done, wg)
+
+	return nil
+}
+
+func (w *Worker) Stop() error {
+	w.once.Do(func() {
+		close(w.done)
+	})
+	if w.ch != nil {
+		return w.ch.Close()
+	}
+	return nil
+}
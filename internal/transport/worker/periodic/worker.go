@@ -0,0 +1,63 @@
+package periodic
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"air-social/pkg"
+)
+
+// Worker runs a job every interval until stopped. A failed run is logged and
+// the job simply runs again on the next tick.
+type Worker struct {
+	name     string
+	job      func(ctx context.Context) error
+	interval time.Duration
+
+	done chan struct{}
+	once sync.Once
+}
+
+// NewWorker creates a worker for job. name identifies the job in the logs.
+func NewWorker(name string, job func(ctx context.Context) error, interval time.Duration) *Worker {
+	return &Worker{
+		name:     name,
+		job:      job,
+		interval: interval,
+		done:     make(chan struct{}),
+	}
+}
+
+func (w *Worker) Start(ctx context.Context, wg *sync.WaitGroup) error {
+	wg.Add(1)
+	go w.loop(ctx, wg)
+	return nil
+}
+
+func (w *Worker) Stop() error {
+	w.once.Do(func() {
+		close(w.done)
+	})
+	return nil
+}
+
+func (w *Worker) loop(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.done:
+			return
+		case <-ticker.C:
+			if err := w.job(ctx); err != nil {
+				pkg.Log().Errorw("periodic job failed", "job", w.name, "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+
+	"air-social/internal/domain"
+)
+
+// Frame is an encoded message addressed to every connection of one user.
+type Frame struct {
+	UserID int64
+	Data   []byte
+	// Close asks for the connections of the user to be closed; Data is empty.
+	Close bool
+}
+
+// Broker relays frames between hub instances, so a message reaches a user
+// whichever API instance holds their sockets. A hub subscribes to the users it
+// currently holds connections for and receives their frames through Frames.
+type Broker interface {
+	Publish(ctx context.Context, userIDs []int64, data []byte) error
+	// Disconnect asks every instance to close the connections of the users.
+	Disconnect(ctx context.Context, userIDs []int64) error
+	Subscribe(ctx context.Context, userID int64) error
+	Unsubscribe(ctx context.Context, userID int64) error
+	// Frames streams the frames published for subscribed users until Close.
+	Frames() <-chan Frame
+	Close() error
+}
+
+// RedisBroker uses one pub/sub channel per user. Delivery is at most once:
+// frames published while no instance is subscribed are dropped.
+type RedisBroker struct {
+	client *redis.Client
+	pubsub *redis.PubSub
+	frames chan Frame
+}
+
+func NewRedisBroker(client *redis.Client) (*RedisBroker, error) {
+	if client == nil {
+		return nil, errors.New("redis client cannot nil")
+	}
+
+	b := &RedisBroker{
+		client: client,
+		// Subscribing without channels defers the connection to the first Subscribe.
+		pubsub: client.Subscribe(context.Background()),
+		frames: make(chan Frame, 256),
+	}
+	go b.receive()
+	return b, nil
+}
+
+func (b *RedisBroker) Publish(ctx context.Context, userIDs []int64, data []byte) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	_, err := b.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range userIDs {
+			pipe.Publish(ctx, domain.GetWSUserChannelKey(id), data)
+		}
+		return nil
+	})
+	return err
+}
+
+func (b *RedisBroker) Disconnect(ctx context.Context, userIDs []int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	_, err := b.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range userIDs {
+			pipe.Publish(ctx, domain.GetWSUserCloseChannelKey(id), "")
+		}
+		return nil
+	})
+	return err
+}
+
+func (b *RedisBroker) Subscribe(ctx context.Context, userID int64) error {
+	return b.pubsub.Subscribe(ctx, domain.GetWSUserChannelKey(userID), domain.GetWSUserCloseChannelKey(userID))
+}
+
+func (b *RedisBroker) Unsubscribe(ctx context.Context, userID int64) error {
+	return b.pubsub.Unsubscribe(ctx, domain.GetWSUserChannelKey(userID), domain.GetWSUserCloseChannelKey(userID))
+}
+
+func (b *RedisBroker) Frames() <-chan Frame {
+	return b.frames
+}
+
+// Close ends the subscription; Frames is closed once pending frames are drained.
+func (b *RedisBroker) Close() error {
+	return b.pubsub.Close()
+}
+
+func (b *RedisBroker) receive() {
+	defer close(b.frames)
+
+	// go-redis reconnects and resubscribes on its own; the channel is closed
+	// only by Close.
+	for msg := range b.pubsub.Channel() {
+		if rest, ok := strings.CutPrefix(msg.Channel, domain.WSUserCloseChannel); ok {
+			if id, err := strconv.ParseInt(rest, 10, 64); err == nil {
+				b.frames <- Frame{UserID: id, Close: true}
+			}
+			continue
+		}
+
+		id, err := strconv.ParseInt(strings.TrimPrefix(msg.Channel, domain.WSUserChannel), 10, 64)
+		if err != nil {
+			continue
+		}
+		b.frames <- Frame{UserID: id, Data: []byte(msg.Payload)}
+	}
+}
@@ -0,0 +1,71 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+
+	"air-social/internal/domain"
+	"air-social/internal/service"
+	"air-social/pkg"
+)
+
+// ChatHandler handles the chat messages clients send over the socket:
+// delivery and read acks, and typing indicators.
+type ChatHandler struct {
+	chatSvc service.ChatService
+}
+
+func NewChatHandler(chatSvc service.ChatService) *ChatHandler {
+	return &ChatHandler{
+		chatSvc: chatSvc,
+	}
+}
+
+// Register installs the handlers on h. It must be called before h.Run.
+func (ch *ChatHandler) Register(h *Hub) {
+	h.Handle(domain.RealtimeMessageDelivered, ch.delivered)
+	h.Handle(domain.RealtimeMessageRead, ch.read)
+	h.Handle(domain.RealtimeTyping, ch.typing)
+}
+
+func (ch *ChatHandler) delivered(ctx context.Context, c *Client, data json.RawMessage) error {
+	params, err := receiptParams(c, data)
+	if err != nil {
+		return err
+	}
+	return ch.chatSvc.MarkDelivered(ctx, params)
+}
+
+func (ch *ChatHandler) read(ctx context.Context, c *Client, data json.RawMessage) error {
+	params, err := receiptParams(c, data)
+	if err != nil {
+		return err
+	}
+	return ch.chatSvc.MarkRead(ctx, params)
+}
+
+func (ch *ChatHandler) typing(ctx context.Context, c *Client, data json.RawMessage) error {
+	var in domain.TypingData
+	if err := json.Unmarshal(data, &in); err != nil || in.ConversationID <= 0 {
+		return pkg.ErrInvalidData
+	}
+
+	return ch.chatSvc.Typing(ctx, domain.TypingParams{
+		UserID:         c.UserID(),
+		ConversationID: in.ConversationID,
+		Typing:         in.Typing,
+	})
+}
+
+func receiptParams(c *Client, data json.RawMessage) (domain.ReceiptParams, error) {
+	var in domain.ReceiptData
+	if err := json.Unmarshal(data, &in); err != nil || in.ConversationID <= 0 || in.Seq <= 0 {
+		return domain.ReceiptParams{}, pkg.ErrInvalidData
+	}
+
+	return domain.ReceiptParams{
+		UserID:         c.UserID(),
+		ConversationID: in.ConversationID,
+		Seq:            in.Seq,
+	}, nil
+}
@@ -0,0 +1,154 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+
+	"air-social/internal/domain"
+	"air-social/pkg"
+)
+
+// Client is one open connection. A user has one client per connected device.
+type Client struct {
+	hub      *Hub
+	conn     *websocket.Conn
+	userID   int64
+	deviceID string
+	// connID identifies this connection in the presence store.
+	connID string
+
+	// send is closed by the hub when the client is removed from the registry.
+	send chan []byte
+}
+
+func newClient(hub *Hub, conn *websocket.Conn, userID int64, deviceID string) *Client {
+	return &Client{
+		hub:      hub,
+		conn:     conn,
+		userID:   userID,
+		deviceID: deviceID,
+		connID:   uuid.NewString(),
+		send:     make(chan []byte, hub.cfg.SendBufferSize),
+	}
+}
+
+func (c *Client) UserID() int64 {
+	return c.userID
+}
+
+func (c *Client) DeviceID() string {
+	return c.deviceID
+}
+
+// Send queues msg for this connection only. Like Hub.SendToUsers it never
+// blocks: a full buffer evicts the client.
+func (c *Client) Send(msg domain.RealtimeMessage) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		pkg.Log().Errorw("[WS] marshal message failed", "type", msg.Type, "error", err)
+		return
+	}
+
+	c.hub.mu.RLock()
+	_, registered := c.hub.clients[c.userID][c]
+	queued := false
+	if registered {
+		select {
+		case c.send <- data:
+			queued = true
+		default:
+		}
+	}
+	c.hub.mu.RUnlock()
+
+	if registered && !queued {
+		pkg.Log().Warnw("[WS] evicting slow client", "user_id", c.userID, "device_id", c.deviceID)
+		c.hub.leave(c)
+	}
+}
+
+func (c *Client) sendError(message string) {
+	c.Send(domain.RealtimeMessage{
+		Type: domain.RealtimeError,
+		Data: domain.RealtimeErrorData{Message: message},
+	})
+}
+
+// readPump dispatches inbound messages until the connection fails, then
+// unregisters the client. Pongs extend the read deadline and count as
+// presence heartbeats.
+func (c *Client) readPump() {
+	c.track(PresenceTracker.Connect)
+	defer func() {
+		c.hub.leave(c)
+		c.conn.Close()
+		c.track(PresenceTracker.Disconnect)
+		c.hub.conns.Done()
+	}()
+
+	cfg := c.hub.cfg
+	c.conn.SetReadLimit(int64(cfg.MaxMessageSize))
+	_ = c.conn.SetReadDeadline(time.Now().Add(cfg.PongTimeout))
+	c.conn.SetPongHandler(func(string) error {
+		c.track(PresenceTracker.Heartbeat)
+		return c.conn.SetReadDeadline(time.Now().Add(cfg.PongTimeout))
+	})
+
+	for {
+		_, raw, err := c.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				pkg.Log().Debugw("[WS] read failed", "user_id", c.userID, "error", err)
+			}
+			return
+		}
+		c.hub.dispatch(c, raw)
+	}
+}
+
+// track reports a lifecycle event of this connection to the presence tracker,
+// bounded by the write timeout so a slow store cannot stall the read loop.
+func (c *Client) track(event func(PresenceTracker, context.Context, int64, string)) {
+	if c.hub.presence == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.hub.cfg.WriteTimeout)
+	defer cancel()
+	event(c.hub.presence, ctx, c.userID, c.connID)
+}
+
+// writePump is the only writer of the connection. It drains the send buffer,
+// pings on every interval, and sends a close frame once the hub closes send.
+func (c *Client) writePump() {
+	cfg := c.hub.cfg
+	ticker := time.NewTicker(cfg.PingInterval)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+		c.hub.conns.Done()
+	}()
+
+	for {
+		select {
+		case data, ok := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(cfg.WriteTimeout))
+			if !ok {
+				_ = c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				return
+			}
+
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(cfg.WriteTimeout))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
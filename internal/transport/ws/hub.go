@@ -1,16 +1,420 @@
 package ws
 
-// todo: websocket
-type Client struct {
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"air-social/internal/config"
+	"air-social/internal/domain"
+	"air-social/internal/transport/http/middleware"
+	"air-social/pkg"
+)
+
+// MessageHandler processes one inbound message of a registered type. A
+// returned error is reported back to the sending connection only.
+type MessageHandler func(ctx context.Context, client *Client, data json.RawMessage) error
+
+// brokerTimeout bounds one subscription change, so a stalled broker cannot
+// leave a user's connections waiting forever.
+const brokerTimeout = 5 * time.Second
+
+// PresenceTracker is told about the lifecycle of every connection: when it
+// opens, on every pong, and when it closes.
+type PresenceTracker interface {
+	Connect(ctx context.Context, userID int64, connID string)
+	Heartbeat(ctx context.Context, userID int64, connID string)
+	Disconnect(ctx context.Context, userID int64, connID string)
 }
 
+// Hub keeps the registry of open connections, indexed by user so every device
+// of a user receives the messages addressed to them. With a broker, outbound
+// messages go through it so users connected to other instances get them too.
 type Hub struct {
+	cfg      config.WSConfig
+	upgrader websocket.Upgrader
+	broker   Broker
+
+	register   chan *Client
+	unregister chan *Client
+	done       chan struct{}
+
+	// stateMu orders connection admission against Shutdown, so conns is never
+	// incremented once Shutdown has started waiting on it.
+	stateMu sync.Mutex
+	stopped bool
+
+	// mu guards clients. Run is the only writer; senders take the read lock so
+	// a client's send channel is never closed while a message is queued on it.
+	mu      sync.RWMutex
+	clients map[int64]map[*Client]struct{}
+
+	// subMu serializes subscription changes; subscribed holds the users the
+	// broker is subscribed to.
+	subMu      sync.Mutex
+	subscribed map[int64]struct{}
+
+	handlers map[domain.RealtimeType]MessageHandler
+	presence PresenceTracker
+
+	// conns tracks the pumps of every connection so Shutdown can wait for them.
+	conns sync.WaitGroup
+}
+
+// NewHub creates a hub. A nil broker keeps delivery local to this instance.
+func NewHub(cfg config.WSConfig, broker Broker) *Hub {
+	h := &Hub{
+		cfg:        cfg,
+		broker:     broker,
+		register:   make(chan *Client),
+		unregister: make(chan *Client),
+		done:       make(chan struct{}),
+		clients:    make(map[int64]map[*Client]struct{}),
+		subscribed: make(map[int64]struct{}),
+		handlers:   make(map[domain.RealtimeType]MessageHandler),
+	}
+	h.upgrader = websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		CheckOrigin:     originChecker(cfg.AllowedOrigins),
+	}
+	return h
+}
+
+// Handle registers the handler for an inbound message type. It must be called
+// before Run.
+func (h *Hub) Handle(msgType domain.RealtimeType, handler MessageHandler) {
+	h.handlers[msgType] = handler
 }
 
-func NewHub() *Hub {
-	return &Hub{}
+// TrackPresence reports connection lifecycle events to t. It must be called
+// before Run.
+func (h *Hub) TrackPresence(t PresenceTracker) {
+	h.presence = t
 }
 
+// Run owns registration until Shutdown is called. When the first device of a
+// user connects or the last one leaves, it has the broker subscription
+// updated in the background.
 func (h *Hub) Run() {
+	if h.broker != nil {
+		go h.consume()
+	}
+
+	for {
+		select {
+		case c := <-h.register:
+			h.mu.Lock()
+			devices, ok := h.clients[c.userID]
+			if !ok {
+				devices = make(map[*Client]struct{})
+				h.clients[c.userID] = devices
+			}
+			devices[c] = struct{}{}
+			h.mu.Unlock()
+
+			if !ok && h.broker != nil {
+				go h.syncSubscription(c.userID)
+			}
+
+		case c := <-h.unregister:
+			h.mu.Lock()
+			last := h.remove(c)
+			h.mu.Unlock()
+
+			if last && h.broker != nil {
+				go h.syncSubscription(c.userID)
+			}
+
+		case <-h.done:
+			h.mu.Lock()
+			for _, devices := range h.clients {
+				for c := range devices {
+					h.remove(c)
+				}
+			}
+			h.mu.Unlock()
+			return
+		}
+	}
+}
+
+// Shutdown stops accepting connections, sends a close frame to every client
+// and waits for their pumps to exit or for ctx to expire.
+func (h *Hub) Shutdown(ctx context.Context) error {
+	h.stateMu.Lock()
+	if !h.stopped {
+		h.stopped = true
+		close(h.done)
+		if h.broker != nil {
+			if err := h.broker.Close(); err != nil {
+				pkg.Log().Errorw("[WS] broker close failed", "error", err)
+			}
+		}
+	}
+	h.stateMu.Unlock()
+
+	finished := make(chan struct{})
+	go func() {
+		h.conns.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Serve upgrades an authenticated request and registers the connection.
+//
+//	@Summary		Open a realtime connection
+//	@Description	Upgrade to a WebSocket. Browsers may pass the access token as the access_token query parameter. Frames are JSON {"type", "data"}. Clients may send "message.delivered" and "message.read" with {"conversation_id", "seq"}, and "typing" with {"conversation_id", "typing"}.
+//	@Tags			Realtime
+//	@Security		BearerAuth
+//	@Param			access_token	query	string	false	"Access token, when the Authorization header cannot be set"
+//	@Success		101
+//	@Failure		401	{object}	pkg.Response
+//	@Router			/ws [get]
+func (h *Hub) Serve(c *gin.Context) {
+	claims, err := middleware.GetAuthClaims(c)
+	if err != nil {
+		pkg.HandleServiceError(c, err)
+		return
+	}
+
+	if !h.admit() {
+		pkg.HandleServiceError(c, pkg.ErrServiceUnavailable)
+		return
+	}
+
+	// The upgrader writes the HTTP error response itself on failure.
+	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		h.conns.Add(-2)
+		return
+	}
+
+	client := newClient(h, conn, claims.UserID, claims.DeviceID)
+	select {
+	case h.register <- client:
+	case <-h.done:
+		h.conns.Add(-2)
+		conn.Close()
+		return
+	}
+
+	go client.writePump()
+	go client.readPump()
+}
+
+// SendToUsers delivers msg to every connection of the given users, on any
+// instance when a broker is configured.
+func (h *Hub) SendToUsers(ctx context.Context, userIDs []int64, msg domain.RealtimeMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	if h.broker != nil {
+		return h.broker.Publish(ctx, userIDs, data)
+	}
+	h.deliver(userIDs, data)
+	return nil
+}
+
+// DisconnectUsers closes every connection of the given users, on any instance
+// when a broker is configured.
+func (h *Hub) DisconnectUsers(ctx context.Context, userIDs []int64) error {
+	if h.broker != nil {
+		return h.broker.Disconnect(ctx, userIDs)
+	}
+	h.disconnect(userIDs)
+	return nil
+}
+
+// consume hands the frames received from the broker to local connections.
+func (h *Hub) consume() {
+	for f := range h.broker.Frames() {
+		if f.Close {
+			h.disconnect([]int64{f.UserID})
+			continue
+		}
+		h.deliver([]int64{f.UserID}, f.Data)
+	}
+}
+
+// disconnect unregisters every local connection of the given users, which
+// sends them a close frame.
+func (h *Hub) disconnect(userIDs []int64) {
+	var clients []*Client
+	h.mu.RLock()
+	for _, id := range userIDs {
+		for c := range h.clients[id] {
+			clients = append(clients, c)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, c := range clients {
+		h.leave(c)
+	}
+}
+
+// deliver queues data on every local connection of the given users. A
+// connection whose buffer is full is evicted instead of blocking the sender.
+func (h *Hub) deliver(userIDs []int64, data []byte) {
+	var slow []*Client
+	h.mu.RLock()
+	for _, id := range userIDs {
+		for c := range h.clients[id] {
+			select {
+			case c.send <- data:
+			default:
+				slow = append(slow, c)
+			}
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, c := range slow {
+		pkg.Log().Warnw("[WS] evicting slow client", "user_id", c.userID, "device_id", c.deviceID)
+		h.leave(c)
+	}
+}
+
+// syncSubscription subscribes to the user's frames while they have
+// connections here and unsubscribes once they have none. Calls are serialized
+// and read the registry afresh, so they settle on its latest state whatever
+// order they run in. A failed subscription closes the user's connections,
+// which would otherwise miss every message sent from other instances.
+func (h *Hub) syncSubscription(userID int64) {
+	h.subMu.Lock()
+	defer h.subMu.Unlock()
+
+	h.mu.RLock()
+	_, connected := h.clients[userID]
+	h.mu.RUnlock()
+	if _, subscribed := h.subscribed[userID]; connected == subscribed {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), brokerTimeout)
+	defer cancel()
+
+	if !connected {
+		// Frames of a channel left subscribed are dropped, so there is
+		// nothing to undo on failure.
+		if err := h.broker.Unsubscribe(ctx, userID); err != nil {
+			pkg.Log().Errorw("[WS] broker unsubscribe failed", "user_id", userID, "error", err)
+		}
+		delete(h.subscribed, userID)
+		return
+	}
+
+	if err := h.broker.Subscribe(ctx, userID); err != nil {
+		pkg.Log().Errorw("[WS] broker subscribe failed", "user_id", userID, "error", err)
+		h.disconnect([]int64{userID})
+		return
+	}
+	h.subscribed[userID] = struct{}{}
+}
+
+// admit reserves the two pump slots of a new connection, unless the hub is
+// shutting down.
+func (h *Hub) admit() bool {
+	h.stateMu.Lock()
+	defer h.stateMu.Unlock()
+	if h.stopped {
+		return false
+	}
+	h.conns.Add(2)
+	return true
+}
+
+// leave unregisters c unless the hub is already shutting down, in which case
+// Run has closed every connection itself.
+func (h *Hub) leave(c *Client) {
+	select {
+	case h.unregister <- c:
+	case <-h.done:
+	}
+}
+
+// remove drops c from the registry and closes its send channel, which makes
+// the write pump send a close frame. It reports whether c was the last
+// connection of its user. Callers must hold mu.
+func (h *Hub) remove(c *Client) bool {
+	devices, ok := h.clients[c.userID]
+	if !ok {
+		return false
+	}
+	if _, ok := devices[c]; !ok {
+		return false
+	}
+
+	delete(devices, c)
+	close(c.send)
+	if len(devices) > 0 {
+		return false
+	}
+	delete(h.clients, c.userID)
+	return true
+}
+
+func (h *Hub) dispatch(c *Client, raw []byte) {
+	var in domain.RealtimeInbound
+	if err := json.Unmarshal(raw, &in); err != nil {
+		c.sendError("invalid message")
+		return
+	}
+
+	handler, ok := h.handlers[in.Type]
+	if !ok {
+		c.sendError("unknown message type")
+		return
+	}
+
+	if err := handler(context.Background(), c, in.Data); err != nil {
+		c.sendError(err.Error())
+	}
+}
+
+func originChecker(allowed string) func(r *http.Request) bool {
+	if allowed == "" {
+		// gorilla's default: only same-origin requests.
+		return nil
+	}
+	if allowed == "*" {
+		return func(*http.Request) bool { return true }
+	}
+
+	origins := make(map[string]struct{})
+	for _, o := range strings.Split(allowed, ",") {
+		if o = strings.TrimSpace(o); o != "" {
+			origins[strings.ToLower(o)] = struct{}{}
+		}
+	}
 
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		u, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+		_, ok := origins[strings.ToLower(u.Scheme+"://"+u.Host)]
+		return ok
+	}
 }
@@ -0,0 +1,235 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+
+	"air-social/internal/config"
+	"air-social/internal/domain"
+	"air-social/internal/transport/http/middleware"
+)
+
+type hubSuite struct {
+	suite.Suite
+	redis *miniredis.Miniredis
+	cfg   config.WSConfig
+}
+
+func TestHubSuite(t *testing.T) {
+	suite.Run(t, new(hubSuite))
+}
+
+func (s *hubSuite) SetupSuite() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (s *hubSuite) SetupTest() {
+	s.redis = miniredis.RunT(s.T())
+	s.cfg = config.WSConfig{
+		WriteTimeout:   time.Second,
+		PongTimeout:    time.Minute,
+		PingInterval:   30 * time.Second,
+		MaxMessageSize: 1024,
+		SendBufferSize: 8,
+	}
+}
+
+// startHub runs a hub backed by the shared Redis stand-in and returns the URL
+// that connects as the given user. setup runs before the hub starts.
+func (s *hubSuite) startHub(userID int64, setup ...func(*Hub)) (*Hub, string) {
+	client := redis.NewClient(&redis.Options{Addr: s.redis.Addr()})
+	s.T().Cleanup(func() { client.Close() })
+
+	broker, err := NewRedisBroker(client)
+	s.Require().NoError(err)
+	return s.serveHub(broker, userID, setup...)
+}
+
+// serveHub runs a hub on broker and returns the URL that connects as the given
+// user. setup runs before the hub starts.
+func (s *hubSuite) serveHub(broker Broker, userID int64, setup ...func(*Hub)) (*Hub, string) {
+	hub := NewHub(s.cfg, broker)
+	for _, fn := range setup {
+		fn(hub)
+	}
+	go hub.Run()
+
+	e := gin.New()
+	e.GET("/ws", func(c *gin.Context) {
+		c.Set(middleware.AuthPayloadKey, &domain.AuthClaims{UserID: userID, DeviceID: "device"})
+	}, hub.Serve)
+	srv := httptest.NewServer(e)
+
+	s.T().Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		s.NoError(hub.Shutdown(ctx))
+		srv.Close()
+	})
+	return hub, "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws"
+}
+
+func (s *hubSuite) dial(url string) *websocket.Conn {
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	s.Require().NoError(err)
+	s.T().Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// waitSubscribed blocks until some hub subscribed to the user's channel.
+func (s *hubSuite) waitSubscribed(userID int64) {
+	channel := domain.GetWSUserChannelKey(userID)
+	s.Require().Eventually(func() bool {
+		return s.redis.PubSubNumSub(channel)[channel] > 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func (s *hubSuite) readMessage(conn *websocket.Conn) string {
+	s.Require().NoError(conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, data, err := conn.ReadMessage()
+	s.Require().NoError(err)
+	return string(data)
+}
+
+func (s *hubSuite) TestSendToUsers() {
+	s.Run("delivers_across_instances", func() {
+		sender, _ := s.startHub(1)
+		_, url := s.startHub(2)
+
+		conn := s.dial(url)
+		s.waitSubscribed(2)
+
+		err := sender.SendToUsers(context.Background(), []int64{2}, domain.RealtimeMessage{Type: "ping", Data: 1})
+		s.Require().NoError(err)
+		s.JSONEq(`{"type":"ping","data":1}`, s.readMessage(conn))
+	})
+
+	s.Run("delivers_to_every_device", func() {
+		hub, url := s.startHub(3)
+		first := s.dial(url)
+		second := s.dial(url)
+		s.waitSubscribed(3)
+
+		err := hub.SendToUsers(context.Background(), []int64{3}, domain.RealtimeMessage{Type: "ping"})
+		s.Require().NoError(err)
+		s.JSONEq(`{"type":"ping"}`, s.readMessage(first))
+		s.JSONEq(`{"type":"ping"}`, s.readMessage(second))
+	})
+
+	s.Run("unsubscribes_after_last_device", func() {
+		_, url := s.startHub(4)
+		conn := s.dial(url)
+		s.waitSubscribed(4)
+
+		s.Require().NoError(conn.Close())
+		channel := domain.GetWSUserChannelKey(4)
+		s.Eventually(func() bool {
+			return s.redis.PubSubNumSub(channel)[channel] == 0
+		}, time.Second, 10*time.Millisecond)
+	})
+}
+
+func (s *hubSuite) TestDisconnectUsers() {
+	sender, _ := s.startHub(6)
+	_, url := s.startHub(7)
+
+	conn := s.dial(url)
+	s.waitSubscribed(7)
+
+	s.Require().NoError(sender.DisconnectUsers(context.Background(), []int64{7}))
+
+	s.Require().NoError(conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, _, err := conn.ReadMessage()
+	s.True(websocket.IsCloseError(err, websocket.CloseNormalClosure), "got %v", err)
+
+	channel := domain.GetWSUserChannelKey(7)
+	s.Eventually(func() bool {
+		return s.redis.PubSubNumSub(channel)[channel] == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+// downBroker fails every subscription.
+type downBroker struct {
+	frames chan Frame
+	once   sync.Once
+}
+
+func (b *downBroker) Publish(context.Context, []int64, []byte) error { return nil }
+func (b *downBroker) Disconnect(context.Context, []int64) error      { return nil }
+func (b *downBroker) Subscribe(context.Context, int64) error         { return errors.New("broker down") }
+func (b *downBroker) Unsubscribe(context.Context, int64) error       { return nil }
+func (b *downBroker) Frames() <-chan Frame                           { return b.frames }
+
+func (b *downBroker) Close() error {
+	b.once.Do(func() { close(b.frames) })
+	return nil
+}
+
+func (s *hubSuite) TestSubscribeFailureClosesConnection() {
+	_, url := s.serveHub(&downBroker{frames: make(chan Frame)}, 8)
+	conn := s.dial(url)
+
+	s.Require().NoError(conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, _, err := conn.ReadMessage()
+	s.True(websocket.IsCloseError(err, websocket.CloseNormalClosure), "got %v", err)
+}
+
+type presenceRecorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *presenceRecorder) record(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *presenceRecorder) Events() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.events)
+}
+
+func (r *presenceRecorder) Connect(context.Context, int64, string)    { r.record("connect") }
+func (r *presenceRecorder) Heartbeat(context.Context, int64, string)  { r.record("heartbeat") }
+func (r *presenceRecorder) Disconnect(context.Context, int64, string) { r.record("disconnect") }
+
+func (s *hubSuite) TestTrackPresence() {
+	s.cfg.PingInterval = 20 * time.Millisecond
+	tracker := &presenceRecorder{}
+	_, url := s.startHub(5, func(h *Hub) { h.TrackPresence(tracker) })
+
+	conn := s.dial(url)
+	// Pongs are answered by the read loop of the client, so keep reading.
+	go func() {
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	s.Require().Eventually(func() bool {
+		return slices.Contains(tracker.Events(), "heartbeat")
+	}, time.Second, 10*time.Millisecond)
+	s.Equal("connect", tracker.Events()[0])
+
+	s.Require().NoError(conn.Close())
+	s.Eventually(func() bool {
+		events := tracker.Events()
+		return events[len(events)-1] == "disconnect"
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,54 @@
+package pkg
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+)
+
+const cursorSeparator = ":"
+
+// EncodeCursor packs the sort keys of the last returned row into an opaque,
+// URL-safe token. Clients must treat it as a black box and send it back as-is.
+//
+// Example:
+//
+//	EncodeCursor(1700000000, 42) -> "MTcwMDAwMDAwMDo0Mg"
+func EncodeCursor(keys ...int64) string {
+	if len(keys) == 0 {
+		return ""
+	}
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = strconv.FormatInt(k, 10)
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(parts, cursorSeparator)))
+}
+
+// DecodeCursor reverses EncodeCursor and expects exactly n keys.
+// An empty cursor means "first page" and returns nil without error.
+func DecodeCursor(cursor string, n int) ([]int64, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrBadRequest
+	}
+
+	parts := strings.Split(string(raw), cursorSeparator)
+	if len(parts) != n {
+		return nil, ErrBadRequest
+	}
+
+	keys := make([]int64, n)
+	for i, p := range parts {
+		k, err := strconv.ParseInt(p, 10, 64)
+		if err != nil {
+			return nil, ErrBadRequest
+		}
+		keys[i] = k
+	}
+	return keys, nil
+}
@@ -17,10 +17,27 @@ var (
 	ErrBadRequest   = errors.New("bad request")                                          // 400
 	ErrInvalidData  = errors.New("validation failed")                                    // 400
 	ErrSamePassword = errors.New("new password must be different from current password") // 400
+	ErrSameEmail    = errors.New("new email must be different from current email")       // 400
 
-	ErrInvalidCredentials = errors.New("email or password is incorrect") // 401
-	ErrUnauthorized       = errors.New("authentication required")        // 401
-	ErrForbidden          = errors.New("access denied")                  // 403
+	ErrInvalidCredentials   = errors.New("email or password is incorrect") // 401
+	ErrInvalidTwoFactorCode = errors.New("two-factor code is incorrect")   // 401
+	ErrUnauthorized         = errors.New("authentication required")        // 401
+	ErrForbidden            = errors.New("access denied")                  // 403
+
+	ErrAccountDisabled  = errors.New("account has been suspended or banned") // 403
+	ErrEmailNotVerified = errors.New("email address is not verified")        // 403
+
+	ErrAlreadyVerified = errors.New("email address is already verified") // 409
+	ErrEmailTaken      = errors.New("email address is already in use")   // 409
+
+	ErrAccountNotLinked = errors.New("an account with this email exists, verify its email before signing in with a provider") // 409
+
+	ErrAccountLocked = errors.New("account is temporarily locked, check your email to unlock it") // 423
+
+	ErrTooManyRequests = errors.New("too many requests, try again later") // 429
+
+	ErrServiceUnavailable  = errors.New("service is shutting down")                         // 503
+	ErrProviderUnavailable = errors.New("sign-in provider is unavailable, try again later") // 503
 
 	ErrFileUnsupported = errors.New("file format not supported")     // 400
 	ErrFileTooLarge    = errors.New("file size exceeds limit")       // 413
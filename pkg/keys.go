@@ -0,0 +1,280 @@
+package pkg
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	jwtHeaderKeyID  = "kid"
+	keyFileExt      = ".pem"
+	minRSAKeyBits   = 2048
+	jwkUseSignature = "sig"
+)
+
+// JWK is the public half of a signing key, as served in a JWKS (RFC 7517).
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+}
+
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+type jwtKey struct {
+	method  jwt.SigningMethod
+	public  crypto.PublicKey
+	private crypto.Signer // nil for keys that only verify
+}
+
+// KeySet signs JWTs with one key and verifies them with any of its keys,
+// picked by the kid header. Keeping the previous key around while a new one
+// takes over lets keys rotate without invalidating live tokens.
+type KeySet struct {
+	signingID string
+	keys      map[string]jwtKey
+}
+
+// LoadKeySet reads the PEM keys of dir, one per file named <kid>.pem, and
+// signs with the key signingID. RSA and Ed25519 keys are supported; retired
+// keys may be kept as public keys only.
+func LoadKeySet(dir, signingID string) (*KeySet, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*"+keyFileExt))
+	if err != nil {
+		return nil, err
+	}
+
+	ks := &KeySet{signingID: signingID, keys: make(map[string]jwtKey, len(files))}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		key, err := parsePEMKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %s: %w", filepath.Base(file), err)
+		}
+		ks.keys[strings.TrimSuffix(filepath.Base(file), keyFileExt)] = key
+	}
+
+	if key, ok := ks.keys[signingID]; !ok || key.private == nil {
+		return nil, fmt.Errorf("no private jwt key %q in %s", signingID, dir)
+	}
+	return ks, nil
+}
+
+// GenerateKeySet creates a key set holding a single new Ed25519 key. Tokens
+// it signs do not survive a restart, so it only suits development and tests.
+func GenerateKeySet(id string) (*KeySet, error) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &KeySet{signingID: id, keys: map[string]jwtKey{
+		id: {method: jwt.SigningMethodEdDSA, public: public, private: private},
+	}}, nil
+}
+
+// NewKeySetFromJWKS builds a key set that only verifies, from the keys
+// another issuer publishes. Keys of unsupported types are skipped.
+func NewKeySetFromJWKS(set JWKSet) (*KeySet, error) {
+	ks := &KeySet{keys: make(map[string]jwtKey, len(set.Keys))}
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != jwkUseSignature {
+			continue
+		}
+		key, err := parseJWK(jwk)
+		if err != nil {
+			return nil, fmt.Errorf("jwk %q: %w", jwk.KeyID, err)
+		}
+		if key.method != nil {
+			ks.keys[jwk.KeyID] = key
+		}
+	}
+	if len(ks.keys) == 0 {
+		return nil, errors.New("no supported keys in JWKS")
+	}
+	return ks, nil
+}
+
+func (ks *KeySet) SigningKeyID() string {
+	return ks.signingID
+}
+
+// Sign signs claims with the signing key and names it in the kid header.
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	key := ks.keys[ks.signingID]
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header[jwtHeaderKeyID] = ks.signingID
+	return token.SignedString(key.private)
+}
+
+// Keyfunc resolves the verification key of a token for jwt.Parse. The key
+// must also match the token's alg, so a token cannot pick its own algorithm.
+func (ks *KeySet) Keyfunc(t *jwt.Token) (any, error) {
+	kid, _ := t.Header[jwtHeaderKeyID].(string)
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	if t.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method %v for key %q", t.Header["alg"], kid)
+	}
+	return key.public, nil
+}
+
+// Methods lists the algorithms of the keys, for jwt.WithValidMethods.
+func (ks *KeySet) Methods() []string {
+	var methods []string
+	for _, key := range ks.keys {
+		if !slices.Contains(methods, key.method.Alg()) {
+			methods = append(methods, key.method.Alg())
+		}
+	}
+	return methods
+}
+
+// JWKS publishes the public keys, sorted by kid.
+func (ks *KeySet) JWKS() JWKSet {
+	set := JWKSet{Keys: make([]JWK, 0, len(ks.keys))}
+	for kid, key := range ks.keys {
+		jwk := JWK{KeyID: kid, Use: jwkUseSignature, Algorithm: key.method.Alg()}
+		switch pub := key.public.(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	slices.SortFunc(set.Keys, func(a, b JWK) int { return strings.Compare(a.KeyID, b.KeyID) })
+	return set
+}
+
+// HasKey tells whether the set holds the key kid.
+func (ks *KeySet) HasKey(kid string) bool {
+	_, ok := ks.keys[kid]
+	return ok
+}
+
+// parseJWK returns a zero key for types it does not support.
+func parseJWK(jwk JWK) (jwtKey, error) {
+	decode := base64.RawURLEncoding.DecodeString
+
+	switch {
+	case jwk.KeyType == "RSA" && (jwk.Algorithm == "" || jwk.Algorithm == jwt.SigningMethodRS256.Alg()):
+		n, err := decode(jwk.N)
+		if err != nil {
+			return jwtKey{}, err
+		}
+		e, err := decode(jwk.E)
+		if err != nil {
+			return jwtKey{}, err
+		}
+		pub := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		if pub.N.BitLen() < minRSAKeyBits {
+			return jwtKey{}, fmt.Errorf("RSA key must be at least %d bits", minRSAKeyBits)
+		}
+		return jwtKey{method: jwt.SigningMethodRS256, public: pub}, nil
+
+	case jwk.KeyType == "EC" && jwk.Curve == "P-256":
+		x, err := decode(jwk.X)
+		if err != nil {
+			return jwtKey{}, err
+		}
+		y, err := decode(jwk.Y)
+		if err != nil {
+			return jwtKey{}, err
+		}
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+			return jwtKey{}, errors.New("EC point is not on the curve")
+		}
+		return jwtKey{method: jwt.SigningMethodES256, public: pub}, nil
+
+	case jwk.KeyType == "OKP" && jwk.Curve == "Ed25519":
+		x, err := decode(jwk.X)
+		if err != nil {
+			return jwtKey{}, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return jwtKey{}, errors.New("invalid Ed25519 key size")
+		}
+		return jwtKey{method: jwt.SigningMethodEdDSA, public: ed25519.PublicKey(x)}, nil
+	}
+	return jwtKey{}, nil
+}
+
+func parsePEMKey(data []byte) (jwtKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return jwtKey{}, errors.New("no PEM block found")
+	}
+
+	var (
+		parsed any
+		err    error
+	)
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return jwtKey{}, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return jwtKey{}, err
+	}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < minRSAKeyBits {
+			return jwtKey{}, fmt.Errorf("RSA key must be at least %d bits", minRSAKeyBits)
+		}
+		return jwtKey{method: jwt.SigningMethodRS256, public: &k.PublicKey, private: k}, nil
+	case *rsa.PublicKey:
+		if k.N.BitLen() < minRSAKeyBits {
+			return jwtKey{}, fmt.Errorf("RSA key must be at least %d bits", minRSAKeyBits)
+		}
+		return jwtKey{method: jwt.SigningMethodRS256, public: k}, nil
+	case ed25519.PrivateKey:
+		return jwtKey{method: jwt.SigningMethodEdDSA, public: k.Public(), private: k}, nil
+	case ed25519.PublicKey:
+		return jwtKey{method: jwt.SigningMethodEdDSA, public: k}, nil
+	default:
+		return jwtKey{}, fmt.Errorf("unsupported key type %T", parsed)
+	}
+}
@@ -0,0 +1,489 @@
+package pkg
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+// QR code encoding (ISO/IEC 18004) in byte mode at error correction level M,
+// enough to put otpauth:// URIs on screen without another dependency.
+
+const (
+	qrMinVersion = 1
+	qrMaxVersion = 40
+	qrQuietZone  = 4
+	// qrFormatBitsM are the two format bits of error correction level M.
+	qrFormatBitsM = 0
+)
+
+// Codewords per error correction block and number of blocks at level M,
+// indexed by version.
+var (
+	qrECCCodewordsPerBlock = [qrMaxVersion + 1]int{-1,
+		10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26,
+		26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28}
+	qrNumECCBlocks = [qrMaxVersion + 1]int{-1,
+		1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16,
+		17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49}
+)
+
+var ErrQRCodeTooLong = errors.New("data too long for a QR code")
+
+type qrCode struct {
+	version    int
+	size       int
+	modules    [][]bool
+	isFunction [][]bool
+}
+
+// QRCodePNG renders data as a QR code PNG, scale pixels per module.
+func QRCodePNG(data []byte, scale int) ([]byte, error) {
+	qr, err := encodeQRCode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	side := (qr.size + 2*qrQuietZone) * scale
+	img := image.NewPaletted(image.Rect(0, 0, side, side), color.Palette{color.White, color.Black})
+	for y := range qr.size {
+		for x := range qr.size {
+			if !qr.modules[y][x] {
+				continue
+			}
+			for dy := range scale {
+				for dx := range scale {
+					img.SetColorIndex((x+qrQuietZone)*scale+dx, (y+qrQuietZone)*scale+dy, 1)
+				}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func encodeQRCode(data []byte) (*qrCode, error) {
+	version := qrMinVersion
+	for ; ; version++ {
+		if version > qrMaxVersion {
+			return nil, ErrQRCodeTooLong
+		}
+		if 4+qrCharCountBits(version)+len(data)*8 <= qrNumDataCodewords(version)*8 {
+			break
+		}
+	}
+
+	// Byte mode segment, terminator and padding.
+	var bb qrBitBuffer
+	bb.append(0x4, 4)
+	bb.append(len(data), qrCharCountBits(version))
+	for _, b := range data {
+		bb.append(int(b), 8)
+	}
+	capacity := qrNumDataCodewords(version) * 8
+	bb.append(0, min(4, capacity-len(bb)))
+	bb.append(0, (8-len(bb)%8)%8)
+	for pad := 0xEC; len(bb) < capacity; pad ^= 0xEC ^ 0x11 {
+		bb.append(pad, 8)
+	}
+
+	codewords := make([]byte, len(bb)/8)
+	for i, bit := range bb {
+		if bit {
+			codewords[i>>3] |= 1 << (7 - i&7)
+		}
+	}
+
+	qr := newQRCode(version)
+	qr.drawFunctionPatterns()
+	qr.drawCodewords(qrAddECCAndInterleave(codewords, version))
+
+	bestMask, minPenalty := 0, -1
+	for mask := range 8 {
+		qr.applyMask(mask)
+		qr.drawFormatBits(mask)
+		if p := qr.penaltyScore(); minPenalty < 0 || p < minPenalty {
+			bestMask, minPenalty = mask, p
+		}
+		qr.applyMask(mask) // XOR again to undo
+	}
+	qr.applyMask(bestMask)
+	qr.drawFormatBits(bestMask)
+	return qr, nil
+}
+
+func newQRCode(version int) *qrCode {
+	size := version*4 + 17
+	qr := &qrCode{version: version, size: size, modules: make([][]bool, size), isFunction: make([][]bool, size)}
+	for i := range size {
+		qr.modules[i] = make([]bool, size)
+		qr.isFunction[i] = make([]bool, size)
+	}
+	return qr
+}
+
+func (qr *qrCode) setFunction(x, y int, dark bool) {
+	qr.modules[y][x] = dark
+	qr.isFunction[y][x] = true
+}
+
+func (qr *qrCode) drawFunctionPatterns() {
+	for i := range qr.size {
+		qr.setFunction(6, i, i%2 == 0)
+		qr.setFunction(i, 6, i%2 == 0)
+	}
+
+	qr.drawFinderPattern(3, 3)
+	qr.drawFinderPattern(qr.size-4, 3)
+	qr.drawFinderPattern(3, qr.size-4)
+
+	positions := qr.alignmentPositions()
+	last := len(positions) - 1
+	for i, y := range positions {
+		for j, x := range positions {
+			// Skip the three that would overlap the finder patterns.
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			qr.drawAlignmentPattern(x, y)
+		}
+	}
+
+	// Reserve the format areas, drawn for real once the mask is chosen.
+	qr.drawFormatBits(0)
+	qr.drawVersion()
+}
+
+// drawFinderPattern draws a finder pattern and its separator around (x, y).
+func (qr *qrCode) drawFinderPattern(x, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			xx, yy := x+dx, y+dy
+			if xx < 0 || xx >= qr.size || yy < 0 || yy >= qr.size {
+				continue
+			}
+			dist := max(abs(dx), abs(dy))
+			qr.setFunction(xx, yy, dist != 2 && dist != 4)
+		}
+	}
+}
+
+func (qr *qrCode) drawAlignmentPattern(x, y int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			qr.setFunction(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+// alignmentPositions returns the centre coordinates of the alignment
+// patterns, used on both axes.
+func (qr *qrCode) alignmentPositions() []int {
+	if qr.version == 1 {
+		return nil
+	}
+	n := qr.version/7 + 2
+	step := (qr.version*8 + n*3 + 5) / (n*4 - 4) * 2
+	positions := make([]int, n)
+	positions[0] = 6
+	for i := n - 1; i >= 1; i-- {
+		positions[i] = qr.size - 7 - (n-1-i)*step
+	}
+	return positions
+}
+
+func (qr *qrCode) drawFormatBits(mask int) {
+	data := qrFormatBitsM<<3 | mask
+	rem := data
+	for range 10 {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	bits := (data<<10 | rem) ^ 0x5412
+
+	// Around the top left finder pattern.
+	for i := range 6 {
+		qr.setFunction(8, i, qrBit(bits, i))
+	}
+	qr.setFunction(8, 7, qrBit(bits, 6))
+	qr.setFunction(8, 8, qrBit(bits, 7))
+	qr.setFunction(7, 8, qrBit(bits, 8))
+	for i := 9; i < 15; i++ {
+		qr.setFunction(14-i, 8, qrBit(bits, i))
+	}
+
+	// Split between the other two finder patterns.
+	for i := range 8 {
+		qr.setFunction(qr.size-1-i, 8, qrBit(bits, i))
+	}
+	for i := 8; i < 15; i++ {
+		qr.setFunction(8, qr.size-15+i, qrBit(bits, i))
+	}
+	qr.setFunction(8, qr.size-8, true) // always dark
+}
+
+func (qr *qrCode) drawVersion() {
+	if qr.version < 7 {
+		return
+	}
+	rem := qr.version
+	for range 12 {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+	}
+	bits := qr.version<<12 | rem
+
+	for i := range 18 {
+		a, b := qr.size-11+i%3, i/3
+		qr.setFunction(a, b, qrBit(bits, i))
+		qr.setFunction(b, a, qrBit(bits, i))
+	}
+}
+
+// drawCodewords fills the data area in the zigzag order of the standard,
+// two columns at a time from the bottom right.
+func (qr *qrCode) drawCodewords(data []byte) {
+	i := 0
+	for right := qr.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5 // skip the vertical timing pattern
+		}
+		upward := (right+1)&2 == 0
+		for vert := range qr.size {
+			y := vert
+			if upward {
+				y = qr.size - 1 - vert
+			}
+			for j := range 2 {
+				x := right - j
+				if qr.isFunction[y][x] || i >= len(data)*8 {
+					continue
+				}
+				qr.modules[y][x] = qrBit(int(data[i>>3]), 7-i&7)
+				i++
+			}
+		}
+	}
+}
+
+func (qr *qrCode) applyMask(mask int) {
+	for y := range qr.size {
+		for x := range qr.size {
+			if qr.isFunction[y][x] {
+				continue
+			}
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			qr.modules[y][x] = qr.modules[y][x] != invert
+		}
+	}
+}
+
+// penaltyScore rates how hard the symbol is to scan, to pick the mask.
+func (qr *qrCode) penaltyScore() int {
+	get := func(x, y int, transpose bool) bool {
+		if transpose {
+			return qr.modules[x][y]
+		}
+		return qr.modules[y][x]
+	}
+	finderLike := [][]bool{
+		{true, false, true, true, true, false, true, false, false, false, false},
+		{false, false, false, false, true, false, true, true, true, false, true},
+	}
+
+	score, dark := 0, 0
+	for _, transpose := range []bool{false, true} {
+		for y := range qr.size {
+			// Runs of five or more modules of the same color.
+			run := 0
+			for x := range qr.size {
+				if x > 0 && get(x, y, transpose) == get(x-1, y, transpose) {
+					run++
+				} else {
+					run = 1
+				}
+				if run == 5 {
+					score += 3
+				} else if run > 5 {
+					score++
+				}
+			}
+			// Patterns that look like a finder.
+			for x := 0; x+11 <= qr.size; x++ {
+				for _, pattern := range finderLike {
+					match := true
+					for k, want := range pattern {
+						if get(x+k, y, transpose) != want {
+							match = false
+							break
+						}
+					}
+					if match {
+						score += 40
+					}
+				}
+			}
+		}
+	}
+
+	for y := range qr.size {
+		for x := range qr.size {
+			if qr.modules[y][x] {
+				dark++
+			}
+			// 2x2 blocks of the same color.
+			if x+1 < qr.size && y+1 < qr.size {
+				c := qr.modules[y][x]
+				if c == qr.modules[y][x+1] && c == qr.modules[y+1][x] && c == qr.modules[y+1][x+1] {
+					score += 3
+				}
+			}
+		}
+	}
+
+	// Every 5% the dark share strays from 50%.
+	total := qr.size * qr.size
+	score += abs(dark*20-total*10) / total * 10
+	return score
+}
+
+func qrCharCountBits(version int) int {
+	if version <= 9 {
+		return 8
+	}
+	return 16
+}
+
+// qrNumRawDataModules is the number of modules left for data and error
+// correction once the function patterns are drawn.
+func qrNumRawDataModules(version int) int {
+	n := (16*version+128)*version + 64
+	if version >= 2 {
+		numAlign := version/7 + 2
+		n -= (25*numAlign-10)*numAlign - 55
+		if version >= 7 {
+			n -= 36
+		}
+	}
+	return n
+}
+
+func qrNumDataCodewords(version int) int {
+	return qrNumRawDataModules(version)/8 - qrECCCodewordsPerBlock[version]*qrNumECCBlocks[version]
+}
+
+// qrAddECCAndInterleave splits data into blocks, appends the Reed-Solomon
+// codewords of each and interleaves the blocks.
+func qrAddECCAndInterleave(data []byte, version int) []byte {
+	numBlocks := qrNumECCBlocks[version]
+	eccLen := qrECCCodewordsPerBlock[version]
+	rawCodewords := qrNumRawDataModules(version) / 8
+	numShortBlocks := numBlocks - rawCodewords%numBlocks
+	shortBlockLen := rawCodewords / numBlocks
+
+	divisor := qrReedSolomonDivisor(eccLen)
+	blocks := make([][]byte, numBlocks)
+	for i, k := 0, 0; i < numBlocks; i++ {
+		n := shortBlockLen - eccLen
+		if i >= numShortBlocks {
+			n++
+		}
+		block := append([]byte{}, data[k:k+n]...)
+		k += n
+		ecc := qrReedSolomonRemainder(block, divisor)
+		if i < numShortBlocks {
+			block = append(block, 0) // keeps the blocks aligned, skipped below
+		}
+		blocks[i] = append(block, ecc...)
+	}
+
+	result := make([]byte, 0, rawCodewords)
+	for i := 0; i <= shortBlockLen; i++ {
+		for j, block := range blocks {
+			if i != shortBlockLen-eccLen || j >= numShortBlocks {
+				result = append(result, block[i])
+			}
+		}
+	}
+	return result
+}
+
+func qrReedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	root := byte(1)
+	for range degree {
+		for j := range result {
+			result[j] = qrGFMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = qrGFMultiply(root, 0x02)
+	}
+	return result
+}
+
+func qrReedSolomonRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, d := range divisor {
+			result[i] ^= qrGFMultiply(d, factor)
+		}
+	}
+	return result
+}
+
+// qrGFMultiply multiplies in GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1.
+func qrGFMultiply(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int(y>>i&1) * int(x)
+	}
+	return byte(z)
+}
+
+type qrBitBuffer []bool
+
+func (bb *qrBitBuffer) append(val, n int) {
+	for i := n - 1; i >= 0; i-- {
+		*bb = append(*bb, qrBit(val, i))
+	}
+}
+
+func qrBit(x, i int) bool {
+	return x>>i&1 != 0
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
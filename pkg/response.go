@@ -57,6 +57,18 @@ func EntityTooLarge(c *gin.Context, msg string) {
 	JSON(c, http.StatusRequestEntityTooLarge, msg, nil)
 }
 
+func Locked(c *gin.Context, msg string) {
+	JSON(c, http.StatusLocked, msg, nil)
+}
+
+func TooManyRequests(c *gin.Context, msg string) {
+	JSON(c, http.StatusTooManyRequests, msg, nil)
+}
+
+func ServiceUnavailable(c *gin.Context, msg string) {
+	JSON(c, http.StatusServiceUnavailable, msg, nil)
+}
+
 func HandleValidateError(c *gin.Context, err error) {
 	if v := ValidateRequestError(err); v != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -72,13 +84,14 @@ func HandleValidateError(c *gin.Context, err error) {
 func HandleServiceError(c *gin.Context, err error) {
 	msg := err.Error()
 	switch {
-	case errors.Is(err, ErrUnauthorized), errors.Is(err, ErrInvalidCredentials):
+	case errors.Is(err, ErrUnauthorized), errors.Is(err, ErrInvalidCredentials), errors.Is(err, ErrInvalidTwoFactorCode):
 		Unauthorized(c, msg)
 
-	case errors.Is(err, ErrForbidden):
+	case errors.Is(err, ErrForbidden), errors.Is(err, ErrAccountDisabled), errors.Is(err, ErrEmailNotVerified):
 		Forbidden(c, msg)
 
-	case errors.Is(err, ErrAlreadyExists):
+	case errors.Is(err, ErrAlreadyExists), errors.Is(err, ErrConflict), errors.Is(err, ErrAccountNotLinked),
+		errors.Is(err, ErrAlreadyVerified), errors.Is(err, ErrEmailTaken):
 		Conflict(c, msg)
 
 	case errors.Is(err, ErrNotFound):
@@ -87,7 +100,16 @@ func HandleServiceError(c *gin.Context, err error) {
 	case errors.Is(err, ErrFileTooLarge):
 		EntityTooLarge(c, msg)
 
-	case errors.Is(err, ErrBadRequest), errors.Is(err, ErrInvalidData), errors.Is(err, ErrSamePassword),
+	case errors.Is(err, ErrAccountLocked):
+		Locked(c, msg)
+
+	case errors.Is(err, ErrTooManyRequests):
+		TooManyRequests(c, msg)
+
+	case errors.Is(err, ErrServiceUnavailable), errors.Is(err, ErrProviderUnavailable):
+		ServiceUnavailable(c, msg)
+
+	case errors.Is(err, ErrBadRequest), errors.Is(err, ErrInvalidData), errors.Is(err, ErrSamePassword), errors.Is(err, ErrSameEmail),
 		errors.Is(err, ErrFileUnsupported), errors.Is(err, ErrFileTypeInvalid):
 		BadRequest(c, msg)
 
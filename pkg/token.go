@@ -2,8 +2,10 @@ package pkg
 
 import (
 	"errors"
+	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
@@ -12,12 +14,16 @@ import (
 const (
 	AuthorizationHeaderKey = "Authorization"
 	AuthorizationType      = "Bearer"
+	AccessTokenQueryKey    = "access_token"
 )
 
 // Standard JWT claims
 const (
+	JWTClaimID        = "jti"
 	JWTClaimSubject   = "sub"
 	JWTClaimDevice    = "dev"
+	JWTClaimSession   = "sid"
+	JWTClaimRole      = "role"
 	JWTClaimAudience  = "aud"
 	JWTClaimIssuer    = "iss"
 	JWTClaimIssuedAt  = "iat"
@@ -39,6 +45,17 @@ func ExtractTokenFromHeader(c *gin.Context) (string, error) {
 	return parts[1], nil
 }
 
+// ExtractTokenFromHeaderOrQuery falls back to the access_token query parameter
+// for clients that cannot set headers, such as browser WebSocket connections.
+func ExtractTokenFromHeaderOrQuery(c *gin.Context) (string, error) {
+	if c.GetHeader(AuthorizationHeaderKey) == "" {
+		if token := c.Query(AccessTokenQueryKey); token != "" {
+			return token, nil
+		}
+	}
+	return ExtractTokenFromHeader(c)
+}
+
 func GetStringClaims(claims jwt.MapClaims, key string) string {
 	if val, ok := claims[key]; ok {
 		if s, ok := val.(string); ok {
@@ -48,6 +65,21 @@ func GetStringClaims(claims jwt.MapClaims, key string) string {
 	return ""
 }
 
+// NumericDateMillis is t as a JWT NumericDate with millisecond precision,
+// which the spec allows as a fraction of seconds.
+func NumericDateMillis(t time.Time) float64 {
+	return float64(t.UnixMilli()) / 1e3
+}
+
+// GetTimeClaims reads a NumericDate claim to the millisecond, where the jwt
+// package truncates it to the second. It is the zero time when missing.
+func GetTimeClaims(claims jwt.MapClaims, key string) time.Time {
+	if v, ok := claims[key].(float64); ok {
+		return time.UnixMilli(int64(math.Round(v * 1e3)))
+	}
+	return time.Time{}
+}
+
 func GetInt64Claims(claim jwt.MapClaims, key string) int64 {
 	if val, ok := claim[key]; ok {
 		switch v := val.(type) {
@@ -6,6 +6,10 @@ const (
 	LayoutPath        = "email/layout_boxed.gohtml"
 	VerifyEmailPath   = "email/verify_email.gohtml"
 	ResetPasswordPath = "email/reset_password.gohtml"
+	AccountLockedPath = "email/account_locked.gohtml"
+	MagicLinkPath     = "email/magic_link.gohtml"
+	EmailChangePath   = "email/email_change.gohtml"
+	EmailNoticePath   = "email/email_change_notice.gohtml"
 )
 
 //go:embed email pages